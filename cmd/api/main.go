@@ -30,19 +30,39 @@ import (
 	"time"
 
 	"motico-api/config"
+	attachmentdomain "motico-api/internal/domain/attachment"
 	authdomain "motico-api/internal/domain/auth"
+	catalogdomain "motico-api/internal/domain/catalog"
 	categorydomain "motico-api/internal/domain/category"
+	cyclecountdomain "motico-api/internal/domain/cyclecount"
+	pricelistdomain "motico-api/internal/domain/pricelist"
 	productdomain "motico-api/internal/domain/product"
+	purchaseorderdomain "motico-api/internal/domain/purchaseorder"
+	reportdomain "motico-api/internal/domain/report"
+	salesorderdomain "motico-api/internal/domain/salesorder"
 	stockdomain "motico-api/internal/domain/stock"
+	stockreturndomain "motico-api/internal/domain/stockreturn"
 	storedomain "motico-api/internal/domain/store"
+	supplierdomain "motico-api/internal/domain/supplier"
 	transferdomain "motico-api/internal/domain/transfer"
+	"motico-api/internal/jobs"
 	"motico-api/internal/repository"
 	"motico-api/internal/rest"
+	attachmenthandler "motico-api/internal/rest/attachment"
+	cataloghandler "motico-api/internal/rest/catalog"
 	categoryhandler "motico-api/internal/rest/category"
+	countsessionhandler "motico-api/internal/rest/countsession"
+	pricelisthandler "motico-api/internal/rest/pricelist"
 	producthandler "motico-api/internal/rest/product"
+	purchaseorderhandler "motico-api/internal/rest/purchaseorder"
+	reporthandler "motico-api/internal/rest/report"
+	salesorderhandler "motico-api/internal/rest/salesorder"
 	stockhandler "motico-api/internal/rest/stock"
+	stockreturnhandler "motico-api/internal/rest/stockreturn"
 	storehandler "motico-api/internal/rest/store"
+	supplierhandler "motico-api/internal/rest/supplier"
 	transferhandler "motico-api/internal/rest/transfer"
+	"motico-api/internal/storage"
 	"motico-api/pkg/logger"
 
 	_ "github.com/joho/godotenv/autoload"
@@ -78,31 +98,94 @@ func main() {
 
 	authService := authdomain.NewService(cfg)
 
+	fileStorage, mediaHandler, err := newStorage(cfg.Storage)
+	if err != nil {
+		appLogger.Fatal("Error creating attachment storage", logger.Error(err))
+	}
+
 	storeRepo := repository.NewStoreRepository(pool)
 	categoryRepo := repository.NewCategoryRepository(pool)
+	catalogRepo := repository.NewCatalogRepository(pool)
 	productRepo := repository.NewProductRepository(pool)
+	priceListRepo := repository.NewPriceListRepository(pool)
 	stockRepo := repository.NewStockRepository(pool)
 	transferRepo := repository.NewTransferRepository(pool)
+	supplierRepo := repository.NewSupplierRepository(pool)
+	purchaseOrderRepo := repository.NewPurchaseOrderRepository(pool)
+	salesOrderRepo := repository.NewSalesOrderRepository(pool)
+	stockReturnRepo := repository.NewStockReturnRepository(pool)
+	countSessionRepo := repository.NewCountSessionRepository(pool)
+	reportRepo := repository.NewReportRepository(pool)
+	attachmentRepo := repository.NewAttachmentRepository(pool)
+	txManager := repository.NewTransactionManager(pool)
 
-	storeService := storedomain.NewService(storeRepo, cfg, appLogger)
-	categoryService := categorydomain.NewService(categoryRepo, cfg, appLogger)
-	productService := productdomain.NewService(productRepo, cfg, appLogger)
-	stockService := stockdomain.NewService(stockRepo, cfg, appLogger)
-	transferService := transferdomain.NewService(transferRepo, stockService, storeRepo, cfg, appLogger)
+	storeService := storedomain.NewService(storeRepo, txManager, cfg, appLogger)
+	categoryService := categorydomain.NewService(categoryRepo, txManager, cfg, appLogger)
+	catalogService := catalogdomain.NewService(catalogRepo, txManager, cfg, appLogger)
+	priceListService := pricelistdomain.NewService(priceListRepo, catalogRepo, storeRepo, cfg, appLogger)
+	productService := productdomain.NewService(productRepo, catalogRepo, categoryRepo, storeRepo, txManager, cfg, appLogger)
+	stockService := stockdomain.NewService(stockRepo, txManager, cfg, appLogger)
+	transferService := transferdomain.NewService(transferRepo, stockService, storeService, storeRepo, productRepo, txManager, cfg, appLogger)
+	supplierService := supplierdomain.NewService(supplierRepo, cfg, appLogger)
+	purchaseOrderService := purchaseorderdomain.NewService(purchaseOrderRepo, stockService, supplierRepo, storeRepo, productRepo, catalogRepo, txManager, cfg, appLogger)
+	salesOrderService := salesorderdomain.NewService(salesOrderRepo, stockService, storeRepo, productRepo, txManager, cfg, appLogger)
+	stockReturnService := stockreturndomain.NewService(stockReturnRepo, stockService, storeRepo, productRepo, supplierRepo, salesOrderRepo, txManager, cfg, appLogger)
+	countSessionService := cyclecountdomain.NewService(countSessionRepo, stockService, storeRepo, categoryRepo, txManager, cfg, appLogger)
+	reportService := reportdomain.NewService(reportRepo, catalogRepo, storeRepo, cfg, appLogger)
+	attachmentService := attachmentdomain.NewService(attachmentRepo, productRepo, fileStorage, cfg, appLogger)
+
+	sweepInterval, err := cfg.Jobs.GetReservationSweepInterval()
+	if err != nil {
+		appLogger.Fatal("Error parsing reservation sweep interval", logger.Error(err))
+	}
+
+	deletedRetention, err := cfg.Jobs.GetDeletedRetention()
+	if err != nil {
+		appLogger.Fatal("Error parsing deleted retention", logger.Error(err))
+	}
+	purgeInterval, err := cfg.Jobs.GetDeletedPurgeInterval()
+	if err != nil {
+		appLogger.Fatal("Error parsing deleted purge interval", logger.Error(err))
+	}
+
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	scheduler := jobs.NewScheduler(appLogger)
+	scheduler.Register(jobs.ReservationSweeper(stockService, sweepInterval, cfg.Jobs.ReservationSweepBatchSize))
+	scheduler.Register(jobs.DeletedPurger(deletedRetention, purgeInterval, cfg.Jobs.DeletedPurgeBatchSize,
+		transferService, productService, categoryService, storeService))
+	scheduler.Start(jobsCtx)
 
 	categoryHandler := categoryhandler.NewHandler(categoryService, cfg)
-	storeHandler := storehandler.NewHandler(storeService, cfg)
+	storeHandler := storehandler.NewHandler(storeService, transferService, cfg)
+	catalogHandler := cataloghandler.NewHandler(catalogService, productService, cfg)
+	priceListHandler := pricelisthandler.NewHandler(priceListService, cfg)
 	productHandler := producthandler.NewHandler(productService, stockService, cfg)
 	stockHandler := stockhandler.NewHandler(stockService, cfg)
 	transferHandler := transferhandler.NewHandler(transferService, cfg)
+	supplierHandler := supplierhandler.NewHandler(supplierService, cfg)
+	purchaseOrderHandler := purchaseorderhandler.NewHandler(purchaseOrderService, cfg)
+	salesOrderHandler := salesorderhandler.NewHandler(salesOrderService, cfg)
+	stockReturnHandler := stockreturnhandler.NewHandler(stockReturnService, cfg)
+	countSessionHandler := countsessionhandler.NewHandler(countSessionService, cfg)
+	reportHandler := reporthandler.NewHandler(reportService, cfg)
+	attachmentHandler := attachmenthandler.NewHandler(attachmentService, cfg)
 
 	router := rest.NewRouter(rest.RouterDependencies{
-		AuthService:     authService,
-		CategoryHandler: categoryHandler,
-		StoreHandler:    storeHandler,
-		ProductHandler:  productHandler,
-		StockHandler:    stockHandler,
-		TransferHandler: transferHandler,
+		AuthService:          authService,
+		CategoryHandler:      categoryHandler,
+		StoreHandler:         storeHandler,
+		CatalogHandler:       catalogHandler,
+		PriceListHandler:     priceListHandler,
+		ProductHandler:       productHandler,
+		AttachmentHandler:    attachmentHandler,
+		StockHandler:         stockHandler,
+		TransferHandler:      transferHandler,
+		SupplierHandler:      supplierHandler,
+		PurchaseOrderHandler: purchaseOrderHandler,
+		SalesOrderHandler:    salesOrderHandler,
+		ReturnHandler:        stockReturnHandler,
+		CountSessionHandler:  countSessionHandler,
+		ReportHandler:        reportHandler,
 	})
 
 	// Swagger documentation
@@ -110,6 +193,11 @@ func main() {
 		httpSwagger.URL("http://localhost:8080/swagger/doc.json"),
 	))
 
+	// Con el driver local la API sirve los archivos adjuntos
+	if mediaHandler != nil {
+		router.Handle("/media/*", http.StripPrefix("/media", mediaHandler))
+	}
+
 	server := &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
 		Handler:      router,
@@ -131,6 +219,10 @@ func main() {
 
 	appLogger.Info("Server shutting down")
 
+	stopJobs()
+	scheduler.Wait()
+	appLogger.Info("Background jobs stopped")
+
 	// Cerrar pool de conexiones primero
 	pool.Close()
 	appLogger.Info("Database connection pool closed")
@@ -150,6 +242,23 @@ func main() {
 	appLogger.Info("Server stopped")
 }
 
+// newStorage crea el almacenamiento de adjuntos del driver configurado; con el
+// driver local devuelve además el handler que sirve los archivos.
+func newStorage(cfg config.StorageConfig) (attachmentdomain.Storage, http.Handler, error) {
+	switch cfg.Driver {
+	case "s3":
+		s3Storage, err := storage.NewS3Storage(cfg.S3)
+		return s3Storage, nil, err
+	case "local", "":
+		localStorage, err := storage.NewLocalStorage(cfg.LocalPath, cfg.BaseURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		return localStorage, localStorage.Handler(), nil
+	}
+	return nil, nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+}
+
 func parseDuration(s string) time.Duration {
 	d, err := time.ParseDuration(s)
 	if err != nil {
@@ -0,0 +1,91 @@
+// Comando de conciliación de reservas: recalcula stock.reserved_quantity a partir
+// de stock_reservations e informa las diferencias. Por defecto solo reporta;
+// con -apply corrige los valores.
+//
+//	go run ./cmd/reconcile-reservations [-tenant <uuid>] [-apply]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"motico-api/config"
+	stockdomain "motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	"motico-api/internal/repository"
+	"motico-api/pkg/logger"
+
+	"github.com/google/uuid"
+	_ "github.com/joho/godotenv/autoload"
+)
+
+func main() {
+	tenantFlag := flag.String("tenant", "", "only reconcile this tenant ID")
+	apply := flag.Bool("apply", false, "write the corrected reserved quantities")
+	flag.Parse()
+
+	var tenantID *uuid.UUID
+	if *tenantFlag != "" {
+		id, err := uuid.Parse(*tenantFlag)
+		if err != nil {
+			log.Fatalf("Invalid tenant ID: %v", err)
+		}
+		tenantID = &id
+	}
+
+	cfg, err := config.Load("config/config.json")
+	if err != nil {
+		log.Fatalf("Error loading config: %v", err)
+	}
+
+	appLogger, err := logger.New(cfg.Logging.Level, cfg.Logging.Format)
+	if err != nil {
+		log.Fatalf("Error creating logger: %v", err)
+	}
+	defer func() {
+		_ = appLogger.Sync()
+	}()
+
+	ctx := context.Background()
+
+	pool, err := repository.NewConnectionPool(ctx, cfg)
+	if err != nil {
+		appLogger.Fatal("Error creating database connection pool", logger.Error(err))
+	}
+	defer pool.Close()
+
+	txManager := repository.NewTransactionManager(pool)
+	stockService := stockdomain.NewService(repository.NewStockRepository(pool), txManager, cfg, appLogger)
+
+	var drifts []*stockentities.ReservationDrift
+	err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		result, err := stockService.ReconcileReservations(ctx, tenantID, *apply)
+		drifts = result
+		return err
+	})
+	if err != nil {
+		appLogger.Fatal("Error reconciling reservations", logger.Error(err))
+	}
+
+	if len(drifts) == 0 {
+		fmt.Println("No reservation drift found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TENANT\tPRODUCT\tQUANTITY\tRESERVED\tEXPECTED\tDRIFT")
+	for _, d := range drifts {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%+d\n", d.TenantID, d.ProductID, d.Quantity, d.ReservedQuantity, d.ExpectedReserved, d.Drift())
+	}
+	_ = w.Flush()
+
+	if *apply {
+		fmt.Printf("%d reservation(s) corrected\n", len(drifts))
+	} else {
+		fmt.Printf("%d reservation(s) drifted; run with -apply to correct them\n", len(drifts))
+	}
+}
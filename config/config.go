@@ -11,12 +11,16 @@ import (
 )
 
 type Config struct {
-	Server     ServerConfig     `json:"server"`
-	Database   DatabaseConfig   `json:"database"`
-	Pagination PaginationConfig `json:"pagination"`
-	Validation ValidationConfig `json:"validation"`
-	Logging    LoggingConfig    `json:"logging"`
-	JWT        JWTConfig        `json:"jwt"`
+	Server       ServerConfig       `json:"server"`
+	Database     DatabaseConfig     `json:"database"`
+	Pagination   PaginationConfig   `json:"pagination"`
+	Validation   ValidationConfig   `json:"validation"`
+	Logging      LoggingConfig      `json:"logging"`
+	JWT          JWTConfig          `json:"jwt"`
+	Roles        RolesConfig        `json:"roles"`
+	Jobs         JobsConfig         `json:"jobs"`
+	Reservations ReservationsConfig `json:"reservations"`
+	Storage      StorageConfig      `json:"storage"`
 }
 
 type ServerConfig struct {
@@ -59,6 +63,53 @@ type JWTConfig struct {
 	ExpirationTime string `json:"expiration_time"`
 }
 
+// RolesConfig asigna roles por email mientras no haya usuarios en la base de
+// datos; el resto de los usuarios opera como staff.
+type RolesConfig struct {
+	Managers []string `json:"managers"`
+	Admins   []string `json:"admins"`
+}
+
+type JobsConfig struct {
+	ReservationSweepInterval  string `json:"reservation_sweep_interval"`
+	ReservationSweepBatchSize int    `json:"reservation_sweep_batch_size"`
+	// DeletedRetention es cuánto se conserva lo borrado lógicamente antes de
+	// purgarlo; mientras tanto se puede restaurar.
+	DeletedRetention      string `json:"deleted_retention"`
+	DeletedPurgeInterval  string `json:"deleted_purge_interval"`
+	DeletedPurgeBatchSize int    `json:"deleted_purge_batch_size"`
+}
+
+// ReservationsConfig fija cuánto dura una reserva según el tipo de dueño
+// (transfer, sales_order, cart). Las reservas de los tipos sin TTL no vencen.
+type ReservationsConfig struct {
+	TTL map[string]string `json:"ttl"`
+}
+
+// StorageConfig elige dónde se guardan los adjuntos: "local" en disco o "s3" en
+// cualquier almacenamiento compatible con S3.
+type StorageConfig struct {
+	Driver string `json:"driver"`
+	// LocalPath es el directorio de los archivos con el driver local
+	LocalPath string `json:"local_path"`
+	// BaseURL es la URL pública desde la que se sirven los archivos locales
+	BaseURL       string   `json:"base_url"`
+	MaxUploadSize int64    `json:"max_upload_size"`
+	ThumbnailSize int      `json:"thumbnail_size"`
+	S3            S3Config `json:"s3"`
+}
+
+type S3Config struct {
+	Endpoint string `json:"endpoint"`
+	Region   string `json:"region"`
+	Bucket   string `json:"bucket"`
+	// PublicURL reemplaza a endpoint/bucket en las URLs de los archivos, por
+	// ejemplo cuando se sirven a través de un CDN
+	PublicURL       string `json:"public_url"`
+	AccessKeyID     string `json:"-"`
+	SecretAccessKey string `json:"-"`
+}
+
 func Load(configPath string) (*Config, error) {
 	_ = godotenv.Load()
 
@@ -97,6 +148,18 @@ func Load(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("JWT_SECRET_KEY environment variable is required")
 	}
 
+	for ownerType := range config.Reservations.TTL {
+		if _, err := config.Reservations.GetTTL(ownerType); err != nil {
+			return nil, fmt.Errorf("invalid reservations.ttl for %s: %w", ownerType, err)
+		}
+	}
+
+	config.Storage.S3.AccessKeyID = os.Getenv("S3_ACCESS_KEY_ID")
+	config.Storage.S3.SecretAccessKey = os.Getenv("S3_SECRET_ACCESS_KEY")
+	if config.Storage.Driver == "s3" && (config.Storage.S3.AccessKeyID == "" || config.Storage.S3.SecretAccessKey == "") {
+		return nil, fmt.Errorf("S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY environment variables are required for the s3 storage driver")
+	}
+
 	return &config, nil
 }
 
@@ -110,3 +173,24 @@ func getEnvOrDefault(key, defaultValue string) string {
 func (c *DatabaseConfig) GetConnMaxLifetime() (time.Duration, error) {
 	return time.ParseDuration(c.ConnMaxLifetime)
 }
+
+func (c *JobsConfig) GetReservationSweepInterval() (time.Duration, error) {
+	return time.ParseDuration(c.ReservationSweepInterval)
+}
+
+func (c *JobsConfig) GetDeletedRetention() (time.Duration, error) {
+	return time.ParseDuration(c.DeletedRetention)
+}
+
+func (c *JobsConfig) GetDeletedPurgeInterval() (time.Duration, error) {
+	return time.ParseDuration(c.DeletedPurgeInterval)
+}
+
+// GetTTL devuelve cuánto dura una reserva del tipo de dueño; cero si no vence.
+func (c *ReservationsConfig) GetTTL(ownerType string) (time.Duration, error) {
+	ttl, ok := c.TTL[ownerType]
+	if !ok || ttl == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(ttl)
+}
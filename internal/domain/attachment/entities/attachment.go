@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	KindImage    Kind = "image"
+	KindDocument Kind = "document"
+)
+
+// contentTypes son los tipos aceptados, detectados por el contenido del archivo y
+// no por lo que declara el cliente, con su extensión y su clase de adjunto.
+var contentTypes = map[string]struct {
+	ext  string
+	kind Kind
+}{
+	"image/jpeg":      {".jpg", KindImage},
+	"image/png":       {".png", KindImage},
+	"image/gif":       {".gif", KindImage},
+	"image/webp":      {".webp", KindImage},
+	"application/pdf": {".pdf", KindDocument},
+}
+
+// Attachment es un archivo de un producto. URL y ThumbnailURL se fijan al subirlo
+// con la URL pública que da el almacenamiento.
+type Attachment struct {
+	ID           uuid.UUID `json:"id"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+	ProductID    uuid.UUID `json:"product_id"`
+	Kind         Kind      `json:"kind"`
+	FileName     string    `json:"file_name"`
+	ContentType  string    `json:"content_type"`
+	SizeBytes    int64     `json:"size_bytes"`
+	StorageKey   string    `json:"storage_key"`
+	URL          string    `json:"url"`
+	ThumbnailKey *string   `json:"thumbnail_key,omitempty"`
+	ThumbnailURL *string   `json:"thumbnail_url,omitempty"`
+	// Position ordena las imágenes del producto; la primera es la principal
+	Position  int       `json:"position"`
+	CreatedBy *string   `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewAttachment arma el adjunto con un ID nuevo y la clave donde se guarda,
+// agrupada por tenant y producto. El nombre original solo se conserva como dato.
+func NewAttachment(tenantID, productID uuid.UUID, fileName, contentType string, size int64) (*Attachment, error) {
+	t, ok := contentTypes[contentType]
+	if !ok {
+		return nil, ErrUnsupportedContentType
+	}
+	if size <= 0 {
+		return nil, ErrEmptyFile
+	}
+
+	id := uuid.New()
+	fileName = strings.TrimSpace(path.Base(strings.ReplaceAll(fileName, "\\", "/")))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		fileName = id.String() + t.ext
+	}
+
+	return &Attachment{
+		ID:          id,
+		TenantID:    tenantID,
+		ProductID:   productID,
+		Kind:        t.kind,
+		FileName:    fileName,
+		ContentType: contentType,
+		SizeBytes:   size,
+		StorageKey:  storageKey(tenantID, productID, id.String()+t.ext),
+	}, nil
+}
+
+// Keys son las claves de todos los archivos guardados del adjunto.
+func (a *Attachment) Keys() []string {
+	if a.ThumbnailKey != nil {
+		return []string{a.StorageKey, *a.ThumbnailKey}
+	}
+	return []string{a.StorageKey}
+}
+
+// ThumbnailStorageKey es la clave de la miniatura junto al archivo original.
+func (a *Attachment) ThumbnailStorageKey(ext string) string {
+	return storageKey(a.TenantID, a.ProductID, a.ID.String()+"_thumb"+ext)
+}
+
+func storageKey(tenantID, productID uuid.UUID, name string) string {
+	return tenantID.String() + "/products/" + productID.String() + "/" + name
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewAttachment(t *testing.T) {
+	tenantID, productID := uuid.New(), uuid.New()
+
+	attachment, err := NewAttachment(tenantID, productID, `C:\fotos\frente.JPG`, "image/jpeg", 1024)
+	if err != nil {
+		t.Fatalf("NewAttachment() error = %v", err)
+	}
+	if attachment.FileName != "frente.JPG" || attachment.Kind != KindImage {
+		t.Errorf("NewAttachment() = %+v", attachment)
+	}
+	wantKey := tenantID.String() + "/products/" + productID.String() + "/" + attachment.ID.String() + ".jpg"
+	if attachment.StorageKey != wantKey {
+		t.Errorf("StorageKey = %s, want %s", attachment.StorageKey, wantKey)
+	}
+	if !strings.HasSuffix(attachment.ThumbnailStorageKey(".png"), attachment.ID.String()+"_thumb.png") {
+		t.Errorf("ThumbnailStorageKey() = %s", attachment.ThumbnailStorageKey(".png"))
+	}
+
+	if _, err := NewAttachment(tenantID, productID, "manual.exe", "application/octet-stream", 10); err != ErrUnsupportedContentType {
+		t.Errorf("NewAttachment(exe) error = %v, want %v", err, ErrUnsupportedContentType)
+	}
+	if _, err := NewAttachment(tenantID, productID, "vacio.pdf", "application/pdf", 0); err != ErrEmptyFile {
+		t.Errorf("NewAttachment(empty) error = %v, want %v", err, ErrEmptyFile)
+	}
+}
+
+func TestNewThumbnail(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 800, 400))
+	for y := 0; y < 400; y++ {
+		for x := 0; x < 800; x++ {
+			src.Set(x, y, color.RGBA{R: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+
+	thumb, err := NewThumbnail(&buf, "image/png", 200)
+	if err != nil {
+		t.Fatalf("NewThumbnail() error = %v", err)
+	}
+	if thumb.ContentType != "image/png" || thumb.Ext != ".png" {
+		t.Errorf("NewThumbnail() type = %s %s", thumb.ContentType, thumb.Ext)
+	}
+
+	img, err := png.Decode(bytes.NewReader(thumb.Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds().Dx() != 200 || img.Bounds().Dy() != 100 {
+		t.Errorf("thumbnail size = %v, want 200x100", img.Bounds().Size())
+	}
+	if r, _, _, _ := img.At(50, 50).RGBA(); r>>8 != 200 {
+		t.Errorf("thumbnail color red = %d, want 200", r>>8)
+	}
+
+	if _, err := NewThumbnail(strings.NewReader("not an image"), "image/png", 200); err != ErrInvalidImage {
+		t.Errorf("NewThumbnail(invalid) error = %v, want %v", err, ErrInvalidImage)
+	}
+}
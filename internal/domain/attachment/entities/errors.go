@@ -0,0 +1,11 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrAttachmentNotFound     = apperror.New(apperror.KindNotFound, "attachment_not_found", "attachment not found")
+	ErrUnsupportedContentType = apperror.New(apperror.KindUnsupportedMediaType, "unsupported_content_type", "file type must be one of jpeg, png, gif, webp or pdf")
+	ErrFileTooLarge           = apperror.New(apperror.KindTooLarge, "file_too_large", "file exceeds the maximum upload size")
+	ErrEmptyFile              = apperror.New(apperror.KindUnprocessable, "empty_file", "file is empty")
+	ErrInvalidImage           = apperror.New(apperror.KindUnprocessable, "invalid_image", "image cannot be decoded")
+)
@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	// Registra el decodificador de GIF para image.Decode
+	_ "image/gif"
+)
+
+// maxImagePixels evita decodificar imágenes enormes (bombas de descompresión)
+// para generar la miniatura.
+const maxImagePixels = 50_000_000
+
+// Thumbnail es una miniatura generada a partir de una imagen.
+type Thumbnail struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+}
+
+// CanThumbnail indica si hay decodificador para generar la miniatura del tipo;
+// WebP se acepta pero se guarda sin miniatura.
+func CanThumbnail(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// NewThumbnail reduce la imagen para que su lado mayor mida como mucho maxSide,
+// promediando los píxeles de origen de cada píxel de destino. Las imágenes con
+// transparencia (PNG y GIF) se guardan como PNG y el resto como JPEG.
+func NewThumbnail(r io.Reader, contentType string, maxSide int) (*Thumbnail, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrInvalidImage
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	dst := scaleDown(src, maxSide)
+
+	var buf bytes.Buffer
+	if contentType == "image/jpeg" {
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+			return nil, err
+		}
+		return &Thumbnail{Data: buf.Bytes(), ContentType: "image/jpeg", Ext: ".jpg"}, nil
+	}
+
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, err
+	}
+	return &Thumbnail{Data: buf.Bytes(), ContentType: "image/png", Ext: ".png"}, nil
+}
+
+func scaleDown(src image.Image, maxSide int) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxSide || height > maxSide {
+		if width >= height {
+			height = max(1, height*maxSide/width)
+			width = maxSide
+		} else {
+			width = max(1, width*maxSide/height)
+			height = maxSide
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width == bounds.Dx() && height == bounds.Dy() {
+		draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+		return dst
+	}
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+			dst.SetRGBA(x, y, average(src, x0, y0, x1, y1))
+		}
+	}
+	return dst
+}
+
+// average promedia el rectángulo [x0,x1)×[y0,y1) con los colores premultiplicados.
+func average(src image.Image, x0, y0, x1, y1 int) color.RGBA {
+	var r, g, b, a, n uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			cr, cg, cb, ca := src.At(x, y).RGBA()
+			r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+			n++
+		}
+	}
+	return color.RGBA{
+		R: uint8(r / n >> 8),
+		G: uint8(g / n >> 8),
+		B: uint8(b / n >> 8),
+		A: uint8(a / n >> 8),
+	}
+}
@@ -0,0 +1,16 @@
+package attachment
+
+import (
+	"context"
+	"motico-api/internal/domain/attachment/entities"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create asigna al adjunto la última posición entre los de su producto
+	Create(ctx context.Context, attachment *entities.Attachment) error
+	GetByID(ctx context.Context, tenantID, productID, id uuid.UUID) (*entities.Attachment, error)
+	ListByProduct(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Attachment, error)
+	Delete(ctx context.Context, tenantID, productID, id uuid.UUID) error
+}
@@ -0,0 +1,133 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"motico-api/config"
+	"motico-api/internal/domain/attachment/entities"
+	productdomain "motico-api/internal/domain/product"
+	"motico-api/pkg/logger"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo        Repository
+	productRepo productdomain.Repository
+	storage     Storage
+	config      *config.Config
+	logger      logger.Logger
+}
+
+func NewService(repo Repository, productRepo productdomain.Repository, storage Storage, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:        repo,
+		productRepo: productRepo,
+		storage:     storage,
+		config:      cfg,
+		logger:      log,
+	}
+}
+
+type UploadRequest struct {
+	TenantID  uuid.UUID
+	ProductID uuid.UUID
+	FileName  string
+	Content   io.Reader
+	Actor     string
+}
+
+// Upload guarda un archivo del producto. El tipo se detecta por el contenido y no
+// por la extensión ni por lo que declara el cliente; las imágenes que se pueden
+// decodificar se guardan además con una miniatura.
+func (s *Service) Upload(ctx context.Context, req UploadRequest) (*entities.Attachment, error) {
+	if _, err := s.productRepo.GetByID(ctx, req.TenantID, req.ProductID); err != nil {
+		return nil, err
+	}
+
+	maxSize := s.config.Storage.MaxUploadSize
+	data, err := io.ReadAll(io.LimitReader(req.Content, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, entities.ErrFileTooLarge
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	attachment, err := entities.NewAttachment(req.TenantID, req.ProductID, req.FileName, contentType, int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if req.Actor != "" {
+		attachment.CreatedBy = &req.Actor
+	}
+
+	var thumbnail *entities.Thumbnail
+	if entities.CanThumbnail(contentType) {
+		thumbnail, err = entities.NewThumbnail(bytes.NewReader(data), contentType, s.config.Storage.ThumbnailSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.storage.Put(ctx, attachment.StorageKey, contentType, bytes.NewReader(data), int64(len(data))); err != nil {
+		return nil, err
+	}
+	attachment.URL = s.storage.URL(attachment.StorageKey)
+
+	if thumbnail != nil {
+		key := attachment.ThumbnailStorageKey(thumbnail.Ext)
+		if err := s.storage.Put(ctx, key, thumbnail.ContentType, bytes.NewReader(thumbnail.Data), int64(len(thumbnail.Data))); err != nil {
+			s.removeFiles(ctx, attachment.StorageKey)
+			return nil, err
+		}
+		url := s.storage.URL(key)
+		attachment.ThumbnailKey = &key
+		attachment.ThumbnailURL = &url
+	}
+
+	if err := s.repo.Create(ctx, attachment); err != nil {
+		s.removeFiles(ctx, attachment.Keys()...)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, productID, id uuid.UUID) (*entities.Attachment, error) {
+	return s.repo.GetByID(ctx, tenantID, productID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Attachment, error) {
+	if _, err := s.productRepo.GetByID(ctx, tenantID, productID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByProduct(ctx, tenantID, productID)
+}
+
+// Delete borra el adjunto y después sus archivos; si falla el borrado de un
+// archivo el adjunto ya no existe y solo se registra el error.
+func (s *Service) Delete(ctx context.Context, tenantID, productID, id uuid.UUID) error {
+	attachment, err := s.repo.GetByID(ctx, tenantID, productID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, tenantID, productID, id); err != nil {
+		return err
+	}
+
+	s.removeFiles(ctx, attachment.Keys()...)
+	return nil
+}
+
+func (s *Service) removeFiles(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			s.logger.Error("Error deleting attachment file", logger.Error(err), logger.String("key", key))
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package attachment
+
+import (
+	"context"
+	"io"
+)
+
+// Storage guarda los archivos de los adjuntos. Las claves son rutas relativas
+// separadas por "/" y URL devuelve la dirección pública desde la que se sirven.
+type Storage interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
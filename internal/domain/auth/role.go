@@ -0,0 +1,40 @@
+package auth
+
+import "strings"
+
+type Role string
+
+const (
+	RoleStaff   Role = "staff"
+	RoleManager Role = "manager"
+	RoleAdmin   Role = "admin"
+)
+
+// roleRank ordena los roles: cada uno puede hacer lo mismo que los anteriores.
+var roleRank = map[Role]int{
+	RoleStaff:   1,
+	RoleManager: 2,
+	RoleAdmin:   3,
+}
+
+// IsAtLeast indica si el rol tiene los permisos de min. Un rol desconocido no
+// alcanza ninguno.
+func (r Role) IsAtLeast(min Role) bool {
+	rank, ok := roleRank[r]
+	return ok && rank >= roleRank[min]
+}
+
+// roleFor resuelve el rol configurado para el email; sin configuración es staff.
+func (s *Service) roleFor(email string) Role {
+	for _, admin := range s.config.Roles.Admins {
+		if strings.EqualFold(admin, email) {
+			return RoleAdmin
+		}
+	}
+	for _, manager := range s.config.Roles.Managers {
+		if strings.EqualFold(manager, email) {
+			return RoleManager
+		}
+	}
+	return RoleStaff
+}
@@ -0,0 +1,24 @@
+package auth
+
+import "testing"
+
+func TestRoleIsAtLeast(t *testing.T) {
+	cases := []struct {
+		role Role
+		min  Role
+		want bool
+	}{
+		{RoleStaff, RoleStaff, true},
+		{RoleStaff, RoleManager, false},
+		{RoleManager, RoleManager, true},
+		{RoleAdmin, RoleManager, true},
+		{RoleManager, RoleAdmin, false},
+		{Role(""), RoleStaff, false},
+	}
+
+	for _, c := range cases {
+		if got := c.role.IsAtLeast(c.min); got != c.want {
+			t.Errorf("%q.IsAtLeast(%q) = %v, want %v", c.role, c.min, got, c.want)
+		}
+	}
+}
@@ -1,16 +1,16 @@
 package auth
 
 import (
-	"errors"
 	"motico-api/config"
+	"motico-api/pkg/apperror"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
 var (
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrInvalidToken       = errors.New("invalid token")
+	ErrInvalidCredentials = apperror.New(apperror.KindUnauthorized, "invalid_credentials", "invalid credentials")
+	ErrInvalidToken       = apperror.New(apperror.KindUnauthorized, "invalid_token", "invalid token")
 )
 
 type Service struct {
@@ -26,6 +26,7 @@ func NewService(cfg *config.Config) *Service {
 type Claims struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email"`
+	Role   Role   `json:"role"`
 	jwt.RegisteredClaims
 }
 
@@ -40,7 +41,7 @@ type LoginResponse struct {
 	TokenType string `json:"token_type"`
 }
 
-func (s *Service) GenerateToken(userID, email string) (string, int, error) {
+func (s *Service) GenerateToken(userID, email string, role Role) (string, int, error) {
 	expirationTime, err := time.ParseDuration(s.config.JWT.ExpirationTime)
 	if err != nil {
 		return "", 0, err
@@ -50,6 +51,7 @@ func (s *Service) GenerateToken(userID, email string) (string, int, error) {
 	claims := &Claims{
 		UserID: userID,
 		Email:  email,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -101,7 +103,7 @@ func (s *Service) Login(req LoginRequest) (*LoginResponse, error) {
 
 	// Generar token con el email como userID (temporal)
 	userID := req.Email // En producción, obtener de la base de datos
-	token, expiresIn, err := s.GenerateToken(userID, req.Email)
+	token, expiresIn, err := s.GenerateToken(userID, req.Email, s.roleFor(req.Email))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,55 @@
+package catalog
+
+import (
+	"context"
+	"motico-api/internal/domain/catalog/entities"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type AddBarcodeRequest struct {
+	TenantID      uuid.UUID
+	CatalogItemID uuid.UUID
+	Code          string
+	// Format es opcional; si no viene se deduce del código
+	Format entities.BarcodeFormat
+}
+
+func (s *Service) AddBarcode(ctx context.Context, req AddBarcodeRequest) (*entities.Barcode, error) {
+	if _, err := s.repo.GetByID(ctx, req.TenantID, req.CatalogItemID); err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(req.Code)
+	format := req.Format
+	if format == "" {
+		format = entities.DetectBarcodeFormat(code)
+	}
+	if err := entities.ValidateBarcode(code, format); err != nil {
+		return nil, err
+	}
+
+	barcode := &entities.Barcode{
+		TenantID:      req.TenantID,
+		CatalogItemID: req.CatalogItemID,
+		Code:          code,
+		Format:        format,
+	}
+	if err := s.repo.CreateBarcode(ctx, barcode); err != nil {
+		return nil, err
+	}
+
+	return barcode, nil
+}
+
+func (s *Service) ListBarcodes(ctx context.Context, tenantID, catalogItemID uuid.UUID) ([]*entities.Barcode, error) {
+	if _, err := s.repo.GetByID(ctx, tenantID, catalogItemID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListBarcodes(ctx, tenantID, catalogItemID)
+}
+
+func (s *Service) RemoveBarcode(ctx context.Context, tenantID, catalogItemID uuid.UUID, code string) error {
+	return s.repo.DeleteBarcode(ctx, tenantID, catalogItemID, strings.TrimSpace(code))
+}
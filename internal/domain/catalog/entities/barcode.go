@@ -0,0 +1,116 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BarcodeFormat string
+
+const (
+	BarcodeFormatEAN13   BarcodeFormat = "ean13"
+	BarcodeFormatUPCA    BarcodeFormat = "upc_a"
+	BarcodeFormatCode128 BarcodeFormat = "code128"
+)
+
+const maxCode128Length = 80
+
+type Barcode struct {
+	ID            uuid.UUID     `json:"id"`
+	TenantID      uuid.UUID     `json:"tenant_id"`
+	CatalogItemID uuid.UUID     `json:"catalog_item_id"`
+	Code          string        `json:"code"`
+	Format        BarcodeFormat `json:"format"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+func (f BarcodeFormat) IsValid() bool {
+	switch f {
+	case BarcodeFormatEAN13, BarcodeFormatUPCA, BarcodeFormatCode128:
+		return true
+	}
+	return false
+}
+
+// DetectBarcodeFormat deduce el formato a partir del código: 13 dígitos es EAN-13,
+// 12 dígitos es UPC-A y cualquier otro valor se trata como Code128.
+func DetectBarcodeFormat(code string) BarcodeFormat {
+	if isDigits(code) {
+		switch len(code) {
+		case 13:
+			return BarcodeFormatEAN13
+		case 12:
+			return BarcodeFormatUPCA
+		}
+	}
+	return BarcodeFormatCode128
+}
+
+// ValidateBarcode comprueba la forma del código y, para EAN-13 y UPC-A, el dígito de control.
+func ValidateBarcode(code string, format BarcodeFormat) error {
+	switch format {
+	case BarcodeFormatEAN13:
+		if len(code) != 13 || !isDigits(code) {
+			return ErrInvalidBarcode
+		}
+		if !validGTINCheckDigit(code) {
+			return ErrInvalidBarcodeCheckDigit
+		}
+	case BarcodeFormatUPCA:
+		if len(code) != 12 || !isDigits(code) {
+			return ErrInvalidBarcode
+		}
+		if !validGTINCheckDigit(code) {
+			return ErrInvalidBarcodeCheckDigit
+		}
+	case BarcodeFormatCode128:
+		if len(code) == 0 || len(code) > maxCode128Length {
+			return ErrInvalidBarcode
+		}
+		// Code128 codifica ASCII imprimible; su dígito de control va en el símbolo, no en los datos
+		for i := 0; i < len(code); i++ {
+			if code[i] < 0x20 || code[i] > 0x7e {
+				return ErrInvalidBarcode
+			}
+		}
+	default:
+		return ErrUnsupportedBarcodeFormat
+	}
+	return nil
+}
+
+// NormalizeBarcode devuelve la clave con la que se guarda y busca el código. Un
+// UPC-A es un EAN-13 con un cero delante, así que ambos se comparan como EAN-13
+// y un escáner que reporte cualquiera de las dos formas encuentra el producto.
+func NormalizeBarcode(code string) string {
+	if len(code) == 12 && isDigits(code) {
+		return "0" + code
+	}
+	return code
+}
+
+// validGTINCheckDigit aplica el módulo 10 de GS1: desde la derecha, sin contar el
+// dígito de control, las posiciones se ponderan alternando 3 y 1.
+func validGTINCheckDigit(code string) bool {
+	sum := 0
+	weight := 3
+	for i := len(code) - 2; i >= 0; i-- {
+		sum += int(code[i]-'0') * weight
+		weight = 4 - weight
+	}
+	check := (10 - sum%10) % 10
+	return check == int(code[len(code)-1]-'0')
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package entities
+
+import "testing"
+
+func TestValidateBarcode(t *testing.T) {
+	tests := []struct {
+		code   string
+		format BarcodeFormat
+		want   error
+	}{
+		{"4006381333931", BarcodeFormatEAN13, nil},
+		{"4006381333932", BarcodeFormatEAN13, ErrInvalidBarcodeCheckDigit},
+		{"400638133393", BarcodeFormatEAN13, ErrInvalidBarcode},
+		{"036000291452", BarcodeFormatUPCA, nil},
+		{"036000291453", BarcodeFormatUPCA, ErrInvalidBarcodeCheckDigit},
+		{"03600029145A", BarcodeFormatUPCA, ErrInvalidBarcode},
+		{"MOTO-ACEITE-10W40", BarcodeFormatCode128, nil},
+		{"", BarcodeFormatCode128, ErrInvalidBarcode},
+		{"tab\tcode", BarcodeFormatCode128, ErrInvalidBarcode},
+		{"4006381333931", BarcodeFormat("qr"), ErrUnsupportedBarcodeFormat},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateBarcode(tt.code, tt.format); got != tt.want {
+			t.Errorf("ValidateBarcode(%q, %s) = %v, want %v", tt.code, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestDetectBarcodeFormat(t *testing.T) {
+	tests := map[string]BarcodeFormat{
+		"4006381333931": BarcodeFormatEAN13,
+		"036000291452":  BarcodeFormatUPCA,
+		"12345":         BarcodeFormatCode128,
+		"ABC-123":       BarcodeFormatCode128,
+	}
+
+	for code, want := range tests {
+		if got := DetectBarcodeFormat(code); got != want {
+			t.Errorf("DetectBarcodeFormat(%q) = %s, want %s", code, got, want)
+		}
+	}
+}
+
+func TestNormalizeBarcode(t *testing.T) {
+	if got := NormalizeBarcode("036000291452"); got != "0036000291452" {
+		t.Errorf("expected UPC-A to normalize to EAN-13, got %s", got)
+	}
+	if got := NormalizeBarcode("0036000291452"); got != "0036000291452" {
+		t.Errorf("expected EAN-13 to stay unchanged, got %s", got)
+	}
+	if got := NormalizeBarcode("ABC-123"); got != "ABC-123" {
+		t.Errorf("expected Code128 to stay unchanged, got %s", got)
+	}
+}
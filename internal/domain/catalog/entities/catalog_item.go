@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogItem es el artículo maestro del tenant. Los productos de cada sucursal
+// son publicaciones (listings) de un artículo con precio local y estado propios.
+type CatalogItem struct {
+	ID           uuid.UUID      `json:"id"`
+	TenantID     uuid.UUID      `json:"tenant_id"`
+	CategoryID   uuid.UUID      `json:"category_id"`
+	Name         string         `json:"name"`
+	Description  *string        `json:"description,omitempty"`
+	SKU          *string        `json:"sku,omitempty"`
+	DefaultPrice *money.Amount  `json:"default_price,omitempty"`
+	Currency     money.Currency `json:"currency"`
+	Tracking     Tracking       `json:"tracking"`
+	ListingCount int            `json:"listing_count"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
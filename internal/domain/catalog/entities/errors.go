@@ -0,0 +1,22 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrCatalogItemNotFound      = apperror.New(apperror.KindNotFound, "catalog_item_not_found", "catalog item not found")
+	ErrCatalogSKUExists         = apperror.New(apperror.KindConflict, "catalog_sku_exists", "catalog SKU already exists for this tenant")
+	ErrInvalidCatalogItemName   = apperror.New(apperror.KindInvalid, "invalid_catalog_item_name", "catalog item name is invalid")
+	ErrCatalogItemHasListings   = apperror.New(apperror.KindConflict, "catalog_item_has_listings", "catalog item has store listings and cannot be deleted")
+	ErrInvalidTracking          = apperror.New(apperror.KindInvalid, "invalid_tracking", "tracking must be one of none, lot, serial")
+	ErrTrackingChangeWithStock  = apperror.New(apperror.KindConflict, "tracking_change_with_stock", "tracking cannot change while a listing has stock")
+	ErrBarcodeNotFound          = apperror.New(apperror.KindNotFound, "barcode_not_found", "barcode not found")
+	ErrBarcodeExists            = apperror.New(apperror.KindConflict, "barcode_exists", "barcode already exists for this tenant")
+	ErrInvalidBarcode           = apperror.New(apperror.KindInvalid, "invalid_barcode", "barcode is invalid for its format")
+	ErrInvalidBarcodeCheckDigit = apperror.New(apperror.KindInvalid, "invalid_barcode_check_digit", "barcode check digit is invalid")
+	ErrUnsupportedBarcodeFormat = apperror.New(apperror.KindInvalid, "unsupported_barcode_format", "barcode format must be one of ean13, upc_a, code128")
+	ErrFitmentNotFound          = apperror.New(apperror.KindNotFound, "fitment_not_found", "fitment not found")
+	ErrFitmentExists            = apperror.New(apperror.KindConflict, "fitment_exists", "fitment already exists for this catalog item")
+	ErrInvalidFitment           = apperror.New(apperror.KindInvalid, "invalid_fitment", "fitment needs a make, a model and a year range between 1900 and 2100")
+	ErrInvalidFitmentRows       = apperror.New(apperror.KindUnprocessable, "invalid_fitment_rows", "fitment import has invalid rows")
+	ErrInvalidVehicle           = apperror.New(apperror.KindInvalid, "invalid_vehicle", "vehicle must be make:model:year")
+)
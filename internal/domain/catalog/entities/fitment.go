@@ -0,0 +1,109 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MinFitmentYear = 1900
+	MaxFitmentYear = 2100
+)
+
+// Fitment indica que el artículo sirve para un modelo de vehículo en un rango de
+// años, ambos inclusive.
+type Fitment struct {
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+	CatalogItemID uuid.UUID `json:"catalog_item_id"`
+	Make          string    `json:"make"`
+	Model         string    `json:"model"`
+	YearFrom      int       `json:"year_from"`
+	YearTo        int       `json:"year_to"`
+	Notes         *string   `json:"notes,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// NewFitment arma la compatibilidad con marca y modelo sin espacios sobrantes. Sin
+// YearTo la compatibilidad es de un solo año.
+func NewFitment(tenantID, catalogItemID uuid.UUID, vehicleMake, vehicleModel string, yearFrom, yearTo int, notes *string) (*Fitment, error) {
+	vehicleMake, vehicleModel = strings.TrimSpace(vehicleMake), strings.TrimSpace(vehicleModel)
+	if yearTo == 0 {
+		yearTo = yearFrom
+	}
+	if vehicleMake == "" || vehicleModel == "" || yearFrom < MinFitmentYear || yearTo > MaxFitmentYear || yearFrom > yearTo {
+		return nil, ErrInvalidFitment
+	}
+
+	return &Fitment{
+		TenantID:      tenantID,
+		CatalogItemID: catalogItemID,
+		Make:          vehicleMake,
+		Model:         vehicleModel,
+		YearFrom:      yearFrom,
+		YearTo:        yearTo,
+		Notes:         notes,
+	}, nil
+}
+
+// Vehicle es el vehículo por el que se buscan repuestos compatibles.
+type Vehicle struct {
+	Make  string
+	Model string
+	Year  int
+}
+
+// ParseVehicle lee un vehículo escrito como marca:modelo:año, por ejemplo honda:cb190r:2019.
+func ParseVehicle(raw string) (Vehicle, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) != 3 {
+		return Vehicle{}, ErrInvalidVehicle
+	}
+
+	year, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+	vehicle := Vehicle{Make: strings.TrimSpace(parts[0]), Model: strings.TrimSpace(parts[1]), Year: year}
+	if err != nil || vehicle.Make == "" || vehicle.Model == "" || year < MinFitmentYear || year > MaxFitmentYear {
+		return Vehicle{}, ErrInvalidVehicle
+	}
+	return vehicle, nil
+}
+
+// FitmentImport es el resultado de una importación: las filas que ya existían se
+// cuentan como omitidas.
+type FitmentImport struct {
+	Created  int `json:"created"`
+	Skipped  int `json:"skipped"`
+	Replaced int `json:"replaced"`
+}
+
+// FitmentRowError es una fila inválida de una importación, numerada desde 1.
+type FitmentRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// FitmentImportError reúne las filas inválidas; si hay alguna no se importa nada.
+type FitmentImportError struct {
+	Rows []FitmentRowError
+}
+
+func (e *FitmentImportError) Error() string {
+	return fmt.Sprintf("fitment import has %d invalid rows", len(e.Rows))
+}
+
+func (e *FitmentImportError) Unwrap() error {
+	return ErrInvalidFitmentRows.WithDetails(e.Rows)
+}
+
+func AsFitmentImportError(err error) (*FitmentImportError, bool) {
+	var importErr *FitmentImportError
+	if errors.As(err, &importErr) {
+		return importErr, true
+	}
+	return nil, false
+}
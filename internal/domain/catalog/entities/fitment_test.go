@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewFitment(t *testing.T) {
+	tests := []struct {
+		name     string
+		make     string
+		model    string
+		from, to int
+		want     error
+	}{
+		{"range", "Honda", "CB190R", 2015, 2020, nil},
+		{"single year", " Yamaha ", "FZ16", 2018, 0, nil},
+		{"missing make", "", "FZ16", 2018, 0, ErrInvalidFitment},
+		{"reversed range", "Honda", "CB190R", 2020, 2015, ErrInvalidFitment},
+		{"year too old", "Honda", "CB190R", 1800, 2015, ErrInvalidFitment},
+		{"year too new", "Honda", "CB190R", 2015, 2200, ErrInvalidFitment},
+	}
+
+	for _, tt := range tests {
+		fitment, err := NewFitment(uuid.New(), uuid.New(), tt.make, tt.model, tt.from, tt.to, nil)
+		if err != tt.want {
+			t.Errorf("%s: NewFitment() error = %v, want %v", tt.name, err, tt.want)
+			continue
+		}
+		if err == nil && (fitment.YearTo < fitment.YearFrom || fitment.Make != "Honda" && fitment.Make != "Yamaha") {
+			t.Errorf("%s: NewFitment() = %+v", tt.name, fitment)
+		}
+	}
+}
+
+func TestParseVehicle(t *testing.T) {
+	vehicle, err := ParseVehicle("honda:cb190r:2019")
+	if err != nil || vehicle != (Vehicle{Make: "honda", Model: "cb190r", Year: 2019}) {
+		t.Errorf("ParseVehicle() = %+v, %v", vehicle, err)
+	}
+
+	for _, raw := range []string{"", "honda:cb190r", "honda::2019", "honda:cb190r:abc", "honda:cb190r:1500", "a:b:c:2019"} {
+		if _, err := ParseVehicle(raw); err != ErrInvalidVehicle {
+			t.Errorf("ParseVehicle(%q) error = %v, want %v", raw, err, ErrInvalidVehicle)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package entities
+
+// Tracking indica cómo se sigue el stock de un artículo en todas sus publicaciones.
+type Tracking string
+
+const (
+	TrackingNone Tracking = "none"
+	// TrackingLot guarda el stock por lote con fecha de vencimiento.
+	TrackingLot Tracking = "lot"
+	// TrackingSerial sigue cada unidad por su número de serie.
+	TrackingSerial Tracking = "serial"
+)
+
+func (t Tracking) IsValid() bool {
+	switch t {
+	case TrackingNone, TrackingLot, TrackingSerial:
+		return true
+	}
+	return false
+}
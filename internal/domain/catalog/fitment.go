@@ -0,0 +1,134 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"motico-api/internal/domain/catalog/entities"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type AddFitmentRequest struct {
+	TenantID      uuid.UUID
+	CatalogItemID uuid.UUID
+	Make          string
+	Model         string
+	YearFrom      int
+	// YearTo es opcional; sin él la compatibilidad es de un solo año
+	YearTo int
+	Notes  *string
+}
+
+// FitmentRow es una fila de la importación masiva; el artículo se identifica por SKU.
+type FitmentRow struct {
+	SKU      string
+	Make     string
+	Model    string
+	YearFrom int
+	YearTo   int
+	Notes    *string
+}
+
+// ImportFitmentsRequest: con Replace se borran antes las compatibilidades de los
+// artículos que aparecen en la importación, para que queden exactamente las filas.
+type ImportFitmentsRequest struct {
+	TenantID uuid.UUID
+	Rows     []FitmentRow
+	Replace  bool
+}
+
+func (s *Service) AddFitment(ctx context.Context, req AddFitmentRequest) (*entities.Fitment, error) {
+	if _, err := s.repo.GetByID(ctx, req.TenantID, req.CatalogItemID); err != nil {
+		return nil, err
+	}
+
+	fitment, err := entities.NewFitment(req.TenantID, req.CatalogItemID, req.Make, req.Model, req.YearFrom, req.YearTo, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateFitment(ctx, fitment); err != nil {
+		return nil, err
+	}
+
+	return fitment, nil
+}
+
+func (s *Service) ListFitments(ctx context.Context, tenantID, catalogItemID uuid.UUID) ([]*entities.Fitment, error) {
+	if _, err := s.repo.GetByID(ctx, tenantID, catalogItemID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListFitments(ctx, tenantID, catalogItemID)
+}
+
+func (s *Service) RemoveFitment(ctx context.Context, tenantID, catalogItemID, id uuid.UUID) error {
+	return s.repo.DeleteFitment(ctx, tenantID, catalogItemID, id)
+}
+
+// ImportFitments valida todas las filas antes de guardar: si alguna es inválida
+// devuelve un FitmentImportError con cada fila mala y no importa nada.
+func (s *Service) ImportFitments(ctx context.Context, req ImportFitmentsRequest) (*entities.FitmentImport, error) {
+	result := &entities.FitmentImport{}
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		fitments, itemIDs, err := s.resolveFitmentRows(ctx, req.TenantID, req.Rows)
+		if err != nil {
+			return err
+		}
+
+		if req.Replace {
+			if result.Replaced, err = s.repo.DeleteFitmentsByItems(ctx, req.TenantID, itemIDs); err != nil {
+				return err
+			}
+		}
+
+		if result.Created, err = s.repo.ImportFitments(ctx, fitments); err != nil {
+			return err
+		}
+		result.Skipped = len(fitments) - result.Created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// resolveFitmentRows busca el artículo de cada fila por SKU y arma sus
+// compatibilidades. Devuelve también los artículos involucrados, sin repetir.
+func (s *Service) resolveFitmentRows(ctx context.Context, tenantID uuid.UUID, rows []FitmentRow) ([]*entities.Fitment, []uuid.UUID, error) {
+	items := make(map[string]uuid.UUID)
+	itemIDs := []uuid.UUID{}
+	fitments := make([]*entities.Fitment, 0, len(rows))
+	importErr := &entities.FitmentImportError{}
+
+	for i, row := range rows {
+		sku := strings.TrimSpace(row.SKU)
+		itemID, ok := items[sku]
+		if !ok {
+			item, err := s.repo.GetBySKU(ctx, tenantID, sku)
+			if errors.Is(err, entities.ErrCatalogItemNotFound) {
+				importErr.Rows = append(importErr.Rows, entities.FitmentRowError{Row: i + 1, Message: "no catalog item with SKU " + sku})
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			itemID = item.ID
+			items[sku] = itemID
+			itemIDs = append(itemIDs, itemID)
+		}
+
+		fitment, err := entities.NewFitment(tenantID, itemID, row.Make, row.Model, row.YearFrom, row.YearTo, row.Notes)
+		if err != nil {
+			importErr.Rows = append(importErr.Rows, entities.FitmentRowError{Row: i + 1, Message: err.Error()})
+			continue
+		}
+		fitments = append(fitments, fitment)
+	}
+
+	if len(importErr.Rows) > 0 {
+		return nil, nil, importErr
+	}
+	return fitments, itemIDs, nil
+}
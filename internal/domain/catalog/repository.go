@@ -0,0 +1,34 @@
+package catalog
+
+import (
+	"context"
+	"motico-api/internal/domain/catalog/entities"
+	"motico-api/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, item *entities.CatalogItem) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.CatalogItem, error)
+	GetBySKU(ctx context.Context, tenantID uuid.UUID, sku string) (*entities.CatalogItem, error)
+	List(ctx context.Context, tenantID uuid.UUID, categoryID *uuid.UUID, limit, offset int) ([]*entities.CatalogItem, error)
+	Update(ctx context.Context, item *entities.CatalogItem) error
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	ExistsBySKU(ctx context.Context, tenantID uuid.UUID, sku string) (bool, error)
+	// HasStock indica si alguna publicación del artículo tiene unidades en stock.
+	HasStock(ctx context.Context, tenantID, id uuid.UUID) (bool, error)
+	TenantCurrency(ctx context.Context, tenantID uuid.UUID) (money.Currency, error)
+	CreateBarcode(ctx context.Context, barcode *entities.Barcode) error
+	GetBarcode(ctx context.Context, tenantID uuid.UUID, code string) (*entities.Barcode, error)
+	ListBarcodes(ctx context.Context, tenantID, catalogItemID uuid.UUID) ([]*entities.Barcode, error)
+	DeleteBarcode(ctx context.Context, tenantID, catalogItemID uuid.UUID, code string) error
+	CreateFitment(ctx context.Context, fitment *entities.Fitment) error
+	ListFitments(ctx context.Context, tenantID, catalogItemID uuid.UUID) ([]*entities.Fitment, error)
+	DeleteFitment(ctx context.Context, tenantID, catalogItemID, id uuid.UUID) error
+	// ImportFitments guarda las compatibilidades omitiendo las que ya existen y
+	// devuelve cuántas creó.
+	ImportFitments(ctx context.Context, fitments []*entities.Fitment) (int, error)
+	// DeleteFitmentsByItems borra las compatibilidades de los artículos y devuelve cuántas borró.
+	DeleteFitmentsByItems(ctx context.Context, tenantID uuid.UUID, catalogItemIDs []uuid.UUID) (int, error)
+}
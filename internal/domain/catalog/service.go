@@ -0,0 +1,235 @@
+package catalog
+
+import (
+	"context"
+	"motico-api/config"
+	"motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/transaction"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo      Repository
+	txManager transaction.Manager
+	config    *config.Config
+	logger    logger.Logger
+}
+
+func NewService(repo Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:      repo,
+		txManager: txManager,
+		config:    cfg,
+		logger:    log,
+	}
+}
+
+type CreateRequest struct {
+	TenantID     uuid.UUID
+	CategoryID   uuid.UUID
+	Name         string
+	Description  *string
+	SKU          *string
+	DefaultPrice *money.Amount
+	// Currency es opcional; por defecto se usa la moneda del tenant
+	Currency *money.Currency
+	// Tracking es opcional; por defecto el stock no se sigue por lote ni por serie
+	Tracking *entities.Tracking
+}
+
+type UpdateRequest struct {
+	ID           uuid.UUID
+	TenantID     uuid.UUID
+	CategoryID   *uuid.UUID
+	Name         *string
+	Description  *string
+	SKU          *string
+	DefaultPrice *money.Amount
+	Currency     *money.Currency
+	Tracking     *entities.Tracking
+}
+
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.CatalogItem, error) {
+	if err := s.validateName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if req.SKU != nil && *req.SKU != "" {
+		exists, err := s.repo.ExistsBySKU(ctx, req.TenantID, *req.SKU)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, entities.ErrCatalogSKUExists
+		}
+	}
+
+	currency, err := s.resolveCurrency(ctx, req.TenantID, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if req.DefaultPrice != nil {
+		if err := money.ValidatePrice(*req.DefaultPrice, currency); err != nil {
+			return nil, err
+		}
+	}
+
+	tracking := entities.TrackingNone
+	if req.Tracking != nil {
+		if !req.Tracking.IsValid() {
+			return nil, entities.ErrInvalidTracking
+		}
+		tracking = *req.Tracking
+	}
+
+	item := &entities.CatalogItem{
+		TenantID:     req.TenantID,
+		CategoryID:   req.CategoryID,
+		Name:         req.Name,
+		Description:  req.Description,
+		SKU:          req.SKU,
+		DefaultPrice: req.DefaultPrice,
+		Currency:     currency,
+		Tracking:     tracking,
+	}
+
+	if err := s.repo.Create(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.CatalogItem, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, categoryID *uuid.UUID, limit, offset int) ([]*entities.CatalogItem, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, categoryID, limit, offset)
+}
+
+// Update modifica el artículo maestro. Nombre, descripción, categoría y SKU se
+// propagan a todas las publicaciones de las sucursales.
+func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.CatalogItem, error) {
+	item, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if err := s.validateName(*req.Name); err != nil {
+			return nil, err
+		}
+		item.Name = *req.Name
+	}
+
+	if req.Description != nil {
+		item.Description = req.Description
+	}
+
+	if req.SKU != nil {
+		if *req.SKU != "" && *req.SKU != getStringValue(item.SKU) {
+			exists, err := s.repo.ExistsBySKU(ctx, req.TenantID, *req.SKU)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, entities.ErrCatalogSKUExists
+			}
+		}
+		item.SKU = req.SKU
+	}
+
+	if req.CategoryID != nil {
+		item.CategoryID = *req.CategoryID
+	}
+
+	if req.DefaultPrice != nil {
+		item.DefaultPrice = req.DefaultPrice
+	}
+
+	if req.Currency != nil {
+		currency, err := money.ParseCurrency(string(*req.Currency))
+		if err != nil {
+			return nil, err
+		}
+		item.Currency = currency
+	}
+
+	if item.DefaultPrice != nil {
+		if err := money.ValidatePrice(*item.DefaultPrice, item.Currency); err != nil {
+			return nil, err
+		}
+	}
+
+	// Cambiar el tracking con unidades en stock dejaría stock sin lote o serie asignado
+	if req.Tracking != nil && *req.Tracking != item.Tracking {
+		if !req.Tracking.IsValid() {
+			return nil, entities.ErrInvalidTracking
+		}
+		hasStock, err := s.repo.HasStock(ctx, req.TenantID, item.ID)
+		if err != nil {
+			return nil, err
+		}
+		if hasStock {
+			return nil, entities.ErrTrackingChangeWithStock
+		}
+		item.Tracking = *req.Tracking
+	}
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	item, err := s.repo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if item.ListingCount > 0 {
+		return entities.ErrCatalogItemHasListings
+	}
+
+	return s.repo.Delete(ctx, tenantID, id)
+}
+
+// resolveCurrency valida la moneda pedida o, si no viene, usa la del tenant.
+func (s *Service) resolveCurrency(ctx context.Context, tenantID uuid.UUID, currency *money.Currency) (money.Currency, error) {
+	if currency != nil {
+		return money.ParseCurrency(string(*currency))
+	}
+	return s.repo.TenantCurrency(ctx, tenantID)
+}
+
+func (s *Service) validateName(name string) error {
+	if name == "" {
+		return entities.ErrInvalidCatalogItemName
+	}
+	if len(name) > s.config.Validation.MaxNameLength {
+		return entities.ErrInvalidCatalogItemName
+	}
+	return nil
+}
+
+func getStringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
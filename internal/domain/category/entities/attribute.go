@@ -0,0 +1,190 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeEnum    AttributeType = "enum"
+	AttributeTypeBoolean AttributeType = "boolean"
+	// AttributeTypeUnit es un número medido en la unidad fija del atributo (cc, mm...)
+	AttributeTypeUnit AttributeType = "unit"
+)
+
+func (t AttributeType) IsValid() bool {
+	switch t {
+	case AttributeTypeString, AttributeTypeNumber, AttributeTypeEnum, AttributeTypeBoolean, AttributeTypeUnit:
+		return true
+	}
+	return false
+}
+
+// AttributeDefinition es un campo de especificación que los productos de la
+// categoría pueden (o deben, si es Required) completar.
+type AttributeDefinition struct {
+	Key      string        `json:"key"`
+	Label    string        `json:"label,omitempty"`
+	Type     AttributeType `json:"type"`
+	Required bool          `json:"required"`
+	Options  []string      `json:"options,omitempty"`
+	Unit     string        `json:"unit,omitempty"`
+}
+
+// AttributeSchema son los atributos que define una categoría. Las subcategorías
+// heredan los de sus ancestras y pueden redefinir una clave.
+type AttributeSchema []AttributeDefinition
+
+// AttributeError indica un atributo mal definido en el esquema o un valor de
+// producto que no lo cumple.
+type AttributeError struct {
+	Key     string
+	Message string
+}
+
+func (e *AttributeError) Error() string {
+	return fmt.Sprintf("attribute %s: %s", e.Key, e.Message)
+}
+
+func (e *AttributeError) Unwrap() error {
+	return ErrInvalidAttributes.WithDetails(map[string]string{e.Key: e.Message})
+}
+
+func AsAttributeError(err error) (*AttributeError, bool) {
+	var attributeErr *AttributeError
+	if errors.As(err, &attributeErr) {
+		return attributeErr, true
+	}
+	return nil, false
+}
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// ValidAttributeKey indica si la clave sirve como nombre de atributo: minúsculas,
+// números y guiones bajos, empezando por una letra.
+func ValidAttributeKey(key string) bool {
+	return attributeKeyPattern.MatchString(key)
+}
+
+// Validate revisa que las claves sean válidas y no se repitan, y que cada tipo
+// tenga lo que necesita: opciones los enum y unidad los unit.
+func (s AttributeSchema) Validate() error {
+	keys := make(map[string]bool, len(s))
+	for _, def := range s {
+		if !ValidAttributeKey(def.Key) {
+			return &AttributeError{Key: def.Key, Message: "key must be lowercase letters, digits and underscores, starting with a letter"}
+		}
+		if keys[def.Key] {
+			return &AttributeError{Key: def.Key, Message: "key is defined more than once"}
+		}
+		keys[def.Key] = true
+
+		if !def.Type.IsValid() {
+			return &AttributeError{Key: def.Key, Message: "type must be one of string, number, enum, boolean, unit"}
+		}
+		if (def.Type == AttributeTypeEnum) != (len(def.Options) > 0) {
+			return &AttributeError{Key: def.Key, Message: "options are required for enum attributes and only allowed for them"}
+		}
+		if (def.Type == AttributeTypeUnit) != (strings.TrimSpace(def.Unit) != "") {
+			return &AttributeError{Key: def.Key, Message: "unit is required for unit attributes and only allowed for them"}
+		}
+
+		options := make(map[string]bool, len(def.Options))
+		for _, option := range def.Options {
+			if strings.TrimSpace(option) == "" || options[option] {
+				return &AttributeError{Key: def.Key, Message: "options must be non-empty and unique"}
+			}
+			options[option] = true
+		}
+	}
+	return nil
+}
+
+// MergeAttributeSchemas arma el esquema efectivo de una categoría a partir de los
+// de su breadcrumb, de la raíz a la categoría: una clave repetida toma la
+// definición más cercana pero conserva la posición donde apareció primero.
+func MergeAttributeSchemas(schemas ...AttributeSchema) AttributeSchema {
+	merged := AttributeSchema{}
+	positions := make(map[string]int)
+	for _, schema := range schemas {
+		for _, def := range schema {
+			if i, ok := positions[def.Key]; ok {
+				merged[i] = def
+				continue
+			}
+			positions[def.Key] = len(merged)
+			merged = append(merged, def)
+		}
+	}
+	return merged
+}
+
+// ValidateValues verifica los atributos de un producto contra el esquema y los
+// devuelve normalizados. Un valor nulo equivale a no informarlo.
+func (s AttributeSchema) ValidateValues(values map[string]interface{}) (map[string]interface{}, error) {
+	defs := make(map[string]AttributeDefinition, len(s))
+	for _, def := range s {
+		defs[def.Key] = def
+	}
+
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		def, ok := defs[key]
+		if !ok {
+			return nil, &AttributeError{Key: key, Message: "is not defined for the category"}
+		}
+		if err := def.validateValue(value); err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+
+	for _, def := range s {
+		if _, ok := result[def.Key]; def.Required && !ok {
+			return nil, &AttributeError{Key: def.Key, Message: "is required"}
+		}
+	}
+	return result, nil
+}
+
+func (d AttributeDefinition) validateValue(value interface{}) error {
+	switch d.Type {
+	case AttributeTypeNumber, AttributeTypeUnit:
+		if _, ok := value.(float64); !ok {
+			return &AttributeError{Key: d.Key, Message: "must be a number"}
+		}
+	case AttributeTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return &AttributeError{Key: d.Key, Message: "must be a boolean"}
+		}
+	case AttributeTypeEnum:
+		text, ok := value.(string)
+		if !ok || !d.hasOption(text) {
+			return &AttributeError{Key: d.Key, Message: "must be one of " + strings.Join(d.Options, ", ")}
+		}
+	default:
+		text, ok := value.(string)
+		if !ok || strings.TrimSpace(text) == "" {
+			return &AttributeError{Key: d.Key, Message: "must be a non-empty string"}
+		}
+	}
+	return nil
+}
+
+func (d AttributeDefinition) hasOption(value string) bool {
+	for _, option := range d.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
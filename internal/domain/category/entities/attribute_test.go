@@ -0,0 +1,98 @@
+package entities
+
+import "testing"
+
+func TestAttributeSchemaValidate(t *testing.T) {
+	valid := AttributeSchema{
+		{Key: "displacement", Type: AttributeTypeUnit, Unit: "cc", Required: true},
+		{Key: "thread_size", Type: AttributeTypeEnum, Options: []string{"M8", "M10"}},
+		{Key: "oem", Type: AttributeTypeBoolean},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid schema: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		schema AttributeSchema
+		key    string
+	}{
+		{"bad key", AttributeSchema{{Key: "Thread Size", Type: AttributeTypeString}}, "Thread Size"},
+		{"duplicate key", AttributeSchema{{Key: "oem", Type: AttributeTypeBoolean}, {Key: "oem", Type: AttributeTypeString}}, "oem"},
+		{"unknown type", AttributeSchema{{Key: "color", Type: "colour"}}, "color"},
+		{"enum without options", AttributeSchema{{Key: "color", Type: AttributeTypeEnum}}, "color"},
+		{"options on string", AttributeSchema{{Key: "color", Type: AttributeTypeString, Options: []string{"red"}}}, "color"},
+		{"unit without unit", AttributeSchema{{Key: "bore", Type: AttributeTypeUnit}}, "bore"},
+		{"repeated option", AttributeSchema{{Key: "color", Type: AttributeTypeEnum, Options: []string{"red", "red"}}}, "color"},
+	}
+	for _, tt := range tests {
+		attributeErr, ok := AsAttributeError(tt.schema.Validate())
+		if !ok || attributeErr.Key != tt.key {
+			t.Errorf("%s: expected attribute error on %s, got %v", tt.name, tt.key, attributeErr)
+		}
+	}
+}
+
+func TestMergeAttributeSchemas(t *testing.T) {
+	engine := AttributeSchema{
+		{Key: "displacement", Type: AttributeTypeUnit, Unit: "cc"},
+		{Key: "brand", Type: AttributeTypeString},
+	}
+	pistons := AttributeSchema{
+		{Key: "bore", Type: AttributeTypeUnit, Unit: "mm"},
+		{Key: "displacement", Type: AttributeTypeUnit, Unit: "cc", Required: true},
+	}
+
+	merged := MergeAttributeSchemas(engine, pistons)
+	if len(merged) != 3 {
+		t.Fatalf("expected 3 attributes, got %d", len(merged))
+	}
+	if merged[0].Key != "displacement" || !merged[0].Required {
+		t.Errorf("displacement should keep its position and take the child definition: %+v", merged[0])
+	}
+	if merged[1].Key != "brand" || merged[2].Key != "bore" {
+		t.Errorf("unexpected order: %s, %s", merged[1].Key, merged[2].Key)
+	}
+}
+
+func TestAttributeSchemaValidateValues(t *testing.T) {
+	schema := AttributeSchema{
+		{Key: "displacement", Type: AttributeTypeUnit, Unit: "cc", Required: true},
+		{Key: "thread_size", Type: AttributeTypeEnum, Options: []string{"M8", "M10"}},
+		{Key: "oem", Type: AttributeTypeBoolean},
+		{Key: "models", Type: AttributeTypeString},
+	}
+
+	values, err := schema.ValidateValues(map[string]interface{}{
+		"displacement": 150.0,
+		"thread_size":  "M10",
+		"oem":          true,
+		"models":       nil,
+	})
+	if err != nil {
+		t.Fatalf("valid values: %v", err)
+	}
+	if _, ok := values["models"]; ok || len(values) != 3 {
+		t.Errorf("null values should be dropped: %v", values)
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		key    string
+	}{
+		{"missing required", map[string]interface{}{"oem": true}, "displacement"},
+		{"unknown key", map[string]interface{}{"displacement": 150.0, "color": "red"}, "color"},
+		{"number as string", map[string]interface{}{"displacement": "150"}, "displacement"},
+		{"enum outside options", map[string]interface{}{"displacement": 150.0, "thread_size": "M12"}, "thread_size"},
+		{"boolean as string", map[string]interface{}{"displacement": 150.0, "oem": "yes"}, "oem"},
+		{"empty string", map[string]interface{}{"displacement": 150.0, "models": " "}, "models"},
+	}
+	for _, tt := range tests {
+		_, err := schema.ValidateValues(tt.values)
+		attributeErr, ok := AsAttributeError(err)
+		if !ok || attributeErr.Key != tt.key {
+			t.Errorf("%s: expected attribute error on %s, got %v", tt.name, tt.key, err)
+		}
+	}
+}
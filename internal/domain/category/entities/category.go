@@ -6,11 +6,43 @@ import (
 	"github.com/google/uuid"
 )
 
+// Category es un nodo del árbol de categorías del tenant (p. ej. Motor > Pistones >
+// Aros). Path es el breadcrumb desde la categoría raíz hasta la propia categoría.
 type Category struct {
-	ID          uuid.UUID `json:"id"`
-	TenantID    uuid.UUID `json:"tenant_id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uuid.UUID  `json:"id"`
+	TenantID    uuid.UUID  `json:"tenant_id"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	// Attributes es el esquema propio; el efectivo suma el de las ancestras
+	Attributes AttributeSchema `json:"attributes"`
+	Path       []CategoryRef   `json:"path"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	DeletedAt  *time.Time      `json:"deleted_at,omitempty"`
+}
+
+// CategoryRef es un tramo del breadcrumb de una categoría.
+type CategoryRef struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// HasAncestor indica si id es la propia categoría o una de sus ancestras.
+func (c *Category) HasAncestor(id uuid.UUID) bool {
+	for _, ref := range c.Path {
+		if ref.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CanMoveUnder valida que la categoría pueda colgar de parent (nil es la raíz): no
+// puede quedar debajo de sí misma ni de una de sus descendientes.
+func (c *Category) CanMoveUnder(parent *Category) error {
+	if parent != nil && parent.HasAncestor(c.ID) {
+		return ErrCategoryCycle
+	}
+	return nil
 }
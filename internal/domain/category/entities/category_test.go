@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCategoryCanMoveUnder(t *testing.T) {
+	engine := &Category{ID: uuid.New(), Name: "Engine"}
+	engine.Path = []CategoryRef{{ID: engine.ID, Name: engine.Name}}
+
+	pistons := &Category{ID: uuid.New(), ParentID: &engine.ID, Name: "Pistons"}
+	pistons.Path = append(engine.Path, CategoryRef{ID: pistons.ID, Name: pistons.Name})
+
+	rings := &Category{ID: uuid.New(), ParentID: &pistons.ID, Name: "Rings"}
+	rings.Path = append(append([]CategoryRef{}, pistons.Path...), CategoryRef{ID: rings.ID, Name: rings.Name})
+
+	brakes := &Category{ID: uuid.New(), Name: "Brakes"}
+	brakes.Path = []CategoryRef{{ID: brakes.ID, Name: brakes.Name}}
+
+	tests := []struct {
+		name     string
+		category *Category
+		parent   *Category
+		want     error
+	}{
+		{"to root", rings, nil, nil},
+		{"under another branch", pistons, brakes, nil},
+		{"under an ancestor", rings, engine, nil},
+		{"under itself", pistons, pistons, ErrCategoryCycle},
+		{"under a child", engine, pistons, ErrCategoryCycle},
+		{"under a grandchild", engine, rings, ErrCategoryCycle},
+	}
+	for _, tt := range tests {
+		if err := tt.category.CanMoveUnder(tt.parent); err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package entities
 
-import "errors"
+import "motico-api/pkg/apperror"
 
 var (
-	ErrCategoryNotFound    = errors.New("category not found")
-	ErrCategoryNameExists  = errors.New("category name already exists for this tenant")
-	ErrCategoryHasProducts = errors.New("category has associated products and cannot be deleted")
-	ErrInvalidCategoryName = errors.New("category name is invalid")
+	ErrCategoryNotFound      = apperror.New(apperror.KindNotFound, "category_not_found", "category not found")
+	ErrCategoryNameExists    = apperror.New(apperror.KindConflict, "category_name_exists", "category name already exists under the same parent")
+	ErrCategoryHasProducts   = apperror.New(apperror.KindConflict, "category_has_products", "category has associated products or catalog items and cannot be deleted")
+	ErrCategoryHasChildren   = apperror.New(apperror.KindConflict, "category_has_children", "category has subcategories and cannot be deleted")
+	ErrInvalidCategoryName   = apperror.New(apperror.KindInvalid, "invalid_category_name", "category name is invalid")
+	ErrInvalidCategoryParent = apperror.New(apperror.KindUnprocessable, "invalid_category_parent", "parent category not found")
+	ErrCategoryCycle         = apperror.New(apperror.KindUnprocessable, "category_cycle", "category cannot be moved under itself or one of its subcategories")
+	ErrInvalidAttributes     = apperror.New(apperror.KindUnprocessable, "invalid_attributes", "attributes do not match the category schema")
 )
@@ -3,6 +3,8 @@ package category
 import (
 	"context"
 	"motico-api/internal/domain/category/entities"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,9 +12,27 @@ import (
 type Repository interface {
 	Create(ctx context.Context, category *entities.Category) error
 	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Category, error)
-	List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entities.Category, error)
+	List(ctx context.Context, tenantID uuid.UUID, params query.Params, limit, offset int) ([]*entities.Category, error)
 	Update(ctx context.Context, category *entities.Category) error
+	// Delete marca la categoría como borrada; las consultas dejan de verla.
 	Delete(ctx context.Context, tenantID, id uuid.UUID) error
-	ExistsByName(ctx context.Context, tenantID uuid.UUID, name string) (bool, error)
+	// Restore quita la marca de borrado. Devuelve ErrCategoryNotFound si la
+	// categoría no existe o no está borrada.
+	Restore(ctx context.Context, tenantID, id uuid.UUID) error
+	// PurgeDeleted borra definitivamente las categorías borradas antes de before
+	// y devuelve cuántas borró.
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error)
+	// ExistsByName busca el nombre entre las hermanas: las que cuelgan de parentID,
+	// o las categorías raíz si es nil.
+	ExistsByName(ctx context.Context, tenantID uuid.UUID, parentID *uuid.UUID, name string) (bool, error)
+	// HasProducts y HasChildren solo cuentan productos y subcategorías vivos;
+	// HasProducts cuenta además los artículos de catálogo de la categoría.
 	HasProducts(ctx context.Context, tenantID, categoryID uuid.UUID) (bool, error)
+	HasChildren(ctx context.Context, tenantID, categoryID uuid.UUID) (bool, error)
+	// AttributeSchemas devuelve los esquemas propios del breadcrumb de la categoría,
+	// de la raíz a la propia categoría.
+	AttributeSchemas(ctx context.Context, tenantID, categoryID uuid.UUID) ([]entities.AttributeSchema, error)
+	// LockTree bloquea las categorías del tenant para que dos movimientos
+	// simultáneos no armen un ciclo entre ellos.
+	LockTree(ctx context.Context, tenantID uuid.UUID) error
 }
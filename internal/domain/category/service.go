@@ -2,46 +2,66 @@ package category
 
 import (
 	"context"
+	"errors"
 	"motico-api/config"
 	"motico-api/internal/domain/category/entities"
+	"motico-api/internal/domain/transaction"
 	"motico-api/pkg/logger"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo   Repository
-	config *config.Config
-	logger logger.Logger
+	repo      Repository
+	txManager transaction.Manager
+	config    *config.Config
+	logger    logger.Logger
 }
 
-func NewService(repo Repository, cfg *config.Config, log logger.Logger) *Service {
+func NewService(repo Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		config: cfg,
-		logger: log,
+		repo:      repo,
+		txManager: txManager,
+		config:    cfg,
+		logger:    log,
 	}
 }
 
+// CreateRequest crea una categoría bajo ParentID, o en la raíz si es nil.
 type CreateRequest struct {
 	TenantID    uuid.UUID
+	ParentID    *uuid.UUID
 	Name        string
 	Description *string
+	Attributes  entities.AttributeSchema
 }
 
+// UpdateRequest: con Attributes nil se conserva el esquema; una lista vacía lo borra.
 type UpdateRequest struct {
 	ID          uuid.UUID
 	TenantID    uuid.UUID
 	Name        *string
 	Description *string
+	Attributes  entities.AttributeSchema
 }
 
 func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Category, error) {
 	if err := s.validateName(req.Name); err != nil {
 		return nil, err
 	}
+	if err := req.Attributes.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.ParentID != nil {
+		if _, err := s.parent(ctx, req.TenantID, *req.ParentID); err != nil {
+			return nil, err
+		}
+	}
 
-	exists, err := s.repo.ExistsByName(ctx, req.TenantID, req.Name)
+	exists, err := s.repo.ExistsByName(ctx, req.TenantID, req.ParentID, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -51,22 +71,25 @@ func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Cate
 
 	category := &entities.Category{
 		TenantID:    req.TenantID,
+		ParentID:    req.ParentID,
 		Name:        req.Name,
 		Description: req.Description,
+		Attributes:  req.Attributes,
 	}
 
 	if err := s.repo.Create(ctx, category); err != nil {
 		return nil, err
 	}
 
-	return category, nil
+	// Se relee para devolver el breadcrumb armado desde la base
+	return s.repo.GetByID(ctx, req.TenantID, category.ID)
 }
 
 func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Category, error) {
 	return s.repo.GetByID(ctx, tenantID, id)
 }
 
-func (s *Service) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entities.Category, error) {
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, params query.Params, limit, offset int) ([]*entities.Category, error) {
 	if limit <= 0 {
 		limit = s.config.Pagination.DefaultLimit
 	}
@@ -77,7 +100,7 @@ func (s *Service) List(ctx context.Context, tenantID uuid.UUID, limit, offset in
 		offset = 0
 	}
 
-	categories, err := s.repo.List(ctx, tenantID, limit, offset)
+	categories, err := s.repo.List(ctx, tenantID, params, limit, offset)
 	if err != nil {
 		s.logger.Error("Error listing categories", logger.Error(err), logger.String("tenant_id", tenantID.String()))
 		return nil, err
@@ -98,7 +121,7 @@ func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Cate
 		}
 
 		if *req.Name != category.Name {
-			exists, err := s.repo.ExistsByName(ctx, req.TenantID, *req.Name)
+			exists, err := s.repo.ExistsByName(ctx, req.TenantID, category.ParentID, *req.Name)
 			if err != nil {
 				return nil, err
 			}
@@ -113,10 +136,77 @@ func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Cate
 		category.Description = req.Description
 	}
 
+	if req.Attributes != nil {
+		if err := req.Attributes.Validate(); err != nil {
+			return nil, err
+		}
+		category.Attributes = req.Attributes
+	}
+
 	if err := s.repo.Update(ctx, category); err != nil {
 		return nil, err
 	}
 
+	return s.repo.GetByID(ctx, req.TenantID, category.ID)
+}
+
+// AttributeSchema devuelve el esquema efectivo de la categoría: el suyo más el
+// heredado de sus ancestras.
+func (s *Service) AttributeSchema(ctx context.Context, tenantID, id uuid.UUID) (entities.AttributeSchema, error) {
+	schemas, err := s.repo.AttributeSchemas(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return entities.MergeAttributeSchemas(schemas...), nil
+}
+
+// Move cuelga la categoría de parentID, o la pasa a la raíz si es nil. Sus
+// subcategorías y productos se mueven con ella.
+func (s *Service) Move(ctx context.Context, tenantID, id uuid.UUID, parentID *uuid.UUID) (*entities.Category, error) {
+	var category *entities.Category
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.LockTree(ctx, tenantID); err != nil {
+			return err
+		}
+
+		var err error
+		category, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		var parent *entities.Category
+		if parentID != nil {
+			if parent, err = s.parent(ctx, tenantID, *parentID); err != nil {
+				return err
+			}
+		}
+		if err := category.CanMoveUnder(parent); err != nil {
+			return err
+		}
+		if sameParent(category.ParentID, parentID) {
+			return nil
+		}
+
+		exists, err := s.repo.ExistsByName(ctx, tenantID, parentID, category.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return entities.ErrCategoryNameExists
+		}
+
+		category.ParentID = parentID
+		if err := s.repo.Update(ctx, category); err != nil {
+			return err
+		}
+		category, err = s.repo.GetByID(ctx, tenantID, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return category, nil
 }
 
@@ -129,9 +219,69 @@ func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
 		return entities.ErrCategoryHasProducts
 	}
 
+	hasChildren, err := s.repo.HasChildren(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if hasChildren {
+		return entities.ErrCategoryHasChildren
+	}
+
 	return s.repo.Delete(ctx, tenantID, id)
 }
 
+// Restore recupera una categoría borrada lógicamente. Su padre tiene que seguir
+// vivo y ninguna hermana puede haber tomado su nombre.
+func (s *Service) Restore(ctx context.Context, tenantID, id uuid.UUID) (*entities.Category, error) {
+	var category *entities.Category
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.LockTree(ctx, tenantID); err != nil {
+			return err
+		}
+		if err := s.repo.Restore(ctx, tenantID, id); err != nil {
+			return err
+		}
+
+		var err error
+		category, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if category.ParentID != nil {
+			if _, err := s.parent(ctx, tenantID, *category.ParentID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// PurgeDeleted borra definitivamente las categorías borradas antes de before.
+func (s *Service) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return s.repo.PurgeDeleted(ctx, before, batchSize)
+}
+
+// parent devuelve la categoría padre; si no existe el error es de validación.
+func (s *Service) parent(ctx context.Context, tenantID, id uuid.UUID) (*entities.Category, error) {
+	parent, err := s.repo.GetByID(ctx, tenantID, id)
+	if errors.Is(err, entities.ErrCategoryNotFound) {
+		return nil, entities.ErrInvalidCategoryParent
+	}
+	return parent, err
+}
+
+func sameParent(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func (s *Service) validateName(name string) error {
 	if name == "" {
 		return entities.ErrInvalidCategoryName
@@ -0,0 +1,136 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CountSession struct {
+	ID          uuid.UUID          `json:"id"`
+	TenantID    uuid.UUID          `json:"tenant_id"`
+	StoreID     uuid.UUID          `json:"store_id"`
+	CategoryID  *uuid.UUID         `json:"category_id,omitempty"`
+	Status      CountSessionStatus `json:"status"`
+	Notes       *string            `json:"notes,omitempty"`
+	Lines       []CountLine        `json:"lines"`
+	CreatedBy   *string            `json:"created_by,omitempty"`
+	SubmittedAt *time.Time         `json:"submitted_at,omitempty"`
+	SubmittedBy *string            `json:"submitted_by,omitempty"`
+	ApprovedAt  *time.Time         `json:"approved_at,omitempty"`
+	ApprovedBy  *string            `json:"approved_by,omitempty"`
+	CancelledAt *time.Time         `json:"cancelled_at,omitempty"`
+	CancelledBy *string            `json:"cancelled_by,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// CountLine guarda la cantidad esperada y el costo promedio del producto al abrir
+// la sesión, junto con lo que contó cada dispositivo.
+type CountLine struct {
+	ID               uuid.UUID     `json:"id"`
+	SessionID        uuid.UUID     `json:"session_id"`
+	ProductID        uuid.UUID     `json:"product_id"`
+	ExpectedQuantity int           `json:"expected_quantity"`
+	UnitCost         money.Amount  `json:"unit_cost"`
+	Counts           []DeviceCount `json:"counts"`
+}
+
+// DeviceCount es lo que contó un dispositivo de un producto. Un nuevo conteo del
+// mismo dispositivo reemplaza al anterior.
+type DeviceCount struct {
+	ProductID uuid.UUID `json:"product_id"`
+	DeviceID  string    `json:"device_id"`
+	Quantity  int       `json:"quantity"`
+	CountedBy *string   `json:"counted_by,omitempty"`
+	CountedAt time.Time `json:"counted_at"`
+}
+
+// IsCounted indica si algún dispositivo contó el producto. Las líneas sin contar
+// no generan diferencias.
+func (l *CountLine) IsCounted() bool {
+	return len(l.Counts) > 0
+}
+
+// CountedQuantity suma lo contado por todos los dispositivos.
+func (l *CountLine) CountedQuantity() int {
+	total := 0
+	for _, c := range l.Counts {
+		total += c.Quantity
+	}
+	return total
+}
+
+// Variance es la diferencia entre lo contado y lo esperado: positiva si sobran
+// unidades, negativa si faltan.
+func (l *CountLine) Variance() int {
+	if !l.IsCounted() {
+		return 0
+	}
+	return l.CountedQuantity() - l.ExpectedQuantity
+}
+
+// VarianceValue valoriza la diferencia al costo promedio de la foto.
+func (l *CountLine) VarianceValue() money.Amount {
+	return l.UnitCost.MulInt(l.Variance())
+}
+
+func (c *CountSession) Line(productID uuid.UUID) *CountLine {
+	for i := range c.Lines {
+		if c.Lines[i].ProductID == productID {
+			return &c.Lines[i]
+		}
+	}
+	return nil
+}
+
+// VarianceSummary resume las diferencias de una sesión.
+type VarianceSummary struct {
+	Lines         int          `json:"lines"`
+	CountedLines  int          `json:"counted_lines"`
+	VarianceLines int          `json:"variance_lines"`
+	UnitsOver     int          `json:"units_over"`
+	UnitsShort    int          `json:"units_short"`
+	ValueOver     money.Amount `json:"value_over"`
+	ValueShort    money.Amount `json:"value_short"`
+	NetUnits      int          `json:"net_units"`
+	NetValue      money.Amount `json:"net_value"`
+	ExpectedValue money.Amount `json:"expected_value"`
+	AccuracyPct   float64      `json:"accuracy_pct"`
+}
+
+// Summary calcula las diferencias de la sesión. La exactitud es el porcentaje de
+// líneas contadas que coincidieron con lo esperado.
+func (c *CountSession) Summary() VarianceSummary {
+	summary := VarianceSummary{Lines: len(c.Lines)}
+	for i := range c.Lines {
+		line := &c.Lines[i]
+		summary.ExpectedValue += line.UnitCost.MulInt(line.ExpectedQuantity)
+		if !line.IsCounted() {
+			continue
+		}
+		summary.CountedLines++
+
+		variance := line.Variance()
+		if variance == 0 {
+			continue
+		}
+		summary.VarianceLines++
+		if variance > 0 {
+			summary.UnitsOver += variance
+			summary.ValueOver += line.VarianceValue()
+		} else {
+			summary.UnitsShort -= variance
+			summary.ValueShort -= line.VarianceValue()
+		}
+	}
+
+	summary.NetUnits = summary.UnitsOver - summary.UnitsShort
+	summary.NetValue = summary.ValueOver - summary.ValueShort
+	if summary.CountedLines > 0 {
+		matched := summary.CountedLines - summary.VarianceLines
+		summary.AccuracyPct = float64(matched) * 100 / float64(summary.CountedLines)
+	}
+	return summary
+}
@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"testing"
+
+	"motico-api/pkg/money"
+)
+
+func TestCountSessionStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from CountSessionStatus
+		to   CountSessionStatus
+		want bool
+	}{
+		{CountSessionStatusOpen, CountSessionStatusSubmitted, true},
+		{CountSessionStatusOpen, CountSessionStatusApproved, false},
+		{CountSessionStatusSubmitted, CountSessionStatusApproved, true},
+		{CountSessionStatusSubmitted, CountSessionStatusOpen, true},
+		{CountSessionStatusApproved, CountSessionStatusOpen, false},
+		{CountSessionStatusCancelled, CountSessionStatusOpen, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCountLineSumsDevices(t *testing.T) {
+	line := CountLine{
+		ExpectedQuantity: 10,
+		Counts:           []DeviceCount{{DeviceID: "a", Quantity: 4}, {DeviceID: "b", Quantity: 3}},
+	}
+
+	if got := line.CountedQuantity(); got != 7 {
+		t.Errorf("counted: got %d, want 7", got)
+	}
+	if got := line.Variance(); got != -3 {
+		t.Errorf("variance: got %d, want -3", got)
+	}
+
+	uncounted := CountLine{ExpectedQuantity: 5}
+	if got := uncounted.Variance(); got != 0 {
+		t.Errorf("uncounted variance: got %d, want 0", got)
+	}
+}
+
+func TestCountSessionSummary(t *testing.T) {
+	session := &CountSession{Lines: []CountLine{
+		{ExpectedQuantity: 10, UnitCost: money.FromInt(2), Counts: []DeviceCount{{Quantity: 12}}},
+		{ExpectedQuantity: 5, UnitCost: money.FromInt(10), Counts: []DeviceCount{{Quantity: 4}}},
+		{ExpectedQuantity: 3, UnitCost: money.FromInt(1), Counts: []DeviceCount{{Quantity: 3}}},
+		{ExpectedQuantity: 7, UnitCost: money.FromInt(1)},
+	}}
+
+	summary := session.Summary()
+	if summary.CountedLines != 3 || summary.VarianceLines != 2 {
+		t.Fatalf("lines: got counted %d variance %d", summary.CountedLines, summary.VarianceLines)
+	}
+	if summary.UnitsOver != 2 || summary.UnitsShort != 1 || summary.NetUnits != 1 {
+		t.Errorf("units: got over %d short %d net %d", summary.UnitsOver, summary.UnitsShort, summary.NetUnits)
+	}
+	if summary.ValueOver != money.FromInt(4) || summary.ValueShort != money.FromInt(10) || summary.NetValue != money.FromInt(-6) {
+		t.Errorf("value: got over %s short %s net %s", summary.ValueOver, summary.ValueShort, summary.NetValue)
+	}
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrCountSessionNotFound     = apperror.New(apperror.KindNotFound, "count_session_not_found", "count session not found")
+	ErrInvalidStatusTransition  = apperror.New(apperror.KindConflict, "invalid_status_transition", "invalid count session status transition")
+	ErrInvalidStore             = apperror.New(apperror.KindUnprocessable, "invalid_store", "store not found")
+	ErrInvalidCategory          = apperror.New(apperror.KindUnprocessable, "invalid_category", "category not found")
+	ErrSessionAlreadyActive     = apperror.New(apperror.KindConflict, "session_already_active", "another count session is already active for these products")
+	ErrSessionHasNoProducts     = apperror.New(apperror.KindUnprocessable, "session_has_no_products", "there are no products to count in this store")
+	ErrSessionNotOpen           = apperror.New(apperror.KindConflict, "session_not_open", "counts can only be recorded while the session is open")
+	ErrCountHasNoLines          = apperror.New(apperror.KindInvalid, "count_has_no_lines", "count must have at least one line")
+	ErrDeviceRequired           = apperror.New(apperror.KindInvalid, "device_required", "device_id is required")
+	ErrDuplicateProduct         = apperror.New(apperror.KindInvalid, "duplicate_product", "a product can only appear once per count")
+	ErrInvalidQuantity          = apperror.New(apperror.KindInvalid, "invalid_quantity", "counted quantity must be greater than or equal to zero")
+	ErrProductNotInSession      = apperror.New(apperror.KindUnprocessable, "product_not_in_session", "product is not part of this count session")
+	ErrApprovalRequiresManager  = apperror.New(apperror.KindForbidden, "approval_requires_manager", "only a manager can approve a count session")
+	ErrVarianceExceedsAvailable = apperror.New(apperror.KindConflict, "variance_exceeds_available", "variance would leave less stock than the units reserved or in quarantine")
+	ErrLotSurplus               = apperror.New(apperror.KindConflict, "lot_surplus", "surplus of a lot-tracked product must be adjusted by lot")
+	ErrSerialVariance           = apperror.New(apperror.KindConflict, "serial_variance", "variance of a serial-tracked product must be adjusted by serial")
+	ErrProductArchived          = apperror.New(apperror.KindConflict, "product_archived", "product or its store is archived")
+)
@@ -0,0 +1,62 @@
+package entities
+
+import "time"
+
+type CountSessionStatus string
+
+const (
+	CountSessionStatusOpen      CountSessionStatus = "open"
+	CountSessionStatusSubmitted CountSessionStatus = "submitted"
+	CountSessionStatusApproved  CountSessionStatus = "approved"
+	CountSessionStatusCancelled CountSessionStatus = "cancelled"
+)
+
+// countSessionTransitions: una sesión enviada vuelve a abrirse si el encargado
+// pide recontar, y solo al aprobarse sus diferencias llegan al stock.
+var countSessionTransitions = map[CountSessionStatus][]CountSessionStatus{
+	CountSessionStatusOpen:      {CountSessionStatusSubmitted, CountSessionStatusCancelled},
+	CountSessionStatusSubmitted: {CountSessionStatusApproved, CountSessionStatusOpen, CountSessionStatusCancelled},
+}
+
+func (s CountSessionStatus) IsValid() bool {
+	switch s {
+	case CountSessionStatusOpen, CountSessionStatusSubmitted, CountSessionStatusApproved, CountSessionStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (s CountSessionStatus) CanTransitionTo(next CountSessionStatus) bool {
+	for _, allowed := range countSessionTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s CountSessionStatus) IsFinal() bool {
+	return len(countSessionTransitions[s]) == 0
+}
+
+// TransitionTo mueve la sesión al siguiente estado registrando quién y cuándo
+// ejecutó la acción. Reabrir descarta el envío anterior.
+func (c *CountSession) TransitionTo(next CountSessionStatus, actor string, at time.Time) error {
+	if !c.Status.CanTransitionTo(next) {
+		return ErrInvalidStatusTransition
+	}
+
+	switch next {
+	case CountSessionStatusOpen:
+		c.SubmittedAt, c.SubmittedBy = nil, nil
+	case CountSessionStatusSubmitted:
+		c.SubmittedAt, c.SubmittedBy = &at, &actor
+	case CountSessionStatusApproved:
+		c.ApprovedAt, c.ApprovedBy = &at, &actor
+	case CountSessionStatusCancelled:
+		c.CancelledAt, c.CancelledBy = &at, &actor
+	}
+
+	c.Status = next
+	return nil
+}
@@ -0,0 +1,28 @@
+package cyclecount
+
+import (
+	"context"
+	"motico-api/internal/domain/cyclecount/entities"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+)
+
+// ListFilter acota el listado de sesiones de conteo.
+type ListFilter struct {
+	Status  *entities.CountSessionStatus
+	StoreID *uuid.UUID
+	Query   query.Params
+}
+
+type Repository interface {
+	// Create guarda la sesión y la foto del stock de los productos a contar.
+	Create(ctx context.Context, session *entities.CountSession) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.CountSession, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.CountSession, error)
+	Update(ctx context.Context, session *entities.CountSession) error
+	// HasActiveSession indica si hay una sesión abierta o enviada que cubre los
+	// mismos productos de la sucursal.
+	HasActiveSession(ctx context.Context, tenantID, storeID uuid.UUID, categoryID *uuid.UUID) (bool, error)
+	SaveCounts(ctx context.Context, tenantID, sessionID uuid.UUID, counts []entities.DeviceCount) error
+}
@@ -0,0 +1,302 @@
+package cyclecount
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	"motico-api/internal/domain/auth"
+	categorydomain "motico-api/internal/domain/category"
+	"motico-api/internal/domain/cyclecount/entities"
+	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	storedomain "motico-api/internal/domain/store"
+	"motico-api/internal/domain/transaction"
+	"motico-api/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const movementReferenceType = "count_session"
+
+type Service struct {
+	repo         Repository
+	stockService *stock.Service
+	storeRepo    storedomain.Repository
+	categoryRepo categorydomain.Repository
+	txManager    transaction.Manager
+	config       *config.Config
+	logger       logger.Logger
+}
+
+func NewService(repo Repository, stockService *stock.Service, storeRepo storedomain.Repository, categoryRepo categorydomain.Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:         repo,
+		stockService: stockService,
+		storeRepo:    storeRepo,
+		categoryRepo: categoryRepo,
+		txManager:    txManager,
+		config:       cfg,
+		logger:       log,
+	}
+}
+
+type CreateRequest struct {
+	TenantID   uuid.UUID
+	StoreID    uuid.UUID
+	CategoryID *uuid.UUID
+	Notes      *string
+	CreatedBy  string
+}
+
+type CountRequest struct {
+	ProductID uuid.UUID
+	Quantity  int
+}
+
+// RecordCountsRequest trae lo contado por un dispositivo. Cada producto reemplaza
+// el conteo anterior del mismo dispositivo.
+type RecordCountsRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	DeviceID string
+	Actor    string
+	Lines    []CountRequest
+}
+
+// ActionRequest identifica la sesión sobre la que se ejecuta una acción y quién la ejecuta.
+type ActionRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Actor    string
+}
+
+// ApproveRequest lleva además el rol de quien aprueba.
+type ApproveRequest struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Actor     string
+	ActorRole auth.Role
+}
+
+// Create abre una sesión con la foto de las cantidades esperadas de los productos
+// de la sucursal (o de una categoría). No puede haber dos sesiones activas sobre
+// los mismos productos porque sus diferencias se aplicarían dos veces.
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.CountSession, error) {
+	if _, err := s.storeRepo.GetByID(ctx, req.TenantID, req.StoreID); err != nil {
+		return nil, entities.ErrInvalidStore
+	}
+	if req.CategoryID != nil {
+		if _, err := s.categoryRepo.GetByID(ctx, req.TenantID, *req.CategoryID); err != nil {
+			return nil, entities.ErrInvalidCategory
+		}
+	}
+
+	session := &entities.CountSession{
+		TenantID:   req.TenantID,
+		StoreID:    req.StoreID,
+		CategoryID: req.CategoryID,
+		Status:     entities.CountSessionStatusOpen,
+		Notes:      req.Notes,
+	}
+	if req.CreatedBy != "" {
+		session.CreatedBy = &req.CreatedBy
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		active, err := s.repo.HasActiveSession(ctx, req.TenantID, req.StoreID, req.CategoryID)
+		if err != nil {
+			return err
+		}
+		if active {
+			return entities.ErrSessionAlreadyActive
+		}
+		if err := s.repo.Create(ctx, session); err != nil {
+			return err
+		}
+		if len(session.Lines) == 0 {
+			return entities.ErrSessionHasNoProducts
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.CountSession, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.CountSession, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, filter, limit, offset)
+}
+
+// RecordCounts registra lo contado por un dispositivo mientras la sesión está abierta.
+func (s *Service) RecordCounts(ctx context.Context, req RecordCountsRequest) (*entities.CountSession, error) {
+	if req.DeviceID == "" {
+		return nil, entities.ErrDeviceRequired
+	}
+	if len(req.Lines) == 0 {
+		return nil, entities.ErrCountHasNoLines
+	}
+
+	session, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != entities.CountSessionStatusOpen {
+		return nil, entities.ErrSessionNotOpen
+	}
+
+	now := time.Now()
+	seen := make(map[uuid.UUID]bool, len(req.Lines))
+	counts := make([]entities.DeviceCount, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		if line.Quantity < 0 {
+			return nil, entities.ErrInvalidQuantity
+		}
+		if seen[line.ProductID] {
+			return nil, entities.ErrDuplicateProduct
+		}
+		seen[line.ProductID] = true
+		if session.Line(line.ProductID) == nil {
+			return nil, entities.ErrProductNotInSession
+		}
+
+		count := entities.DeviceCount{
+			ProductID: line.ProductID,
+			DeviceID:  req.DeviceID,
+			Quantity:  line.Quantity,
+			CountedAt: now,
+		}
+		if req.Actor != "" {
+			count.CountedBy = &req.Actor
+		}
+		counts = append(counts, count)
+	}
+
+	if err := s.repo.SaveCounts(ctx, req.TenantID, session.ID, counts); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, req.TenantID, req.ID)
+}
+
+// Submit cierra el conteo y deja la sesión esperando la aprobación de un encargado.
+func (s *Service) Submit(ctx context.Context, req ActionRequest) (*entities.CountSession, error) {
+	return s.transition(ctx, req, entities.CountSessionStatusSubmitted)
+}
+
+// Reopen devuelve una sesión enviada al conteo, por ejemplo para recontar las diferencias.
+func (s *Service) Reopen(ctx context.Context, req ActionRequest) (*entities.CountSession, error) {
+	return s.transition(ctx, req, entities.CountSessionStatusOpen)
+}
+
+// Cancel descarta la sesión sin tocar el stock.
+func (s *Service) Cancel(ctx context.Context, req ActionRequest) (*entities.CountSession, error) {
+	return s.transition(ctx, req, entities.CountSessionStatusCancelled)
+}
+
+// Approve aplica las diferencias de las líneas contadas como ajustes de stock
+// vinculados a la sesión. Se ajusta la diferencia contra la foto y no la cantidad
+// contada, así las ventas y recepciones ocurridas durante el conteo se conservan.
+// Las unidades que aparecen entran al costo promedio de la foto.
+func (s *Service) Approve(ctx context.Context, req ApproveRequest) (*entities.CountSession, error) {
+	if !req.ActorRole.IsAtLeast(auth.RoleManager) {
+		return nil, entities.ErrApprovalRequiresManager
+	}
+
+	session, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.TransitionTo(entities.CountSessionStatusApproved, req.Actor, time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, session); err != nil {
+			return err
+		}
+		for i := range session.Lines {
+			if err := s.applyVariance(ctx, session, &session.Lines[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *Service) transition(ctx context.Context, req ActionRequest, next entities.CountSessionStatus) (*entities.CountSession, error) {
+	session, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.TransitionTo(next, req.Actor, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *Service) applyVariance(ctx context.Context, session *entities.CountSession, line *entities.CountLine) error {
+	variance := line.Variance()
+	if variance == 0 {
+		return nil
+	}
+
+	adjust := stock.AdjustRequest{
+		TenantID:  session.TenantID,
+		ProductID: line.ProductID,
+		Amount:    variance,
+		Reason:    stockentities.MovementReasonCycleCount,
+		Reference: movementReference(session),
+	}
+	if variance > 0 {
+		unitCost := line.UnitCost
+		adjust.UnitCost = &unitCost
+	}
+
+	_, err := s.stockService.Adjust(ctx, adjust)
+	switch {
+	case errors.Is(err, stockentities.ErrInsufficientStock), errors.Is(err, stockentities.ErrInvalidReservedAmount), errors.Is(err, stockentities.ErrInsufficientLotStock):
+		return entities.ErrVarianceExceedsAvailable
+	case errors.Is(err, stockentities.ErrLotRequired):
+		// Un sobrante no dice a qué lote pertenece: se ajusta aparte por lote.
+		return entities.ErrLotSurplus
+	case errors.Is(err, stockentities.ErrSerialsRequired):
+		// El conteo no dice qué unidades faltan o sobran: se ajusta aparte por serie.
+		return entities.ErrSerialVariance
+	case errors.Is(err, stockentities.ErrProductArchived):
+		return entities.ErrProductArchived
+	}
+	return err
+}
+
+func movementReference(session *entities.CountSession) *stockentities.MovementReference {
+	return &stockentities.MovementReference{Type: movementReferenceType, ID: session.ID}
+}
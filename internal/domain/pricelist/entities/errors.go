@@ -0,0 +1,12 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrPriceListNotFound      = apperror.New(apperror.KindNotFound, "price_list_not_found", "price list not found")
+	ErrInvalidPriceListName   = apperror.New(apperror.KindInvalid, "invalid_price_list_name", "price list name is invalid")
+	ErrInvalidValidityPeriod  = apperror.New(apperror.KindInvalid, "invalid_validity_period", "valid_to must be after valid_from")
+	ErrDuplicatePriceListItem = apperror.New(apperror.KindInvalid, "duplicate_price_list_item", "a catalog item can only appear once per price list")
+	ErrCurrencyMismatch       = apperror.New(apperror.KindInvalid, "currency_mismatch", "catalog item currency does not match the price list currency")
+	ErrInvalidPriceListStore  = apperror.New(apperror.KindInvalid, "invalid_price_list_store", "store does not exist for this tenant")
+)
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PriceList fija precios de artículos del catálogo para una sucursal durante un
+// período. Sus precios tienen prioridad sobre el precio local y el del catálogo.
+type PriceList struct {
+	ID        uuid.UUID       `json:"id"`
+	TenantID  uuid.UUID       `json:"tenant_id"`
+	StoreID   uuid.UUID       `json:"store_id"`
+	Name      string          `json:"name"`
+	Currency  money.Currency  `json:"currency"`
+	ValidFrom time.Time       `json:"valid_from"`
+	ValidTo   *time.Time      `json:"valid_to,omitempty"`
+	Items     []PriceListItem `json:"items"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type PriceListItem struct {
+	ID            uuid.UUID    `json:"id"`
+	CatalogItemID uuid.UUID    `json:"catalog_item_id"`
+	Price         money.Amount `json:"price"`
+}
+
+// IsActiveAt indica si la lista está vigente en el instante t; valid_to es excluyente.
+func (l *PriceList) IsActiveAt(t time.Time) bool {
+	if t.Before(l.ValidFrom) {
+		return false
+	}
+	return l.ValidTo == nil || t.Before(*l.ValidTo)
+}
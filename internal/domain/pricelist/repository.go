@@ -0,0 +1,16 @@
+package pricelist
+
+import (
+	"context"
+	"motico-api/internal/domain/pricelist/entities"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, priceList *entities.PriceList) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PriceList, error)
+	List(ctx context.Context, tenantID uuid.UUID, storeID *uuid.UUID, limit, offset int) ([]*entities.PriceList, error)
+	Update(ctx context.Context, priceList *entities.PriceList) error
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+}
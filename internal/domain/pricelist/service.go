@@ -0,0 +1,177 @@
+package pricelist
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/pricelist/entities"
+	storedomain "motico-api/internal/domain/store"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo        Repository
+	catalogRepo catalog.Repository
+	storeRepo   storedomain.Repository
+	config      *config.Config
+	logger      logger.Logger
+}
+
+func NewService(repo Repository, catalogRepo catalog.Repository, storeRepo storedomain.Repository, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:        repo,
+		catalogRepo: catalogRepo,
+		storeRepo:   storeRepo,
+		config:      cfg,
+		logger:      log,
+	}
+}
+
+type ItemRequest struct {
+	CatalogItemID uuid.UUID
+	Price         money.Amount
+}
+
+type CreateRequest struct {
+	TenantID  uuid.UUID
+	StoreID   uuid.UUID
+	Name      string
+	Currency  *money.Currency
+	ValidFrom time.Time
+	ValidTo   *time.Time
+	Items     []ItemRequest
+}
+
+// UpdateRequest reemplaza la lista completa, incluidos sus artículos.
+type UpdateRequest struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Name      string
+	ValidFrom time.Time
+	ValidTo   *time.Time
+	Items     []ItemRequest
+}
+
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.PriceList, error) {
+	if _, err := s.storeRepo.GetByID(ctx, req.TenantID, req.StoreID); err != nil {
+		if errors.Is(err, storeentities.ErrStoreNotFound) {
+			return nil, entities.ErrInvalidPriceListStore
+		}
+		return nil, err
+	}
+
+	var currency money.Currency
+	var err error
+	if req.Currency != nil {
+		currency, err = money.ParseCurrency(string(*req.Currency))
+	} else {
+		currency, err = s.catalogRepo.TenantCurrency(ctx, req.TenantID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	priceList := &entities.PriceList{
+		TenantID:  req.TenantID,
+		StoreID:   req.StoreID,
+		Name:      req.Name,
+		Currency:  currency,
+		ValidFrom: req.ValidFrom,
+		ValidTo:   req.ValidTo,
+	}
+	if err := s.applyItems(ctx, priceList, req.Items); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, priceList); err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PriceList, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, storeID *uuid.UUID, limit, offset int) ([]*entities.PriceList, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, storeID, limit, offset)
+}
+
+func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.PriceList, error) {
+	priceList, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	priceList.Name = req.Name
+	priceList.ValidFrom = req.ValidFrom
+	priceList.ValidTo = req.ValidTo
+	if err := s.applyItems(ctx, priceList, req.Items); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, priceList); err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	return s.repo.Delete(ctx, tenantID, id)
+}
+
+// applyItems valida la cabecera y los artículos y los asigna a la lista. Cada
+// precio debe estar en la moneda de la lista y respetar sus decimales.
+func (s *Service) applyItems(ctx context.Context, priceList *entities.PriceList, items []ItemRequest) error {
+	if priceList.Name == "" || len(priceList.Name) > s.config.Validation.MaxNameLength {
+		return entities.ErrInvalidPriceListName
+	}
+	if priceList.ValidTo != nil && !priceList.ValidTo.After(priceList.ValidFrom) {
+		return entities.ErrInvalidValidityPeriod
+	}
+
+	seen := make(map[uuid.UUID]bool, len(items))
+	priceList.Items = make([]entities.PriceListItem, 0, len(items))
+	for _, item := range items {
+		if seen[item.CatalogItemID] {
+			return entities.ErrDuplicatePriceListItem
+		}
+		seen[item.CatalogItemID] = true
+
+		catalogItem, err := s.catalogRepo.GetByID(ctx, priceList.TenantID, item.CatalogItemID)
+		if err != nil {
+			return err
+		}
+		if catalogItem.Currency != priceList.Currency {
+			return entities.ErrCurrencyMismatch
+		}
+		if err := money.ValidatePrice(item.Price, priceList.Currency); err != nil {
+			return err
+		}
+
+		priceList.Items = append(priceList.Items, entities.PriceListItem{
+			CatalogItemID: item.CatalogItemID,
+			Price:         item.Price,
+		})
+	}
+
+	return nil
+}
@@ -1,11 +1,33 @@
 package entities
 
-import "errors"
+import "motico-api/pkg/apperror"
 
 var (
-	ErrProductNotFound     = errors.New("product not found")
-	ErrProductSKUExists    = errors.New("product SKU already exists for this store")
-	ErrProductHasStock     = errors.New("product has stock and cannot be deleted")
-	ErrProductHasTransfers = errors.New("product has transfers and cannot be deleted")
-	ErrInvalidProductName  = errors.New("product name is invalid")
+	ErrProductNotFound         = apperror.New(apperror.KindNotFound, "product_not_found", "product not found")
+	ErrProductSKUExists        = apperror.New(apperror.KindConflict, "product_sku_exists", "product SKU already exists for this store")
+	ErrProductHasStock         = apperror.New(apperror.KindConflict, "product_has_stock", "product has stock and cannot be deleted")
+	ErrProductHasTransfers     = apperror.New(apperror.KindConflict, "product_has_transfers", "product has transfers and cannot be deleted")
+	ErrInvalidProductName      = apperror.New(apperror.KindInvalid, "invalid_product_name", "product name is invalid")
+	ErrProductHasVariants      = apperror.New(apperror.KindConflict, "product_has_variants", "product has variants and cannot be deleted")
+	ErrProductIsVariant        = apperror.New(apperror.KindInvalid, "product_is_variant", "operation is not allowed on a product variant")
+	ErrInvalidProductOption    = apperror.New(apperror.KindInvalid, "invalid_product_option", "option must have a name and distinct non-empty values")
+	ErrDuplicateProductOption  = apperror.New(apperror.KindInvalid, "duplicate_product_option", "option names must be unique per product")
+	ErrProductHasNoOptions     = apperror.New(apperror.KindInvalid, "product_has_no_options", "product has no options to build variants from")
+	ErrTooManyVariants         = apperror.New(apperror.KindInvalid, "too_many_variants", "options exceed the maximum of 100 variant combinations")
+	ErrInvalidVariantOptions   = apperror.New(apperror.KindInvalid, "invalid_variant_options", "variant option values must match the product options")
+	ErrVariantExists           = apperror.New(apperror.KindConflict, "variant_exists", "a variant with these option values already exists")
+	ErrProductOptionInUse      = apperror.New(apperror.KindConflict, "product_option_in_use", "options are in use by existing variants")
+	ErrProductAlreadyListed    = apperror.New(apperror.KindConflict, "product_already_listed", "catalog item is already listed in this store")
+	ErrProductNotInStore       = apperror.New(apperror.KindNotFound, "product_not_in_store", "product is not listed in this store")
+	ErrInvalidSearchQuery      = apperror.New(apperror.KindInvalid, "invalid_search_query", "search query must contain at least one letter or digit")
+	ErrInvalidPriceRange       = apperror.New(apperror.KindInvalid, "invalid_price_range", "min_price must be less than or equal to max_price")
+	ErrProductCategoryNotFound = apperror.New(apperror.KindUnprocessable, "product_category_not_found", "product category not found")
+	ErrProductStoreNotFound    = apperror.New(apperror.KindUnprocessable, "product_store_not_found", "product store not found")
+	ErrProductParentNotFound   = apperror.New(apperror.KindUnprocessable, "product_parent_not_found", "parent product not found")
+	ErrProductArchived         = apperror.New(apperror.KindConflict, "product_archived", "product is archived")
+	ErrProductNotArchived      = apperror.New(apperror.KindConflict, "product_not_archived", "product is not archived")
+	ErrArchiveProductHasStock  = apperror.New(apperror.KindConflict, "archive_product_has_stock", "product or its variants have stock and cannot be archived")
+	ErrProductHasOpenTransfers = apperror.New(apperror.KindConflict, "product_has_open_transfers", "product or its variants are in open transfers and cannot be archived")
+	ErrProductStoreArchived    = apperror.New(apperror.KindUnprocessable, "product_store_archived", "product store is archived")
+	ErrProductParentArchived   = apperror.New(apperror.KindUnprocessable, "product_parent_archived", "parent product is archived")
 )
@@ -0,0 +1,142 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxVariantCombinations es el tope de combinaciones que pueden formar los ejes
+// de un producto, para que generar la matriz no cree miles de variantes de golpe.
+const MaxVariantCombinations = 100
+
+// ProductOption es un eje de variación del producto padre (talle, color...) con
+// los valores que puede tomar.
+type ProductOption struct {
+	ID        uuid.UUID `json:"id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	ProductID uuid.UUID `json:"product_id"`
+	Name      string    `json:"name"`
+	Position  int       `json:"position"`
+	Values    []string  `json:"values"`
+}
+
+func (o *ProductOption) HasValue(value string) bool {
+	for _, v := range o.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateOptions verifica que los ejes tengan nombre y valores no vacíos y sin
+// repetir, y que no formen más de MaxVariantCombinations combinaciones.
+func ValidateOptions(options []ProductOption) error {
+	names := make(map[string]bool, len(options))
+	for _, option := range options {
+		if strings.TrimSpace(option.Name) == "" || len(option.Values) == 0 {
+			return ErrInvalidProductOption
+		}
+		if names[option.Name] {
+			return ErrDuplicateProductOption
+		}
+		names[option.Name] = true
+
+		values := make(map[string]bool, len(option.Values))
+		for _, value := range option.Values {
+			if strings.TrimSpace(value) == "" || values[value] {
+				return ErrInvalidProductOption
+			}
+			values[value] = true
+		}
+	}
+	if variantCombinations(options) > MaxVariantCombinations {
+		return ErrTooManyVariants
+	}
+	return nil
+}
+
+// variantCombinations cuenta las combinaciones de los ejes; deja de multiplicar
+// al pasar MaxVariantCombinations para no desbordar con muchos ejes.
+func variantCombinations(options []ProductOption) int {
+	combinations := 1
+	for _, option := range options {
+		combinations *= len(option.Values)
+		if combinations > MaxVariantCombinations {
+			return combinations
+		}
+	}
+	return combinations
+}
+
+// MatchesOptions indica si la combinación de valores de una variante usa
+// exactamente los ejes definidos y valores permitidos en cada uno.
+func MatchesOptions(values map[string]string, options []ProductOption) bool {
+	if len(values) != len(options) {
+		return false
+	}
+	for i := range options {
+		value, ok := values[options[i].Name]
+		if !ok || !options[i].HasValue(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// VariantMatrix devuelve todas las combinaciones de valores de los ejes, en el
+// orden de los ejes y de sus valores. Devuelve ErrTooManyVariants si son más de
+// MaxVariantCombinations.
+func VariantMatrix(options []ProductOption) ([]map[string]string, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+	if variantCombinations(options) > MaxVariantCombinations {
+		return nil, ErrTooManyVariants
+	}
+
+	matrix := []map[string]string{{}}
+	for _, option := range options {
+		next := make([]map[string]string, 0, len(matrix)*len(option.Values))
+		for _, combination := range matrix {
+			for _, value := range option.Values {
+				extended := make(map[string]string, len(combination)+1)
+				for k, v := range combination {
+					extended[k] = v
+				}
+				extended[option.Name] = value
+				next = append(next, extended)
+			}
+		}
+		matrix = next
+	}
+	return matrix, nil
+}
+
+// VariantKey identifica una combinación de valores independientemente del orden del mapa.
+func VariantKey(values map[string]string, options []ProductOption) string {
+	parts := make([]string, len(options))
+	for i, option := range options {
+		parts[i] = values[option.Name]
+	}
+	return strings.Join(parts, "/")
+}
+
+// VariantName arma el nombre de la variante a partir del padre, p. ej. "Casco (M / Rojo)".
+func VariantName(parentName string, values map[string]string, options []ProductOption) string {
+	parts := make([]string, len(options))
+	for i, option := range options {
+		parts[i] = values[option.Name]
+	}
+	return parentName + " (" + strings.Join(parts, " / ") + ")"
+}
+
+// VariantSKU deriva el SKU de la variante del SKU del padre, p. ej. "CASCO-M-ROJO".
+func VariantSKU(parentSKU string, values map[string]string, options []ProductOption) string {
+	parts := []string{parentSKU}
+	for _, option := range options {
+		parts = append(parts, strings.ToUpper(strings.ReplaceAll(values[option.Name], " ", "")))
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestVariantMatrix(t *testing.T) {
+	options := []ProductOption{
+		{Name: "size", Values: []string{"S", "M", "L"}},
+		{Name: "color", Values: []string{"red", "black"}},
+	}
+
+	matrix, err := VariantMatrix(options)
+	if err != nil {
+		t.Fatalf("VariantMatrix: %v", err)
+	}
+	if len(matrix) != 6 {
+		t.Fatalf("expected 6 combinations, got %d", len(matrix))
+	}
+
+	seen := make(map[string]bool)
+	for _, values := range matrix {
+		if !MatchesOptions(values, options) {
+			t.Errorf("combination %v does not match options", values)
+		}
+		seen[VariantKey(values, options)] = true
+	}
+	if len(seen) != 6 {
+		t.Errorf("expected 6 distinct combinations, got %d", len(seen))
+	}
+	if key := VariantKey(matrix[0], options); key != "S/red" {
+		t.Errorf("expected first combination S/red, got %s", key)
+	}
+}
+
+func TestVariantMatrixLimit(t *testing.T) {
+	values := func(n int) []string {
+		out := make([]string, n)
+		for i := range out {
+			out[i] = strconv.Itoa(i)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		options []ProductOption
+		wantErr error
+	}{
+		{"at the limit", []ProductOption{{Name: "size", Values: values(10)}, {Name: "color", Values: values(10)}}, nil},
+		{"over the limit", []ProductOption{{Name: "size", Values: values(10)}, {Name: "color", Values: values(11)}}, ErrTooManyVariants},
+		{"many options", []ProductOption{
+			{Name: "a", Values: values(50)}, {Name: "b", Values: values(50)}, {Name: "c", Values: values(50)},
+			{Name: "d", Values: values(50)}, {Name: "e", Values: values(50)}, {Name: "f", Values: values(50)},
+		}, ErrTooManyVariants},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matrix, err := VariantMatrix(tt.options)
+			if err != tt.wantErr {
+				t.Fatalf("VariantMatrix: got %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && len(matrix) != MaxVariantCombinations {
+				t.Errorf("expected %d combinations, got %d", MaxVariantCombinations, len(matrix))
+			}
+			if err := ValidateOptions(tt.options); err != tt.wantErr {
+				t.Errorf("ValidateOptions: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatchesOptions(t *testing.T) {
+	options := []ProductOption{
+		{Name: "size", Values: []string{"S", "M"}},
+		{Name: "color", Values: []string{"red"}},
+	}
+
+	tests := []struct {
+		values map[string]string
+		want   bool
+	}{
+		{map[string]string{"size": "S", "color": "red"}, true},
+		{map[string]string{"size": "XL", "color": "red"}, false},
+		{map[string]string{"size": "S"}, false},
+		{map[string]string{"size": "S", "color": "red", "fit": "slim"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchesOptions(tt.values, options); got != tt.want {
+			t.Errorf("MatchesOptions(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestValidateOptions(t *testing.T) {
+	if err := ValidateOptions([]ProductOption{{Name: "size", Values: []string{"S", "S"}}}); err != ErrInvalidProductOption {
+		t.Errorf("expected ErrInvalidProductOption for repeated values, got %v", err)
+	}
+	if err := ValidateOptions([]ProductOption{
+		{Name: "size", Values: []string{"S"}},
+		{Name: "size", Values: []string{"M"}},
+	}); err != ErrDuplicateProductOption {
+		t.Errorf("expected ErrDuplicateProductOption, got %v", err)
+	}
+	if got := VariantSKU("HELMET", map[string]string{"size": "M", "color": "matte black"}, []ProductOption{
+		{Name: "size"}, {Name: "color"},
+	}); got != "HELMET-M-MATTEBLACK" {
+		t.Errorf("unexpected variant SKU %s", got)
+	}
+}
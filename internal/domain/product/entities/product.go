@@ -1,20 +1,72 @@
 package entities
 
 import (
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/pkg/money"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Product struct {
-	ID          uuid.UUID `json:"id"`
-	TenantID    uuid.UUID `json:"tenant_id"`
-	StoreID     uuid.UUID `json:"store_id"`
-	CategoryID  uuid.UUID `json:"category_id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description,omitempty"`
-	SKU         *string   `json:"sku,omitempty"`
-	Price       *float64  `json:"price,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            uuid.UUID      `json:"id"`
+	TenantID      uuid.UUID      `json:"tenant_id"`
+	StoreID       uuid.UUID      `json:"store_id"`
+	CategoryID    uuid.UUID      `json:"category_id"`
+	CatalogItemID uuid.UUID      `json:"catalog_item_id"`
+	ParentID      *uuid.UUID     `json:"parent_id,omitempty"`
+	Name          string         `json:"name"`
+	Description   *string        `json:"description,omitempty"`
+	SKU           *string        `json:"sku,omitempty"`
+	Price         *money.Amount  `json:"price,omitempty"`
+	CatalogPrice  *money.Amount  `json:"catalog_price,omitempty"`
+	ListPrice     *money.Amount  `json:"list_price,omitempty"`
+	Currency      money.Currency `json:"currency"`
+	// Tracking viene del artículo de catálogo
+	Tracking catalogentities.Tracking `json:"tracking"`
+	Active   bool                     `json:"active"`
+	Status   ProductStatus            `json:"status"`
+	// ArchivedAt es cuándo se archivó el producto; nil mientras está activo
+	ArchivedAt   *time.Time        `json:"archived_at,omitempty"`
+	OptionValues map[string]string `json:"option_values,omitempty"`
+	// Attributes son los valores de los atributos que define el esquema de la categoría
+	Attributes   map[string]interface{} `json:"attributes"`
+	VariantCount int                    `json:"variant_count"`
+	// Images son las imágenes adjuntas en orden; la primera es la principal
+	Images    []Image    `json:"images"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type Image struct {
+	ID           uuid.UUID `json:"id"`
+	URL          string    `json:"url"`
+	ThumbnailURL *string   `json:"thumbnail_url,omitempty"`
+}
+
+func (p *Product) IsVariant() bool {
+	return p.ParentID != nil
+}
+
+func (p *Product) HasVariants() bool {
+	return p.VariantCount > 0
+}
+
+// BasePrice es el precio propio de la publicación: el precio local si lo hay o el
+// precio por defecto del catálogo.
+func (p *Product) BasePrice() *money.Amount {
+	if p.Price != nil {
+		return p.Price
+	}
+	return p.CatalogPrice
+}
+
+// EffectivePrice es el precio de venta en la sucursal: el de la lista de precios
+// vigente si la hay y, si no, el precio base.
+func (p *Product) EffectivePrice() *money.Amount {
+	if p.ListPrice != nil {
+		return p.ListPrice
+	}
+	return p.BasePrice()
 }
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"testing"
+)
+
+func TestProductPrices(t *testing.T) {
+	catalog, local, list := money.FromInt(100), money.FromInt(120), money.FromInt(90)
+
+	tests := []struct {
+		name      string
+		product   Product
+		wantBase  *money.Amount
+		wantPrice *money.Amount
+	}{
+		{"catalog price only", Product{CatalogPrice: &catalog}, &catalog, &catalog},
+		{"local override", Product{Price: &local, CatalogPrice: &catalog}, &local, &local},
+		{"price list wins", Product{Price: &local, CatalogPrice: &catalog, ListPrice: &list}, &local, &list},
+		{"no price", Product{}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		if got := tt.product.BasePrice(); !samePrice(got, tt.wantBase) {
+			t.Errorf("%s: BasePrice() = %v, want %v", tt.name, got, tt.wantBase)
+		}
+		if got := tt.product.EffectivePrice(); !samePrice(got, tt.wantPrice) {
+			t.Errorf("%s: EffectivePrice() = %v, want %v", tt.name, got, tt.wantPrice)
+		}
+	}
+}
+
+func samePrice(a, b *money.Amount) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
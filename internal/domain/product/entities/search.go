@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SearchResult es un producto encontrado por la búsqueda junto con su relevancia.
+type SearchResult struct {
+	Product *Product
+	Rank    float64
+}
+
+// SearchTerms separa la consulta en palabras en minúsculas, descartando signos de
+// puntuación y cualquier carácter con significado en la sintaxis de tsquery.
+func SearchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// PrefixTSQuery arma un tsquery que exige todas las palabras y trata la última
+// como prefijo, para que la búsqueda funcione mientras el usuario escribe.
+func PrefixTSQuery(terms []string) string {
+	if len(terms) == 0 {
+		return ""
+	}
+	parts := make([]string, len(terms))
+	copy(parts, terms)
+	parts[len(parts)-1] += ":*"
+	return strings.Join(parts, " & ")
+}
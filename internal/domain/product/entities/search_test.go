@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"Aceite 10W-40", []string{"aceite", "10w", "40"}},
+		{"  casco   integral ", []string{"casco", "integral"}},
+		{"cámara & (rueda)|!", []string{"cámara", "rueda"}},
+		{"':*", []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := SearchTerms(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTerms(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixTSQuery(t *testing.T) {
+	if got := PrefixTSQuery([]string{"casco", "int"}); got != "casco & int:*" {
+		t.Errorf("unexpected tsquery %q", got)
+	}
+	if got := PrefixTSQuery([]string{"cas"}); got != "cas:*" {
+		t.Errorf("unexpected tsquery %q", got)
+	}
+	if got := PrefixTSQuery(nil); got != "" {
+		t.Errorf("expected empty tsquery, got %q", got)
+	}
+}
@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+type ProductStatus string
+
+const (
+	ProductStatusActive   ProductStatus = "active"
+	ProductStatusArchived ProductStatus = "archived"
+)
+
+func (s ProductStatus) IsValid() bool {
+	return s == ProductStatusActive || s == ProductStatusArchived
+}
+
+// IsArchived indica si el producto está retirado. A diferencia de Active, que solo
+// lo saca de la venta, un producto archivado tampoco admite traspasos ni ajustes.
+func (p *Product) IsArchived() bool {
+	return p.Status == ProductStatusArchived
+}
+
+func (p *Product) Archive(at time.Time) error {
+	if p.IsArchived() {
+		return ErrProductArchived
+	}
+	p.Status = ProductStatusArchived
+	p.ArchivedAt = &at
+	return nil
+}
+
+func (p *Product) Unarchive() error {
+	if !p.IsArchived() {
+		return ErrProductNotArchived
+	}
+	p.Status = ProductStatusActive
+	p.ArchivedAt = nil
+	return nil
+}
@@ -2,18 +2,70 @@ package product
 
 import (
 	"context"
+	catalogentities "motico-api/internal/domain/catalog/entities"
 	"motico-api/internal/domain/product/entities"
+	"motico-api/pkg/money"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// ListFilter acota el listado de productos. Por defecto solo se listan productos
+// de primer nivel; con ParentID se listan las variantes de ese producto. Fits deja
+// los repuestos compatibles con el vehículo. Sin Status se listan tanto los
+// productos activos como los archivados.
+type ListFilter struct {
+	StoreID         *uuid.UUID
+	CategoryID      *uuid.UUID
+	CatalogItemID   *uuid.UUID
+	ParentID        *uuid.UUID
+	Active          *bool
+	Status          *entities.ProductStatus
+	IncludeVariants bool
+	Fits            *catalogentities.Vehicle
+	InStock         bool
+	Query           query.Params
+}
+
+// SearchFilter describe una búsqueda de productos activos. Query es el texto original,
+// usado para la similitud de trigramas, y TSQuery su versión para texto completo.
+type SearchFilter struct {
+	Query      string
+	TSQuery    string
+	StoreID    *uuid.UUID
+	CategoryID *uuid.UUID
+	MinPrice   *money.Amount
+	MaxPrice   *money.Amount
+	InStock    bool
+}
+
 type Repository interface {
 	Create(ctx context.Context, product *entities.Product) error
 	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Product, error)
-	List(ctx context.Context, tenantID uuid.UUID, storeID, categoryID *uuid.UUID, limit, offset int) ([]*entities.Product, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.Product, error)
+	Search(ctx context.Context, tenantID uuid.UUID, filter SearchFilter, limit, offset int) ([]*entities.SearchResult, error)
+	ListVariants(ctx context.Context, tenantID, parentID uuid.UUID) ([]*entities.Product, error)
 	Update(ctx context.Context, product *entities.Product) error
+	// Delete marca el producto como borrado; las consultas dejan de verlo.
 	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	// Restore quita la marca de borrado. Devuelve ErrProductNotFound si el
+	// producto no existe o no está borrado.
+	Restore(ctx context.Context, tenantID, id uuid.UUID) error
+	// PurgeDeleted borra definitivamente los productos borrados antes de before
+	// y devuelve cuántos borró.
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error)
 	ExistsBySKU(ctx context.Context, tenantID, storeID uuid.UUID, sku string) (bool, error)
 	HasStock(ctx context.Context, tenantID, productID uuid.UUID) (bool, error)
+	// HasTransfers ignora los traspasos borrados.
 	HasTransfers(ctx context.Context, tenantID, productID uuid.UUID) (bool, error)
+	// HasOpenTransfers indica si el producto está en un traspaso sin terminar.
+	HasOpenTransfers(ctx context.Context, tenantID, productID uuid.UUID) (bool, error)
+	// ArchiveVariants archiva las variantes activas de un producto ya archivado con
+	// su mismo archived_at; UnarchiveVariants reactiva esas mismas variantes y se
+	// llama antes de reactivar el producto.
+	ArchiveVariants(ctx context.Context, tenantID, parentID uuid.UUID) error
+	UnarchiveVariants(ctx context.Context, tenantID, parentID uuid.UUID) error
+	ListOptions(ctx context.Context, tenantID, productID uuid.UUID) ([]entities.ProductOption, error)
+	ReplaceOptions(ctx context.Context, tenantID, productID uuid.UUID, options []entities.ProductOption) error
 }
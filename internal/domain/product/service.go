@@ -2,37 +2,63 @@ package product
 
 import (
 	"context"
+	"errors"
 	"motico-api/config"
+	"motico-api/internal/domain/catalog"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/category"
+	categoryentities "motico-api/internal/domain/category/entities"
 	"motico-api/internal/domain/product/entities"
+	storedomain "motico-api/internal/domain/store"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transaction"
 	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo   Repository
-	config *config.Config
-	logger logger.Logger
+	repo         Repository
+	catalogRepo  catalog.Repository
+	categoryRepo category.Repository
+	storeRepo    storedomain.Repository
+	txManager    transaction.Manager
+	config       *config.Config
+	logger       logger.Logger
 }
 
-func NewService(repo Repository, cfg *config.Config, log logger.Logger) *Service {
+func NewService(repo Repository, catalogRepo catalog.Repository, categoryRepo category.Repository, storeRepo storedomain.Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		config: cfg,
-		logger: log,
+		repo:         repo,
+		catalogRepo:  catalogRepo,
+		categoryRepo: categoryRepo,
+		storeRepo:    storeRepo,
+		txManager:    txManager,
+		config:       cfg,
+		logger:       log,
 	}
 }
 
+// CreateRequest publica un producto en una sucursal. Con CatalogItemID se publica
+// ese artículo del catálogo; si no, se usa el artículo con el mismo SKU o se crea uno.
 type CreateRequest struct {
-	TenantID    uuid.UUID
-	StoreID     uuid.UUID
-	CategoryID  uuid.UUID
-	Name        string
-	Description *string
-	SKU         *string
-	Price       *float64
+	TenantID      uuid.UUID
+	StoreID       uuid.UUID
+	CatalogItemID *uuid.UUID
+	CategoryID    uuid.UUID
+	Name          string
+	Description   *string
+	SKU           *string
+	Price         *money.Amount
+	Active        *bool
+	Attributes    map[string]interface{}
 }
 
+// UpdateRequest: con Attributes nil se conservan los atributos, que igual se
+// vuelven a validar si cambia la categoría.
 type UpdateRequest struct {
 	ID          uuid.UUID
 	TenantID    uuid.UUID
@@ -41,24 +67,12 @@ type UpdateRequest struct {
 	Name        *string
 	Description *string
 	SKU         *string
-	Price       *float64
+	Price       *money.Amount
+	Active      *bool
+	Attributes  map[string]interface{}
 }
 
 func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Product, error) {
-	if err := s.validateName(req.Name); err != nil {
-		return nil, err
-	}
-
-	if req.SKU != nil && *req.SKU != "" {
-		exists, err := s.repo.ExistsBySKU(ctx, req.TenantID, req.StoreID, *req.SKU)
-		if err != nil {
-			return nil, err
-		}
-		if exists {
-			return nil, entities.ErrProductSKUExists
-		}
-	}
-
 	product := &entities.Product{
 		TenantID:    req.TenantID,
 		StoreID:     req.StoreID,
@@ -67,20 +81,165 @@ func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Prod
 		Description: req.Description,
 		SKU:         req.SKU,
 		Price:       req.Price,
+		Active:      true,
+		Attributes:  req.Attributes,
 	}
+	if req.Active != nil {
+		product.Active = *req.Active
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.validateStore(ctx, product.TenantID, product.StoreID); err != nil {
+			return err
+		}
+
+		if err := s.linkCatalogItem(ctx, product, req.CatalogItemID); err != nil {
+			return err
+		}
+
+		if err := s.validateName(product.Name); err != nil {
+			return err
+		}
+
+		if err := s.validateAttributes(ctx, product); err != nil {
+			return err
+		}
+
+		if product.SKU != nil && *product.SKU != "" {
+			exists, err := s.repo.ExistsBySKU(ctx, product.TenantID, product.StoreID, *product.SKU)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return entities.ErrProductSKUExists
+			}
+		}
 
-	if err := s.repo.Create(ctx, product); err != nil {
+		return s.repo.Create(ctx, product)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return product, nil
 }
 
+// linkCatalogItem asocia la publicación con su artículo maestro: el indicado, el
+// del mismo SKU o uno nuevo creado con los datos de la publicación. Los datos
+// maestros del artículo prevalecen y el precio solo se guarda si difiere del catálogo.
+func (s *Service) linkCatalogItem(ctx context.Context, product *entities.Product, catalogItemID *uuid.UUID) error {
+	var item *catalogentities.CatalogItem
+	var err error
+
+	switch {
+	case catalogItemID != nil:
+		item, err = s.catalogRepo.GetByID(ctx, product.TenantID, *catalogItemID)
+	case product.SKU != nil && *product.SKU != "":
+		item, err = s.catalogRepo.GetBySKU(ctx, product.TenantID, *product.SKU)
+		if errors.Is(err, catalogentities.ErrCatalogItemNotFound) {
+			item, err = nil, nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if item == nil {
+		if err := s.validateName(product.Name); err != nil {
+			return err
+		}
+		currency := product.Currency
+		if currency == "" {
+			if currency, err = s.catalogRepo.TenantCurrency(ctx, product.TenantID); err != nil {
+				return err
+			}
+		}
+		item = &catalogentities.CatalogItem{
+			TenantID:     product.TenantID,
+			CategoryID:   product.CategoryID,
+			Name:         product.Name,
+			Description:  product.Description,
+			SKU:          product.SKU,
+			DefaultPrice: product.Price,
+			Currency:     currency,
+		}
+		if err := validatePrice(product.Price, currency); err != nil {
+			return err
+		}
+		if err := s.catalogRepo.Create(ctx, item); err != nil {
+			return err
+		}
+	}
+
+	product.CatalogItemID = item.ID
+	product.CategoryID = item.CategoryID
+	product.Name = item.Name
+	product.Description = item.Description
+	product.SKU = item.SKU
+	product.CatalogPrice = item.DefaultPrice
+	product.Currency = item.Currency
+	if err := validatePrice(product.Price, item.Currency); err != nil {
+		return err
+	}
+	if samePrice(product.Price, item.DefaultPrice) {
+		product.Price = nil
+	}
+
+	return nil
+}
+
 func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Product, error) {
 	return s.repo.GetByID(ctx, tenantID, id)
 }
 
-func (s *Service) List(ctx context.Context, tenantID uuid.UUID, storeID, categoryID *uuid.UUID, limit, offset int) ([]*entities.Product, error) {
+// LookupByBarcode resuelve el código al artículo del catálogo y devuelve su
+// publicación en la sucursal indicada.
+func (s *Service) LookupByBarcode(ctx context.Context, tenantID, storeID uuid.UUID, code string) (*entities.Product, error) {
+	barcode, err := s.catalogRepo.GetBarcode(ctx, tenantID, strings.TrimSpace(code))
+	if err != nil {
+		return nil, err
+	}
+
+	status := entities.ProductStatusActive
+	filter := ListFilter{StoreID: &storeID, CatalogItemID: &barcode.CatalogItemID, IncludeVariants: true, Status: &status}
+	products, err := s.repo.List(ctx, tenantID, filter, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, entities.ErrProductNotInStore
+	}
+
+	return products[0], nil
+}
+
+// Search busca productos por nombre, descripción y SKU ordenados por relevancia.
+func (s *Service) Search(ctx context.Context, tenantID uuid.UUID, filter SearchFilter, limit, offset int) ([]*entities.SearchResult, error) {
+	terms := entities.SearchTerms(filter.Query)
+	if len(terms) == 0 {
+		return nil, entities.ErrInvalidSearchQuery
+	}
+	filter.Query = strings.Join(terms, " ")
+	filter.TSQuery = entities.PrefixTSQuery(terms)
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, entities.ErrInvalidPriceRange
+	}
+
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.Search(ctx, tenantID, filter, limit, offset)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.Product, error) {
 	if limit <= 0 {
 		limit = s.config.Pagination.DefaultLimit
 	}
@@ -91,7 +250,7 @@ func (s *Service) List(ctx context.Context, tenantID uuid.UUID, storeID, categor
 		offset = 0
 	}
 
-	return s.repo.List(ctx, tenantID, storeID, categoryID, limit, offset)
+	return s.repo.List(ctx, tenantID, filter, limit, offset)
 }
 
 func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Product, error) {
@@ -125,15 +284,33 @@ func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Prod
 	}
 
 	if req.Price != nil {
+		if err := validatePrice(req.Price, product.Currency); err != nil {
+			return nil, err
+		}
 		product.Price = req.Price
 	}
 
-	if req.StoreID != nil {
+	if req.Active != nil {
+		product.Active = *req.Active
+	}
+
+	if req.StoreID != nil && *req.StoreID != product.StoreID {
+		if err := s.validateStore(ctx, req.TenantID, *req.StoreID); err != nil {
+			return nil, err
+		}
 		product.StoreID = *req.StoreID
 	}
 
-	if req.CategoryID != nil {
-		product.CategoryID = *req.CategoryID
+	if req.Attributes != nil || (req.CategoryID != nil && *req.CategoryID != product.CategoryID) {
+		if req.CategoryID != nil {
+			product.CategoryID = *req.CategoryID
+		}
+		if req.Attributes != nil {
+			product.Attributes = req.Attributes
+		}
+		if err := s.validateAttributes(ctx, product); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := s.repo.Update(ctx, product); err != nil {
@@ -144,6 +321,14 @@ func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Prod
 }
 
 func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	product, err := s.repo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if product.HasVariants() {
+		return entities.ErrProductHasVariants
+	}
+
 	hasStock, err := s.repo.HasStock(ctx, tenantID, id)
 	if err != nil {
 		return err
@@ -163,6 +348,193 @@ func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
 	return s.repo.Delete(ctx, tenantID, id)
 }
 
+// Restore recupera un producto borrado lógicamente. Su sucursal, su categoría y,
+// si es una variante, su producto padre tienen que seguir vivos, y ningún
+// producto puede haber tomado su SKU, su artículo en la sucursal o sus opciones.
+func (s *Service) Restore(ctx context.Context, tenantID, id uuid.UUID) (*entities.Product, error) {
+	var product *entities.Product
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Restore(ctx, tenantID, id); err != nil {
+			return err
+		}
+
+		var err error
+		product, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.validateStore(ctx, tenantID, product.StoreID); err != nil {
+			return err
+		}
+		if _, err := s.categoryRepo.GetByID(ctx, tenantID, product.CategoryID); err != nil {
+			if errors.Is(err, categoryentities.ErrCategoryNotFound) {
+				return entities.ErrProductCategoryNotFound
+			}
+			return err
+		}
+		if product.ParentID != nil {
+			if _, err := s.repo.GetByID(ctx, tenantID, *product.ParentID); err != nil {
+				if errors.Is(err, entities.ErrProductNotFound) {
+					return entities.ErrProductParentNotFound
+				}
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// Archive retira el producto y sus variantes. Ninguno puede tener stock ni estar
+// en traspasos sin terminar, porque un producto archivado ya no admite
+// movimientos de stock.
+func (s *Service) Archive(ctx context.Context, tenantID, id uuid.UUID) (*entities.Product, error) {
+	var product *entities.Product
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if product.IsArchived() {
+			return entities.ErrProductArchived
+		}
+
+		ids := []uuid.UUID{product.ID}
+		if product.HasVariants() {
+			variants, err := s.repo.ListVariants(ctx, tenantID, product.ID)
+			if err != nil {
+				return err
+			}
+			for _, variant := range variants {
+				ids = append(ids, variant.ID)
+			}
+		}
+		for _, productID := range ids {
+			hasStock, err := s.repo.HasStock(ctx, tenantID, productID)
+			if err != nil {
+				return err
+			}
+			if hasStock {
+				return entities.ErrArchiveProductHasStock
+			}
+
+			hasTransfers, err := s.repo.HasOpenTransfers(ctx, tenantID, productID)
+			if err != nil {
+				return err
+			}
+			if hasTransfers {
+				return entities.ErrProductHasOpenTransfers
+			}
+		}
+
+		if err := product.Archive(time.Now()); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, product); err != nil {
+			return err
+		}
+		if product.HasVariants() {
+			return s.repo.ArchiveVariants(ctx, tenantID, product.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// Unarchive reactiva el producto y las variantes que se archivaron con él. Su
+// sucursal y, si es una variante, su producto padre tienen que estar activos.
+func (s *Service) Unarchive(ctx context.Context, tenantID, id uuid.UUID) (*entities.Product, error) {
+	var product *entities.Product
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if !product.IsArchived() {
+			return entities.ErrProductNotArchived
+		}
+
+		if err := s.validateStore(ctx, tenantID, product.StoreID); err != nil {
+			return err
+		}
+		if product.ParentID != nil {
+			parent, err := s.repo.GetByID(ctx, tenantID, *product.ParentID)
+			if err != nil {
+				return err
+			}
+			if parent.IsArchived() {
+				return entities.ErrProductParentArchived
+			}
+		}
+
+		// Las variantes se reactivan antes de que el padre pierda su archived_at
+		if product.HasVariants() {
+			if err := s.repo.UnarchiveVariants(ctx, tenantID, product.ID); err != nil {
+				return err
+			}
+		}
+		if err := product.Unarchive(); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, product)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// PurgeDeleted borra definitivamente los productos borrados antes de before.
+func (s *Service) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return s.repo.PurgeDeleted(ctx, before, batchSize)
+}
+
+// validateStore comprueba que la sucursal exista, no esté borrada y siga activa.
+func (s *Service) validateStore(ctx context.Context, tenantID, storeID uuid.UUID) error {
+	store, err := s.storeRepo.GetByID(ctx, tenantID, storeID)
+	if err != nil {
+		if errors.Is(err, storeentities.ErrStoreNotFound) {
+			return entities.ErrProductStoreNotFound
+		}
+		return err
+	}
+	if store.IsArchived() {
+		return entities.ErrProductStoreArchived
+	}
+	return nil
+}
+
+// validateAttributes valida los atributos del producto contra el esquema efectivo
+// de su categoría y los deja normalizados.
+func (s *Service) validateAttributes(ctx context.Context, product *entities.Product) error {
+	schemas, err := s.categoryRepo.AttributeSchemas(ctx, product.TenantID, product.CategoryID)
+	if errors.Is(err, categoryentities.ErrCategoryNotFound) {
+		return entities.ErrProductCategoryNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	values, err := categoryentities.MergeAttributeSchemas(schemas...).ValidateValues(product.Attributes)
+	if err != nil {
+		return err
+	}
+	product.Attributes = values
+	return nil
+}
+
 func (s *Service) validateName(name string) error {
 	if name == "" {
 		return entities.ErrInvalidProductName
@@ -179,3 +551,17 @@ func getStringValue(s *string) string {
 	}
 	return *s
 }
+
+func validatePrice(price *money.Amount, currency money.Currency) error {
+	if price == nil {
+		return nil
+	}
+	return money.ValidatePrice(*price, currency)
+}
+
+func samePrice(a, b *money.Amount) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
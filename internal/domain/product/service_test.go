@@ -0,0 +1,207 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	catalogdomain "motico-api/internal/domain/catalog"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	categorydomain "motico-api/internal/domain/category"
+	categoryentities "motico-api/internal/domain/category/entities"
+	"motico-api/internal/domain/product/entities"
+	storedomain "motico-api/internal/domain/store"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transaction/transactiontest"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type productRepo struct {
+	Repository
+	products map[uuid.UUID]*entities.Product
+}
+
+func (r *productRepo) Create(ctx context.Context, product *entities.Product) error {
+	product.ID = uuid.New()
+	created := *product
+	r.products[product.ID] = &created
+	return nil
+}
+
+func (r *productRepo) ExistsBySKU(ctx context.Context, tenantID, storeID uuid.UUID, sku string) (bool, error) {
+	for _, product := range r.products {
+		if product.TenantID == tenantID && product.StoreID == storeID && product.SKU != nil && *product.SKU == sku {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *productRepo) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.Product, error) {
+	var products []*entities.Product
+	for _, product := range r.products {
+		if product.TenantID != tenantID ||
+			(filter.StoreID != nil && product.StoreID != *filter.StoreID) ||
+			(filter.CatalogItemID != nil && product.CatalogItemID != *filter.CatalogItemID) {
+			continue
+		}
+		found := *product
+		products = append(products, &found)
+	}
+	return products, nil
+}
+
+type catalogRepo struct {
+	catalogdomain.Repository
+	items map[uuid.UUID]*catalogentities.CatalogItem
+}
+
+func (r *catalogRepo) Create(ctx context.Context, item *catalogentities.CatalogItem) error {
+	item.ID = uuid.New()
+	created := *item
+	r.items[item.ID] = &created
+	return nil
+}
+
+func (r *catalogRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*catalogentities.CatalogItem, error) {
+	item, ok := r.items[id]
+	if !ok || item.TenantID != tenantID {
+		return nil, catalogentities.ErrCatalogItemNotFound
+	}
+	found := *item
+	return &found, nil
+}
+
+func (r *catalogRepo) GetBySKU(ctx context.Context, tenantID uuid.UUID, sku string) (*catalogentities.CatalogItem, error) {
+	for _, item := range r.items {
+		if item.TenantID == tenantID && item.SKU != nil && *item.SKU == sku {
+			found := *item
+			return &found, nil
+		}
+	}
+	return nil, catalogentities.ErrCatalogItemNotFound
+}
+
+func (r *catalogRepo) TenantCurrency(ctx context.Context, tenantID uuid.UUID) (money.Currency, error) {
+	return "USD", nil
+}
+
+type categoryRepo struct {
+	categorydomain.Repository
+}
+
+func (r *categoryRepo) AttributeSchemas(ctx context.Context, tenantID, categoryID uuid.UUID) ([]categoryentities.AttributeSchema, error) {
+	return nil, nil
+}
+
+type storeRepo struct {
+	storedomain.Repository
+}
+
+func (r *storeRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*storeentities.Store, error) {
+	return &storeentities.Store{ID: id, TenantID: tenantID, Status: storeentities.StoreStatusActive}, nil
+}
+
+func newTestService() (*Service, *productRepo, *catalogRepo) {
+	products := &productRepo{products: map[uuid.UUID]*entities.Product{}}
+	catalog := &catalogRepo{items: map[uuid.UUID]*catalogentities.CatalogItem{}}
+	cfg := &config.Config{}
+	cfg.Validation.MaxNameLength = 100
+	cfg.Pagination.DefaultLimit = 20
+	cfg.Pagination.MaxLimit = 100
+	service := NewService(products, catalog, &categoryRepo{}, &storeRepo{}, transactiontest.Manager{}, cfg, logger.NewNop())
+	return service, products, catalog
+}
+
+func TestCreateGroupsListingsBySKU(t *testing.T) {
+	ctx := context.Background()
+	service, _, catalog := newTestService()
+	tenantID := uuid.New()
+	storeA, storeB := uuid.New(), uuid.New()
+	sku := "HELMET"
+	price, localPrice := money.FromInt(100), money.FromInt(120)
+
+	first, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: storeA, CategoryID: uuid.New(), Name: "Helmet", SKU: &sku, Price: &price})
+	if err != nil {
+		t.Fatalf("create first listing: %v", err)
+	}
+	if len(catalog.items) != 1 {
+		t.Fatalf("catalog items: got %d, want 1", len(catalog.items))
+	}
+	if first.Price != nil || first.CatalogPrice == nil || *first.CatalogPrice != price {
+		t.Errorf("first listing: got price %v catalog price %v, want only the catalog price", first.Price, first.CatalogPrice)
+	}
+
+	// La segunda sucursal publica el mismo artículo: los datos maestros vienen del
+	// catálogo aunque el pedido traiga otros, y el precio distinto queda como local
+	second, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: storeB, CategoryID: uuid.New(), Name: "Other name", SKU: &sku, Price: &localPrice})
+	if err != nil {
+		t.Fatalf("create second listing: %v", err)
+	}
+	if len(catalog.items) != 1 {
+		t.Errorf("catalog items: got %d, want the same item for both listings", len(catalog.items))
+	}
+	if second.CatalogItemID != first.CatalogItemID || second.Name != "Helmet" || second.CategoryID != first.CategoryID {
+		t.Errorf("second listing: got item %s name %q category %s, want the first listing's master data", second.CatalogItemID, second.Name, second.CategoryID)
+	}
+	if second.Price == nil || *second.Price != localPrice {
+		t.Errorf("second listing: got local price %v, want %s", second.Price, localPrice)
+	}
+
+	listings, err := service.List(ctx, tenantID, ListFilter{CatalogItemID: &first.CatalogItemID}, 0, 0)
+	if err != nil {
+		t.Fatalf("list listings: %v", err)
+	}
+	if len(listings) != 2 {
+		t.Errorf("listings of the catalog item: got %d, want 2", len(listings))
+	}
+}
+
+func TestCreateRejectsDuplicateSKUInStore(t *testing.T) {
+	ctx := context.Background()
+	service, products, _ := newTestService()
+	tenantID, storeID := uuid.New(), uuid.New()
+	sku := "HELMET"
+
+	if _, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: storeID, CategoryID: uuid.New(), Name: "Helmet", SKU: &sku}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	_, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: storeID, CategoryID: uuid.New(), Name: "Helmet", SKU: &sku})
+	if !errors.Is(err, entities.ErrProductSKUExists) {
+		t.Fatalf("duplicate SKU: got %v, want ErrProductSKUExists", err)
+	}
+	if len(products.products) != 1 {
+		t.Errorf("products: got %d, want 1", len(products.products))
+	}
+}
+
+func TestCreateLinksRequestedCatalogItem(t *testing.T) {
+	ctx := context.Background()
+	service, _, catalog := newTestService()
+	tenantID := uuid.New()
+	sku := "GLOVES"
+	price := money.FromInt(30)
+	item := &catalogentities.CatalogItem{TenantID: tenantID, CategoryID: uuid.New(), Name: "Gloves", SKU: &sku, DefaultPrice: &price, Currency: "USD"}
+	if err := catalog.Create(ctx, item); err != nil {
+		t.Fatalf("create catalog item: %v", err)
+	}
+
+	listing, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: uuid.New(), CatalogItemID: &item.ID})
+	if err != nil {
+		t.Fatalf("create listing: %v", err)
+	}
+	if listing.CatalogItemID != item.ID || listing.Name != "Gloves" || listing.SKU == nil || *listing.SKU != sku {
+		t.Errorf("listing: got item %s name %q sku %v, want the catalog item's data", listing.CatalogItemID, listing.Name, listing.SKU)
+	}
+	if price := listing.EffectivePrice(); price == nil || *price != money.FromInt(30) {
+		t.Errorf("effective price: got %v, want the catalog default 30", price)
+	}
+
+	unknown := uuid.New()
+	if _, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: uuid.New(), CatalogItemID: &unknown}); !errors.Is(err, catalogentities.ErrCatalogItemNotFound) {
+		t.Errorf("unknown catalog item: got %v, want ErrCatalogItemNotFound", err)
+	}
+}
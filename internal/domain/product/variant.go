@@ -0,0 +1,214 @@
+package product
+
+import (
+	"context"
+	"motico-api/internal/domain/product/entities"
+	"motico-api/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+type OptionRequest struct {
+	Name   string
+	Values []string
+}
+
+type CreateVariantRequest struct {
+	TenantID     uuid.UUID
+	ParentID     uuid.UUID
+	OptionValues map[string]string
+	SKU          *string
+	Price        *money.Amount
+}
+
+func (s *Service) ListOptions(ctx context.Context, tenantID, productID uuid.UUID) ([]entities.ProductOption, error) {
+	if _, err := s.getParent(ctx, tenantID, productID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListOptions(ctx, tenantID, productID)
+}
+
+// ReplaceOptions redefine los ejes de variación del producto. No se permite dejar
+// variantes existentes con valores que ya no estén en los ejes.
+func (s *Service) ReplaceOptions(ctx context.Context, tenantID, productID uuid.UUID, reqs []OptionRequest) ([]entities.ProductOption, error) {
+	if _, err := s.getParent(ctx, tenantID, productID); err != nil {
+		return nil, err
+	}
+
+	options := make([]entities.ProductOption, len(reqs))
+	for i, req := range reqs {
+		options[i] = entities.ProductOption{Name: req.Name, Values: req.Values}
+	}
+	if err := entities.ValidateOptions(options); err != nil {
+		return nil, err
+	}
+
+	variants, err := s.repo.ListVariants(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		if !entities.MatchesOptions(variant.OptionValues, options) {
+			return nil, entities.ErrProductOptionInUse
+		}
+	}
+
+	if err := s.repo.ReplaceOptions(ctx, tenantID, productID, options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func (s *Service) ListVariants(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Product, error) {
+	if _, err := s.getParent(ctx, tenantID, productID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListVariants(ctx, tenantID, productID)
+}
+
+// CreateVariant agrega una variante puntual. Sin SKU o precio se derivan del padre.
+func (s *Service) CreateVariant(ctx context.Context, req CreateVariantRequest) (*entities.Product, error) {
+	parent, err := s.getParent(ctx, req.TenantID, req.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.IsArchived() {
+		return nil, entities.ErrProductArchived
+	}
+
+	options, err := s.repo.ListOptions(ctx, req.TenantID, req.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(options) == 0 {
+		return nil, entities.ErrProductHasNoOptions
+	}
+	if !entities.MatchesOptions(req.OptionValues, options) {
+		return nil, entities.ErrInvalidVariantOptions
+	}
+
+	variant := newVariant(parent, req.OptionValues, options)
+	if req.SKU != nil {
+		variant.SKU = req.SKU
+	}
+	if req.Price != nil {
+		variant.Price = req.Price
+	}
+
+	if err := s.createVariant(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	return variant, nil
+}
+
+// GenerateVariants crea las combinaciones de la matriz de opciones que todavía no
+// existen y devuelve solo las creadas. La matriz se crea entera o nada, y no puede
+// pasar de entities.MaxVariantCombinations combinaciones.
+func (s *Service) GenerateVariants(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Product, error) {
+	parent, err := s.getParent(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.IsArchived() {
+		return nil, entities.ErrProductArchived
+	}
+
+	options, err := s.repo.ListOptions(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if len(options) == 0 {
+		return nil, entities.ErrProductHasNoOptions
+	}
+	matrix, err := entities.VariantMatrix(options)
+	if err != nil {
+		return nil, err
+	}
+
+	created := []*entities.Product{}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := s.repo.ListVariants(ctx, tenantID, productID)
+		if err != nil {
+			return err
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, variant := range existing {
+			taken[entities.VariantKey(variant.OptionValues, options)] = true
+		}
+
+		for _, values := range matrix {
+			if taken[entities.VariantKey(values, options)] {
+				continue
+			}
+
+			variant := newVariant(parent, values, options)
+			if err := s.createVariant(ctx, variant); err != nil {
+				return err
+			}
+			created = append(created, variant)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// createVariant publica la variante con su propio artículo de catálogo.
+func (s *Service) createVariant(ctx context.Context, variant *entities.Product) error {
+	if variant.SKU != nil && *variant.SKU != "" {
+		exists, err := s.repo.ExistsBySKU(ctx, variant.TenantID, variant.StoreID, *variant.SKU)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return entities.ErrProductSKUExists
+		}
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.linkCatalogItem(ctx, variant, nil); err != nil {
+			return err
+		}
+		return s.repo.Create(ctx, variant)
+	})
+}
+
+// getParent obtiene un producto que puede tener variantes: las variantes no se anidan.
+func (s *Service) getParent(ctx context.Context, tenantID, productID uuid.UUID) (*entities.Product, error) {
+	product, err := s.repo.GetByID(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsVariant() {
+		return nil, entities.ErrProductIsVariant
+	}
+	return product, nil
+}
+
+func newVariant(parent *entities.Product, values map[string]string, options []entities.ProductOption) *entities.Product {
+	variant := &entities.Product{
+		TenantID:     parent.TenantID,
+		StoreID:      parent.StoreID,
+		CategoryID:   parent.CategoryID,
+		ParentID:     &parent.ID,
+		Name:         entities.VariantName(parent.Name, values, options),
+		Description:  parent.Description,
+		Price:        parent.BasePrice(),
+		Currency:     parent.Currency,
+		Active:       parent.Active,
+		OptionValues: values,
+		Attributes:   parent.Attributes,
+	}
+	if parent.SKU != nil && *parent.SKU != "" {
+		sku := entities.VariantSKU(*parent.SKU, values, options)
+		variant.SKU = &sku
+	}
+	return variant
+}
@@ -0,0 +1,25 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrPurchaseOrderNotFound     = apperror.New(apperror.KindNotFound, "purchase_order_not_found", "purchase order not found")
+	ErrPurchaseOrderNotDraft     = apperror.New(apperror.KindConflict, "purchase_order_not_draft", "purchase order is not in draft status")
+	ErrInvalidStatusTransition   = apperror.New(apperror.KindConflict, "invalid_status_transition", "action is not allowed for the current purchase order status")
+	ErrPurchaseOrderHasNoLines   = apperror.New(apperror.KindInvalid, "purchase_order_has_no_lines", "purchase order must have at least one line")
+	ErrDuplicateProduct          = apperror.New(apperror.KindInvalid, "duplicate_product", "a product can only appear once per purchase order")
+	ErrInvalidQuantity           = apperror.New(apperror.KindInvalid, "invalid_quantity", "quantity must be greater than zero")
+	ErrInvalidUnitCost           = apperror.New(apperror.KindInvalid, "invalid_unit_cost", "unit cost must be greater than or equal to zero")
+	ErrProductNotInStore         = apperror.New(apperror.KindUnprocessable, "product_not_in_store", "product is not listed in the purchase order store")
+	ErrPurchaseOrderLineNotFound = apperror.New(apperror.KindInvalid, "purchase_order_line_not_found", "product is not part of this purchase order")
+	ErrReceiptExceedsOutstanding = apperror.New(apperror.KindInvalid, "receipt_exceeds_outstanding", "received quantity exceeds the outstanding quantity")
+	ErrInvalidSupplier           = apperror.New(apperror.KindUnprocessable, "invalid_supplier", "supplier not found")
+	ErrInvalidStore              = apperror.New(apperror.KindUnprocessable, "invalid_store", "store not found")
+	ErrLotRequired               = apperror.New(apperror.KindInvalid, "lot_required", "lot number is required to receive a lot-tracked product")
+	ErrLotExpiryMismatch         = apperror.New(apperror.KindConflict, "lot_expiry_mismatch", "lot already exists with a different expiry date")
+	ErrSerialsRequired           = apperror.New(apperror.KindInvalid, "serials_required", "serial-tracked products require one serial per unit received")
+	ErrSerialsNotAllowed         = apperror.New(apperror.KindInvalid, "serials_not_allowed", "serials only apply to serial-tracked products")
+	ErrDuplicateSerial           = apperror.New(apperror.KindInvalid, "duplicate_serial", "a serial number can only appear once per receipt")
+	ErrSerialAlreadyInStock      = apperror.New(apperror.KindConflict, "serial_already_in_stock", "serial is already in stock")
+	ErrProductArchived           = apperror.New(apperror.KindConflict, "product_archived", "product or its store is archived")
+)
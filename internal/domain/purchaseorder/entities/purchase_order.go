@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PurchaseOrder struct {
+	ID          uuid.UUID           `json:"id"`
+	TenantID    uuid.UUID           `json:"tenant_id"`
+	SupplierID  uuid.UUID           `json:"supplier_id"`
+	StoreID     uuid.UUID           `json:"store_id"`
+	Status      PurchaseOrderStatus `json:"status"`
+	Currency    money.Currency      `json:"currency"`
+	Notes       *string             `json:"notes,omitempty"`
+	ExpectedAt  *time.Time          `json:"expected_at,omitempty"`
+	Lines       []PurchaseOrderLine `json:"lines"`
+	CreatedBy   *string             `json:"created_by,omitempty"`
+	SentAt      *time.Time          `json:"sent_at,omitempty"`
+	SentBy      *string             `json:"sent_by,omitempty"`
+	ReceivedAt  *time.Time          `json:"received_at,omitempty"`
+	ReceivedBy  *string             `json:"received_by,omitempty"`
+	ClosedAt    *time.Time          `json:"closed_at,omitempty"`
+	ClosedBy    *string             `json:"closed_by,omitempty"`
+	CancelledAt *time.Time          `json:"cancelled_at,omitempty"`
+	CancelledBy *string             `json:"cancelled_by,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+type PurchaseOrderLine struct {
+	ID               uuid.UUID    `json:"id"`
+	PurchaseOrderID  uuid.UUID    `json:"purchase_order_id"`
+	ProductID        uuid.UUID    `json:"product_id"`
+	Quantity         int          `json:"quantity"`
+	ReceivedQuantity int          `json:"received_quantity"`
+	UnitCost         money.Amount `json:"unit_cost"`
+}
+
+func (l *PurchaseOrderLine) OutstandingQuantity() int {
+	outstanding := l.Quantity - l.ReceivedQuantity
+	if outstanding < 0 {
+		return 0
+	}
+	return outstanding
+}
+
+func (l *PurchaseOrderLine) Total() money.Amount {
+	return l.UnitCost.MulInt(l.Quantity)
+}
+
+func (o *PurchaseOrder) CanUpdate() bool {
+	return o.Status == PurchaseOrderStatusDraft
+}
+
+func (o *PurchaseOrder) CanDelete() bool {
+	return o.Status == PurchaseOrderStatusDraft
+}
+
+func (o *PurchaseOrder) Line(productID uuid.UUID) *PurchaseOrderLine {
+	for i := range o.Lines {
+		if o.Lines[i].ProductID == productID {
+			return &o.Lines[i]
+		}
+	}
+	return nil
+}
+
+func (o *PurchaseOrder) IsFullyReceived() bool {
+	for _, line := range o.Lines {
+		if line.OutstandingQuantity() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Total es el importe de la orden a los costos pactados.
+func (o *PurchaseOrder) Total() money.Amount {
+	var total money.Amount
+	for _, line := range o.Lines {
+		total += line.Total()
+	}
+	return total
+}
@@ -0,0 +1,67 @@
+package entities
+
+import "time"
+
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderStatusDraft             PurchaseOrderStatus = "draft"
+	PurchaseOrderStatusSent              PurchaseOrderStatus = "sent"
+	PurchaseOrderStatusPartiallyReceived PurchaseOrderStatus = "partially_received"
+	PurchaseOrderStatusReceived          PurchaseOrderStatus = "received"
+	PurchaseOrderStatusClosed            PurchaseOrderStatus = "closed"
+	PurchaseOrderStatusCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+// purchaseOrderTransitions define el flujo borrador → enviada → recepción → cierre. Una
+// orden con recepciones ya no se cancela: se cierra con lo recibido.
+var purchaseOrderTransitions = map[PurchaseOrderStatus][]PurchaseOrderStatus{
+	PurchaseOrderStatusDraft:             {PurchaseOrderStatusSent, PurchaseOrderStatusCancelled},
+	PurchaseOrderStatusSent:              {PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusReceived, PurchaseOrderStatusCancelled},
+	PurchaseOrderStatusPartiallyReceived: {PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusReceived, PurchaseOrderStatusClosed},
+	PurchaseOrderStatusReceived:          {PurchaseOrderStatusClosed},
+}
+
+func (s PurchaseOrderStatus) IsValid() bool {
+	switch s {
+	case PurchaseOrderStatusDraft, PurchaseOrderStatusSent, PurchaseOrderStatusPartiallyReceived,
+		PurchaseOrderStatusReceived, PurchaseOrderStatusClosed, PurchaseOrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (s PurchaseOrderStatus) CanTransitionTo(next PurchaseOrderStatus) bool {
+	for _, allowed := range purchaseOrderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s PurchaseOrderStatus) IsFinal() bool {
+	return len(purchaseOrderTransitions[s]) == 0
+}
+
+// TransitionTo mueve la orden al siguiente estado registrando quién y cuándo
+// ejecutó la acción.
+func (o *PurchaseOrder) TransitionTo(next PurchaseOrderStatus, actor string, at time.Time) error {
+	if !o.Status.CanTransitionTo(next) {
+		return ErrInvalidStatusTransition
+	}
+
+	switch next {
+	case PurchaseOrderStatusSent:
+		o.SentAt, o.SentBy = &at, &actor
+	case PurchaseOrderStatusReceived:
+		o.ReceivedAt, o.ReceivedBy = &at, &actor
+	case PurchaseOrderStatusClosed:
+		o.ClosedAt, o.ClosedBy = &at, &actor
+	case PurchaseOrderStatusCancelled:
+		o.CancelledAt, o.CancelledBy = &at, &actor
+	}
+
+	o.Status = next
+	return nil
+}
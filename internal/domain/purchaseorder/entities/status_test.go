@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPurchaseOrderStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from PurchaseOrderStatus
+		to   PurchaseOrderStatus
+		want bool
+	}{
+		{PurchaseOrderStatusDraft, PurchaseOrderStatusSent, true},
+		{PurchaseOrderStatusDraft, PurchaseOrderStatusCancelled, true},
+		{PurchaseOrderStatusDraft, PurchaseOrderStatusReceived, false},
+		{PurchaseOrderStatusSent, PurchaseOrderStatusPartiallyReceived, true},
+		{PurchaseOrderStatusSent, PurchaseOrderStatusReceived, true},
+		{PurchaseOrderStatusSent, PurchaseOrderStatusClosed, false},
+		{PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusPartiallyReceived, true},
+		{PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusClosed, true},
+		{PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusCancelled, false},
+		{PurchaseOrderStatusReceived, PurchaseOrderStatusClosed, true},
+		{PurchaseOrderStatusClosed, PurchaseOrderStatusSent, false},
+		{PurchaseOrderStatusCancelled, PurchaseOrderStatusSent, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestPurchaseOrderTransitionToRecordsActor(t *testing.T) {
+	order := &PurchaseOrder{Status: PurchaseOrderStatusDraft}
+	at := time.Now()
+
+	if err := order.TransitionTo(PurchaseOrderStatusSent, "buyer", at); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if order.SentBy == nil || *order.SentBy != "buyer" || !order.SentAt.Equal(at) {
+		t.Fatalf("sender not recorded: %+v", order)
+	}
+
+	if err := order.TransitionTo(PurchaseOrderStatusClosed, "buyer", at); err != ErrInvalidStatusTransition {
+		t.Fatalf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+	if order.Status != PurchaseOrderStatusSent {
+		t.Fatalf("status changed on rejected transition: %s", order.Status)
+	}
+}
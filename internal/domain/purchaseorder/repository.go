@@ -0,0 +1,29 @@
+package purchaseorder
+
+import (
+	"context"
+	"motico-api/internal/domain/purchaseorder/entities"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+)
+
+// ListFilter acota el listado de órdenes de compra.
+type ListFilter struct {
+	Status     *entities.PurchaseOrderStatus
+	SupplierID *uuid.UUID
+	StoreID    *uuid.UUID
+	Query      query.Params
+}
+
+type Repository interface {
+	Create(ctx context.Context, order *entities.PurchaseOrder) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error)
+	// GetByIDForUpdate es GetByID bloqueando la orden hasta el fin de la
+	// transacción en curso.
+	GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.PurchaseOrder, error)
+	// Update persiste la cabecera y sincroniza las líneas con las de la orden recibida.
+	Update(ctx context.Context, order *entities.PurchaseOrder) error
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+}
@@ -0,0 +1,435 @@
+package purchaseorder
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	"motico-api/internal/domain/catalog"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	"motico-api/internal/domain/purchaseorder/entities"
+	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	storedomain "motico-api/internal/domain/store"
+	"motico-api/internal/domain/supplier"
+	"motico-api/internal/domain/transaction"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// movementReferenceType identifica a las órdenes de compra en el libro de movimientos de stock.
+const movementReferenceType = "purchase_order"
+
+type Service struct {
+	repo         Repository
+	stockService *stock.Service
+	supplierRepo supplier.Repository
+	storeRepo    storedomain.Repository
+	productRepo  productdomain.Repository
+	catalogRepo  catalog.Repository
+	txManager    transaction.Manager
+	config       *config.Config
+	logger       logger.Logger
+}
+
+func NewService(repo Repository, stockService *stock.Service, supplierRepo supplier.Repository, storeRepo storedomain.Repository, productRepo productdomain.Repository, catalogRepo catalog.Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:         repo,
+		stockService: stockService,
+		supplierRepo: supplierRepo,
+		storeRepo:    storeRepo,
+		productRepo:  productRepo,
+		catalogRepo:  catalogRepo,
+		txManager:    txManager,
+		config:       cfg,
+		logger:       log,
+	}
+}
+
+type LineRequest struct {
+	ProductID uuid.UUID
+	Quantity  int
+	UnitCost  money.Amount
+}
+
+type CreateRequest struct {
+	TenantID   uuid.UUID
+	SupplierID uuid.UUID
+	StoreID    uuid.UUID
+	Lines      []LineRequest
+	Notes      *string
+	ExpectedAt *time.Time
+	CreatedBy  string
+}
+
+type UpdateRequest struct {
+	ID         uuid.UUID
+	TenantID   uuid.UUID
+	SupplierID *uuid.UUID
+	StoreID    *uuid.UUID
+	Lines      []LineRequest
+	Notes      *string
+	ExpectedAt *time.Time
+}
+
+// ActionRequest identifica una orden y al usuario que ejecuta la transición.
+type ActionRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Actor    string
+}
+
+// ReceiptLineRequest es lo recibido de un producto. UnitCost reemplaza al costo
+// pactado en la orden cuando la factura del proveedor trae otro. Los productos con
+// seguimiento por lote indican el lote recibido; un producto puede repetirse en
+// la recepción si llegan varios lotes. Los de seguimiento por serie indican una
+// serie por unidad recibida.
+type ReceiptLineRequest struct {
+	ProductID uuid.UUID
+	Quantity  int
+	UnitCost  *money.Amount
+	Lot       *stockentities.LotSpec
+	Serials   []string
+}
+
+type ReceiveRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Actor    string
+	Lines    []ReceiptLineRequest
+}
+
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.PurchaseOrder, error) {
+	if err := validateLines(req.Lines); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateParties(ctx, req.TenantID, req.SupplierID, req.StoreID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProducts(ctx, req.TenantID, req.StoreID, req.Lines); err != nil {
+		return nil, err
+	}
+
+	currency, err := s.catalogRepo.TenantCurrency(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &entities.PurchaseOrder{
+		TenantID:   req.TenantID,
+		SupplierID: req.SupplierID,
+		StoreID:    req.StoreID,
+		Status:     entities.PurchaseOrderStatusDraft,
+		Currency:   currency,
+		Notes:      req.Notes,
+		ExpectedAt: req.ExpectedAt,
+		Lines:      newLines(req.Lines),
+	}
+	if req.CreatedBy != "" {
+		order.CreatedBy = &req.CreatedBy
+	}
+
+	if err := s.repo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.PurchaseOrder, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, filter, limit, offset)
+}
+
+// Update modifica una orden en borrador. Una vez enviada al proveedor la orden
+// solo avanza por su flujo de estados.
+func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.PurchaseOrder, error) {
+	order, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.CanUpdate() {
+		return nil, entities.ErrPurchaseOrderNotDraft
+	}
+
+	if req.SupplierID != nil {
+		order.SupplierID = *req.SupplierID
+	}
+	if req.StoreID != nil {
+		order.StoreID = *req.StoreID
+	}
+	if req.SupplierID != nil || req.StoreID != nil {
+		if err := s.validateParties(ctx, req.TenantID, order.SupplierID, order.StoreID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Lines != nil {
+		if err := validateLines(req.Lines); err != nil {
+			return nil, err
+		}
+		order.Lines = newLines(req.Lines)
+	}
+	if req.Lines != nil || req.StoreID != nil {
+		if err := s.validateProducts(ctx, req.TenantID, order.StoreID, lineRequests(order)); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Notes != nil {
+		order.Notes = req.Notes
+	}
+	if req.ExpectedAt != nil {
+		order.ExpectedAt = req.ExpectedAt
+	}
+
+	if err := s.repo.Update(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	order, err := s.repo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	if !order.CanDelete() {
+		return entities.ErrPurchaseOrderNotDraft
+	}
+
+	return s.repo.Delete(ctx, tenantID, id)
+}
+
+func (s *Service) Send(ctx context.Context, req ActionRequest) (*entities.PurchaseOrder, error) {
+	return s.transition(ctx, req, entities.PurchaseOrderStatusSent)
+}
+
+// Close da por terminada una orden recibida o recibida en parte; lo que falta ya
+// no se espera.
+func (s *Service) Close(ctx context.Context, req ActionRequest) (*entities.PurchaseOrder, error) {
+	return s.transition(ctx, req, entities.PurchaseOrderStatusClosed)
+}
+
+func (s *Service) Cancel(ctx context.Context, req ActionRequest) (*entities.PurchaseOrder, error) {
+	return s.transition(ctx, req, entities.PurchaseOrderStatusCancelled)
+}
+
+// Receive registra mercadería recibida: suma el stock en la sucursal de la orden
+// al costo de cada línea y deja los movimientos vinculados a la orden. La orden se
+// lee bloqueada dentro de la transacción, así dos recepciones concurrentes no
+// validan lo pendiente contra la misma copia.
+func (s *Service) Receive(ctx context.Context, req ReceiveRequest) (*entities.PurchaseOrder, error) {
+	var order *entities.PurchaseOrder
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		return s.receive(ctx, order, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Service) receive(ctx context.Context, order *entities.PurchaseOrder, req ReceiveRequest) error {
+	if len(req.Lines) == 0 {
+		return entities.ErrPurchaseOrderHasNoLines
+	}
+
+	adjustments := make([]stock.AdjustRequest, 0, len(req.Lines))
+	for _, received := range req.Lines {
+		line := order.Line(received.ProductID)
+		if line == nil {
+			return entities.ErrPurchaseOrderLineNotFound
+		}
+		if received.Quantity <= 0 {
+			return entities.ErrInvalidQuantity
+		}
+		if received.Quantity > line.OutstandingQuantity() {
+			return entities.ErrReceiptExceedsOutstanding
+		}
+
+		unitCost := line.UnitCost
+		if received.UnitCost != nil {
+			if received.UnitCost.IsNegative() {
+				return entities.ErrInvalidUnitCost
+			}
+			unitCost = *received.UnitCost
+		}
+
+		line.ReceivedQuantity += received.Quantity
+		adjustments = append(adjustments, stock.AdjustRequest{
+			TenantID:  order.TenantID,
+			ProductID: line.ProductID,
+			Amount:    received.Quantity,
+			UnitCost:  &unitCost,
+			Reason:    stockentities.MovementReasonReceipt,
+			Reference: movementReference(order),
+			Lot:       received.Lot,
+			Serials:   received.Serials,
+		})
+	}
+
+	next := entities.PurchaseOrderStatusPartiallyReceived
+	if order.IsFullyReceived() {
+		next = entities.PurchaseOrderStatusReceived
+	}
+	if err := order.TransitionTo(next, req.Actor, time.Now()); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, order); err != nil {
+		return err
+	}
+	for _, adjustment := range adjustments {
+		if _, err := s.stockService.Adjust(ctx, adjustment); err != nil {
+			switch {
+			case errors.Is(err, stockentities.ErrLotRequired):
+				return entities.ErrLotRequired
+			case errors.Is(err, stockentities.ErrLotExpiryMismatch):
+				return entities.ErrLotExpiryMismatch
+			case errors.Is(err, stockentities.ErrSerialsRequired), errors.Is(err, stockentities.ErrSerialCountMismatch):
+				return entities.ErrSerialsRequired
+			case errors.Is(err, stockentities.ErrProductNotSerialTracked):
+				return entities.ErrSerialsNotAllowed
+			case errors.Is(err, stockentities.ErrDuplicateSerial):
+				return entities.ErrDuplicateSerial
+			case errors.Is(err, stockentities.ErrSerialAlreadyInStock):
+				return entities.ErrSerialAlreadyInStock
+			case errors.Is(err, stockentities.ErrProductArchived):
+				return entities.ErrProductArchived
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ListReceipts devuelve los movimientos de stock generados por las recepciones de la orden.
+func (s *Service) ListReceipts(ctx context.Context, tenantID, id uuid.UUID) ([]*stockentities.Movement, error) {
+	order, err := s.repo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.stockService.ListMovements(ctx, tenantID, *movementReference(order))
+}
+
+func (s *Service) transition(ctx context.Context, req ActionRequest, next entities.PurchaseOrderStatus) (*entities.PurchaseOrder, error) {
+	var order *entities.PurchaseOrder
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		if err := order.TransitionTo(next, req.Actor, time.Now()); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Service) validateParties(ctx context.Context, tenantID, supplierID, storeID uuid.UUID) error {
+	if _, err := s.supplierRepo.GetByID(ctx, tenantID, supplierID); err != nil {
+		return entities.ErrInvalidSupplier
+	}
+	if _, err := s.storeRepo.GetByID(ctx, tenantID, storeID); err != nil {
+		return entities.ErrInvalidStore
+	}
+	return nil
+}
+
+// validateProducts exige que cada producto sea una publicación de la sucursal que recibe la orden.
+func (s *Service) validateProducts(ctx context.Context, tenantID, storeID uuid.UUID, lines []LineRequest) error {
+	for _, line := range lines {
+		product, err := s.productRepo.GetByID(ctx, tenantID, line.ProductID)
+		if err != nil {
+			if errors.Is(err, productentities.ErrProductNotFound) {
+				return entities.ErrProductNotInStore
+			}
+			return err
+		}
+		if product.StoreID != storeID {
+			return entities.ErrProductNotInStore
+		}
+	}
+	return nil
+}
+
+func validateLines(lines []LineRequest) error {
+	if len(lines) == 0 {
+		return entities.ErrPurchaseOrderHasNoLines
+	}
+
+	seen := make(map[uuid.UUID]bool, len(lines))
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return entities.ErrInvalidQuantity
+		}
+		if line.UnitCost.IsNegative() {
+			return entities.ErrInvalidUnitCost
+		}
+		if seen[line.ProductID] {
+			return entities.ErrDuplicateProduct
+		}
+		seen[line.ProductID] = true
+	}
+
+	return nil
+}
+
+func newLines(lines []LineRequest) []entities.PurchaseOrderLine {
+	result := make([]entities.PurchaseOrderLine, len(lines))
+	for i, line := range lines {
+		result[i] = entities.PurchaseOrderLine{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			UnitCost:  line.UnitCost,
+		}
+	}
+	return result
+}
+
+func lineRequests(order *entities.PurchaseOrder) []LineRequest {
+	lines := make([]LineRequest, len(order.Lines))
+	for i, line := range order.Lines {
+		lines[i] = LineRequest{ProductID: line.ProductID, Quantity: line.Quantity, UnitCost: line.UnitCost}
+	}
+	return lines
+}
+
+func movementReference(order *entities.PurchaseOrder) *stockentities.MovementReference {
+	return &stockentities.MovementReference{Type: movementReferenceType, ID: order.ID}
+}
@@ -0,0 +1,84 @@
+package purchaseorder
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	"motico-api/internal/domain/purchaseorder/entities"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/domain/stock/stocktest"
+	"motico-api/internal/domain/transaction/transactiontest"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// purchaseOrderRepo guarda las órdenes en memoria y devuelve copias, como la base.
+type purchaseOrderRepo struct {
+	Repository
+	orders map[uuid.UUID]*entities.PurchaseOrder
+}
+
+func (r *purchaseOrderRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	order, ok := r.orders[id]
+	if !ok || order.TenantID != tenantID {
+		return nil, entities.ErrPurchaseOrderNotFound
+	}
+	return copyOrder(order), nil
+}
+
+func (r *purchaseOrderRepo) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	if !transactiontest.InTransaction(ctx) {
+		return nil, errors.New("purchase order locked outside a transaction")
+	}
+	return r.GetByID(ctx, tenantID, id)
+}
+
+func (r *purchaseOrderRepo) Update(ctx context.Context, order *entities.PurchaseOrder) error {
+	r.orders[order.ID] = copyOrder(order)
+	return nil
+}
+
+func copyOrder(order *entities.PurchaseOrder) *entities.PurchaseOrder {
+	copied := *order
+	copied.Lines = append([]entities.PurchaseOrderLine(nil), order.Lines...)
+	return &copied
+}
+
+func TestReceiveValidatesAgainstLockedOrder(t *testing.T) {
+	ctx := context.Background()
+	tenantID, productID := uuid.New(), uuid.New()
+	order := &entities.PurchaseOrder{
+		ID:       uuid.New(),
+		TenantID: tenantID,
+		Status:   entities.PurchaseOrderStatusSent,
+		Lines:    []entities.PurchaseOrderLine{{ProductID: productID, Quantity: 5, UnitCost: money.FromInt(10)}},
+	}
+	repo := &purchaseOrderRepo{orders: map[uuid.UUID]*entities.PurchaseOrder{order.ID: copyOrder(order)}}
+	stockRepo := stocktest.NewRepository()
+	cfg := &config.Config{}
+	stockService := stock.NewService(stockRepo, transactiontest.Manager{}, cfg, logger.NewNop())
+	service := NewService(repo, stockService, nil, nil, nil, nil, transactiontest.Manager{}, cfg, logger.NewNop())
+
+	receipt := ReceiveRequest{ID: order.ID, TenantID: tenantID, Actor: "clerk", Lines: []ReceiptLineRequest{{ProductID: productID, Quantity: 4}}}
+	received, err := service.Receive(ctx, receipt)
+	if err != nil {
+		t.Fatalf("first receipt: %v", err)
+	}
+	if received.Status != entities.PurchaseOrderStatusPartiallyReceived {
+		t.Errorf("status: got %s, want partially_received", received.Status)
+	}
+
+	// La segunda recepción se valida contra lo ya recibido: solo queda 1 pendiente
+	if _, err := service.Receive(ctx, receipt); !errors.Is(err, entities.ErrReceiptExceedsOutstanding) {
+		t.Fatalf("second receipt: got %v, want ErrReceiptExceedsOutstanding", err)
+	}
+	if st := stockRepo.Stock(tenantID, productID); st.Quantity != 4 {
+		t.Errorf("stock: got %d, want 4", st.Quantity)
+	}
+	if got := repo.orders[order.ID].Lines[0].ReceivedQuantity; got != 4 {
+		t.Errorf("received quantity: got %d, want 4", got)
+	}
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxDailySalesDays limita el rango de días del reporte de ventas diarias.
+const MaxDailySalesDays = 366
+
+// DailySalesRow son las ventas cumplidas de una sucursal en un día. Cost es el
+// costo de las unidades que salieron del stock con esas ventas.
+type DailySalesRow struct {
+	Date    time.Time    `json:"date"`
+	Orders  int          `json:"orders"`
+	Units   int          `json:"units"`
+	Revenue money.Amount `json:"revenue"`
+	Cost    money.Amount `json:"cost"`
+}
+
+func (r *DailySalesRow) GrossMargin() money.Amount {
+	return r.Revenue - r.Cost
+}
+
+// DailySales es la serie diaria de ventas de una sucursal, con un día por fecha
+// del rango aunque no haya ventas, y sus totales.
+type DailySales struct {
+	StoreID  uuid.UUID       `json:"store_id"`
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Currency money.Currency  `json:"currency"`
+	Days     []DailySalesRow `json:"days"`
+	Totals   DailySalesRow   `json:"totals"`
+}
+
+// NewDailySales completa con ceros los días sin ventas entre from y to (ambos
+// inclusive) y suma los totales.
+func NewDailySales(storeID uuid.UUID, from, to time.Time, currency money.Currency, rows []*DailySalesRow) *DailySales {
+	byDay := make(map[string]*DailySalesRow, len(rows))
+	for _, row := range rows {
+		byDay[row.Date.Format(time.DateOnly)] = row
+	}
+
+	sales := &DailySales{StoreID: storeID, From: from, To: to, Currency: currency, Days: []DailySalesRow{}}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		current := DailySalesRow{Date: day}
+		if row, ok := byDay[day.Format(time.DateOnly)]; ok {
+			current.Orders, current.Units = row.Orders, row.Units
+			current.Revenue, current.Cost = row.Revenue, row.Cost
+		}
+		sales.Days = append(sales.Days, current)
+
+		sales.Totals.Orders += current.Orders
+		sales.Totals.Units += current.Units
+		sales.Totals.Revenue += current.Revenue
+		sales.Totals.Cost += current.Cost
+	}
+	return sales
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"motico-api/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+func TestNewDailySalesFillsMissingDays(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)
+	rows := []*DailySalesRow{
+		{Date: from, Orders: 2, Units: 5, Revenue: money.FromInt(100), Cost: money.FromInt(60)},
+		{Date: to, Orders: 1, Units: 1, Revenue: money.FromInt(20), Cost: money.FromInt(15)},
+	}
+
+	sales := NewDailySales(uuid.New(), from, to, "USD", rows)
+
+	if len(sales.Days) != 3 {
+		t.Fatalf("expected 3 days, got %d", len(sales.Days))
+	}
+	if empty := sales.Days[1]; empty.Orders != 0 || empty.Revenue != 0 || !empty.Date.Equal(from.AddDate(0, 0, 1)) {
+		t.Fatalf("expected an empty second day, got %+v", empty)
+	}
+	if sales.Totals.Orders != 3 || sales.Totals.Units != 6 {
+		t.Fatalf("unexpected totals: %+v", sales.Totals)
+	}
+	if got, want := sales.Totals.GrossMargin(), money.FromInt(45); got != want {
+		t.Fatalf("gross margin: got %s, want %s", got, want)
+	}
+}
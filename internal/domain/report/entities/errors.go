@@ -0,0 +1,9 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrInvalidDateRange = apperror.New(apperror.KindInvalid, "invalid_date_range", "from must not be after to")
+	ErrDateRangeTooLong = apperror.New(apperror.KindInvalid, "date_range_too_long", "date range cannot exceed 366 days")
+	ErrInvalidDays      = apperror.New(apperror.KindInvalid, "invalid_days", "days must be between 0 and 366")
+)
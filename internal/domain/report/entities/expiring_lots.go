@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultExpiringDays es la ventana del reporte de lotes por vencer si no se indica otra.
+	DefaultExpiringDays = 30
+	// MaxExpiringDays limita la ventana del reporte de lotes por vencer.
+	MaxExpiringDays = 366
+)
+
+// ExpiringLotRow es un lote con stock que vence dentro de la ventana del reporte
+// o que ya venció. Value es su valor al costo promedio del producto.
+type ExpiringLotRow struct {
+	StoreID     uuid.UUID    `json:"store_id"`
+	StoreName   string       `json:"store_name"`
+	ProductID   uuid.UUID    `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	SKU         *string      `json:"sku,omitempty"`
+	LotNumber   string       `json:"lot_number"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	Quantity    int          `json:"quantity"`
+	Value       money.Amount `json:"value"`
+}
+
+// DaysLeft son los días que faltan para el vencimiento a partir del día de now;
+// es negativo si el lote ya venció.
+func (r *ExpiringLotRow) DaysLeft(now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(r.ExpiresAt.Sub(today).Hours() / 24)
+}
+
+// ExpiringLots son los lotes que vencen hasta Until, ordenados por vencimiento.
+type ExpiringLots struct {
+	Until    time.Time         `json:"until"`
+	Currency money.Currency    `json:"currency"`
+	Quantity int               `json:"quantity"`
+	Value    money.Amount      `json:"value"`
+	Lots     []*ExpiringLotRow `json:"lots"`
+}
+
+func NewExpiringLots(until time.Time, currency money.Currency, rows []*ExpiringLotRow) *ExpiringLots {
+	report := &ExpiringLots{Until: until, Currency: currency, Lots: rows}
+	if report.Lots == nil {
+		report.Lots = []*ExpiringLotRow{}
+	}
+	for _, row := range rows {
+		report.Quantity += row.Quantity
+		report.Value += row.Value
+	}
+	return report
+}
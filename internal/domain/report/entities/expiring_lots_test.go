@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"testing"
+	"time"
+)
+
+func TestExpiringLotRowDaysLeft(t *testing.T) {
+	now := time.Date(2024, 3, 10, 18, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		expiresAt time.Time
+		want      int
+	}{
+		{time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2024, 3, 17, 0, 0, 0, 0, time.UTC), 7},
+		{time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC), -2},
+	}
+	for _, c := range cases {
+		row := ExpiringLotRow{ExpiresAt: c.expiresAt}
+		if got := row.DaysLeft(now); got != c.want {
+			t.Errorf("DaysLeft for %s: expected %d, got %d", c.expiresAt.Format(time.DateOnly), c.want, got)
+		}
+	}
+}
+
+func TestNewExpiringLotsTotals(t *testing.T) {
+	rows := []*ExpiringLotRow{
+		{LotNumber: "A", Quantity: 3, Value: money.FromInt(30)},
+		{LotNumber: "B", Quantity: 2, Value: money.FromInt(12)},
+	}
+
+	report := NewExpiringLots(time.Now(), "USD", rows)
+	if report.Quantity != 5 || report.Value != money.FromInt(42) || len(report.Lots) != 2 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+
+	if empty := NewExpiringLots(time.Now(), "USD", nil); empty.Lots == nil || len(empty.Lots) != 0 {
+		t.Errorf("expected empty lots, got %+v", empty.Lots)
+	}
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ValuationRow es el saldo de inventario de una categoría en una sucursal.
+type ValuationRow struct {
+	StoreID      uuid.UUID    `json:"store_id"`
+	StoreName    string       `json:"store_name"`
+	CategoryID   uuid.UUID    `json:"category_id"`
+	CategoryName string       `json:"category_name"`
+	Quantity     int          `json:"quantity"`
+	Value        money.Amount `json:"value"`
+}
+
+type CategoryValuation struct {
+	CategoryID uuid.UUID    `json:"category_id"`
+	Name       string       `json:"name"`
+	Quantity   int          `json:"quantity"`
+	Value      money.Amount `json:"value"`
+}
+
+type StoreValuation struct {
+	StoreID    uuid.UUID           `json:"store_id"`
+	Name       string              `json:"name"`
+	Quantity   int                 `json:"quantity"`
+	Value      money.Amount        `json:"value"`
+	Categories []CategoryValuation `json:"categories"`
+}
+
+// InventoryValuation es el valor del inventario del tenant a una fecha, por
+// sucursal y categoría.
+type InventoryValuation struct {
+	AsOf     time.Time        `json:"as_of"`
+	Currency money.Currency   `json:"currency"`
+	Quantity int              `json:"quantity"`
+	Value    money.Amount     `json:"value"`
+	Stores   []StoreValuation `json:"stores"`
+}
+
+// NewInventoryValuation agrupa las filas por sucursal, en el orden en que llegan,
+// y calcula los subtotales y el total.
+func NewInventoryValuation(asOf time.Time, currency money.Currency, rows []*ValuationRow) *InventoryValuation {
+	valuation := &InventoryValuation{AsOf: asOf, Currency: currency, Stores: []StoreValuation{}}
+	index := make(map[uuid.UUID]int)
+	for _, row := range rows {
+		i, ok := index[row.StoreID]
+		if !ok {
+			i = len(valuation.Stores)
+			index[row.StoreID] = i
+			valuation.Stores = append(valuation.Stores, StoreValuation{StoreID: row.StoreID, Name: row.StoreName})
+		}
+
+		store := &valuation.Stores[i]
+		store.Categories = append(store.Categories, CategoryValuation{
+			CategoryID: row.CategoryID,
+			Name:       row.CategoryName,
+			Quantity:   row.Quantity,
+			Value:      row.Value,
+		})
+		store.Quantity += row.Quantity
+		store.Value += row.Value
+		valuation.Quantity += row.Quantity
+		valuation.Value += row.Value
+	}
+	return valuation
+}
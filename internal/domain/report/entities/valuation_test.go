@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewInventoryValuationGroupsByStore(t *testing.T) {
+	north, south := uuid.New(), uuid.New()
+	tools, parts := uuid.New(), uuid.New()
+
+	rows := []*ValuationRow{
+		{StoreID: north, StoreName: "North", CategoryID: parts, CategoryName: "Parts", Quantity: 4, Value: money.FromInt(40)},
+		{StoreID: north, StoreName: "North", CategoryID: tools, CategoryName: "Tools", Quantity: 1, Value: money.FromInt(15)},
+		{StoreID: south, StoreName: "South", CategoryID: parts, CategoryName: "Parts", Quantity: 2, Value: money.FromInt(20)},
+	}
+
+	valuation := NewInventoryValuation(time.Now(), "USD", rows)
+
+	if len(valuation.Stores) != 2 {
+		t.Fatalf("expected 2 stores, got %d", len(valuation.Stores))
+	}
+	if got := valuation.Stores[0]; got.StoreID != north || got.Quantity != 5 || got.Value != money.FromInt(55) || len(got.Categories) != 2 {
+		t.Errorf("unexpected north valuation: %+v", got)
+	}
+	if got := valuation.Stores[1]; got.StoreID != south || got.Value != money.FromInt(20) {
+		t.Errorf("unexpected south valuation: %+v", got)
+	}
+	if valuation.Quantity != 7 || valuation.Value != money.FromInt(75) {
+		t.Errorf("unexpected totals: %d units, %s", valuation.Quantity, valuation.Value)
+	}
+}
+
+func TestNewInventoryValuationEmpty(t *testing.T) {
+	valuation := NewInventoryValuation(time.Now(), "USD", nil)
+
+	if valuation.Stores == nil || len(valuation.Stores) != 0 || valuation.Value != 0 {
+		t.Errorf("expected an empty valuation, got %+v", valuation)
+	}
+}
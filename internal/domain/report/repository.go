@@ -0,0 +1,17 @@
+package report
+
+import (
+	"context"
+	"motico-api/internal/domain/report/entities"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	InventoryValuation(ctx context.Context, tenantID uuid.UUID, asOf time.Time, storeID *uuid.UUID) ([]*entities.ValuationRow, error)
+	// DailySales devuelve las ventas cumplidas de la sucursal por día entre from (inclusive) y to (exclusivo).
+	DailySales(ctx context.Context, tenantID, storeID uuid.UUID, from, to time.Time) ([]*entities.DailySalesRow, error)
+	// ExpiringLots devuelve los lotes con stock que vencen antes de until, incluidos los ya vencidos.
+	ExpiringLots(ctx context.Context, tenantID uuid.UUID, until time.Time, storeID *uuid.UUID) ([]*entities.ExpiringLotRow, error)
+}
@@ -0,0 +1,136 @@
+package report
+
+import (
+	"context"
+	"motico-api/config"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/report/entities"
+	storedomain "motico-api/internal/domain/store"
+	"motico-api/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo        Repository
+	catalogRepo catalog.Repository
+	storeRepo   storedomain.Repository
+	config      *config.Config
+	logger      logger.Logger
+}
+
+func NewService(repo Repository, catalogRepo catalog.Repository, storeRepo storedomain.Repository, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:        repo,
+		catalogRepo: catalogRepo,
+		storeRepo:   storeRepo,
+		config:      cfg,
+		logger:      log,
+	}
+}
+
+// ValuationRequest pide el valor del inventario al momento AsOf, opcionalmente de
+// una sola sucursal.
+type ValuationRequest struct {
+	TenantID uuid.UUID
+	AsOf     time.Time
+	StoreID  *uuid.UUID
+}
+
+// InventoryValuation valoriza el inventario con el último saldo del libro de
+// movimientos de cada producto anterior a AsOf, en la moneda del tenant.
+func (s *Service) InventoryValuation(ctx context.Context, req ValuationRequest) (*entities.InventoryValuation, error) {
+	if req.StoreID != nil {
+		if _, err := s.storeRepo.GetByID(ctx, req.TenantID, *req.StoreID); err != nil {
+			return nil, err
+		}
+	}
+
+	currency, err := s.catalogRepo.TenantCurrency(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.InventoryValuation(ctx, req.TenantID, req.AsOf, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewInventoryValuation(req.AsOf, currency, rows), nil
+}
+
+// DailySalesRequest pide las ventas diarias de una sucursal entre dos fechas, ambas inclusive.
+type DailySalesRequest struct {
+	TenantID uuid.UUID
+	StoreID  uuid.UUID
+	From     time.Time
+	To       time.Time
+}
+
+// DailySales resume por día las órdenes de venta cumplidas de una sucursal. Una
+// venta cuenta el día en que se cumplió, que es cuando sale del stock.
+func (s *Service) DailySales(ctx context.Context, req DailySalesRequest) (*entities.DailySales, error) {
+	from := truncateDay(req.From)
+	to := truncateDay(req.To)
+	if from.After(to) {
+		return nil, entities.ErrInvalidDateRange
+	}
+	if to.Sub(from) >= entities.MaxDailySalesDays*24*time.Hour {
+		return nil, entities.ErrDateRangeTooLong
+	}
+
+	if _, err := s.storeRepo.GetByID(ctx, req.TenantID, req.StoreID); err != nil {
+		return nil, err
+	}
+
+	currency, err := s.catalogRepo.TenantCurrency(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.DailySales(ctx, req.TenantID, req.StoreID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewDailySales(req.StoreID, from, to, currency, rows), nil
+}
+
+// ExpiringLotsRequest pide los lotes que vencen en los próximos Days días,
+// opcionalmente de una sola sucursal.
+type ExpiringLotsRequest struct {
+	TenantID uuid.UUID
+	Days     int
+	StoreID  *uuid.UUID
+}
+
+// ExpiringLots lista los lotes con stock que vencen en los próximos días, junto
+// con los que ya vencieron y siguen en stock.
+func (s *Service) ExpiringLots(ctx context.Context, req ExpiringLotsRequest) (*entities.ExpiringLots, error) {
+	if req.Days < 0 || req.Days > entities.MaxExpiringDays {
+		return nil, entities.ErrInvalidDays
+	}
+	if req.StoreID != nil {
+		if _, err := s.storeRepo.GetByID(ctx, req.TenantID, *req.StoreID); err != nil {
+			return nil, err
+		}
+	}
+
+	currency, err := s.catalogRepo.TenantCurrency(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	until := truncateDay(time.Now()).AddDate(0, 0, req.Days)
+	rows, err := s.repo.ExpiringLots(ctx, req.TenantID, until.AddDate(0, 0, 1), req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewExpiringLots(until, currency, rows), nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrSalesOrderNotFound      = apperror.New(apperror.KindNotFound, "sales_order_not_found", "sales order not found")
+	ErrInvalidStatusTransition = apperror.New(apperror.KindConflict, "invalid_status_transition", "invalid sales order status transition")
+	ErrSalesOrderHasNoLines    = apperror.New(apperror.KindInvalid, "sales_order_has_no_lines", "sales order must have at least one line")
+	ErrDuplicateProduct        = apperror.New(apperror.KindInvalid, "duplicate_product", "a product can only appear once per sales order")
+	ErrInvalidQuantity         = apperror.New(apperror.KindInvalid, "invalid_quantity", "quantity must be greater than zero")
+	ErrInvalidStore            = apperror.New(apperror.KindUnprocessable, "invalid_store", "store not found")
+	ErrStoreNotOpen            = apperror.New(apperror.KindUnprocessable, "store_not_open", "store is closing or archived and cannot sell")
+	ErrProductNotInStore       = apperror.New(apperror.KindUnprocessable, "product_not_in_store", "product is not listed in the sales order store")
+	ErrProductHasVariants      = apperror.New(apperror.KindUnprocessable, "product_has_variants", "product has variants; sell a specific variant")
+	ErrProductHasNoPrice       = apperror.New(apperror.KindUnprocessable, "product_has_no_price", "product has no price")
+	ErrProductInactive         = apperror.New(apperror.KindUnprocessable, "product_inactive", "product is not active")
+	ErrCurrencyMismatch        = apperror.New(apperror.KindUnprocessable, "currency_mismatch", "all sales order lines must be priced in the same currency")
+	ErrInsufficientStock       = apperror.New(apperror.KindConflict, "insufficient_stock", "insufficient stock available for sales order")
+	ErrSerialsRequired         = apperror.New(apperror.KindInvalid, "serials_required", "serial-tracked products require one serial per unit")
+	ErrSerialsNotAllowed       = apperror.New(apperror.KindInvalid, "serials_not_allowed", "serials only apply to serial-tracked products")
+	ErrDuplicateSerial         = apperror.New(apperror.KindInvalid, "duplicate_serial", "a serial number can only appear once per sales order")
+	ErrSerialNotAvailable      = apperror.New(apperror.KindConflict, "serial_not_available", "serial is not in stock at the sales order store")
+)
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SalesOrder struct {
+	ID           uuid.UUID        `json:"id"`
+	TenantID     uuid.UUID        `json:"tenant_id"`
+	StoreID      uuid.UUID        `json:"store_id"`
+	Status       SalesOrderStatus `json:"status"`
+	Currency     money.Currency   `json:"currency"`
+	CustomerName *string          `json:"customer_name,omitempty"`
+	Notes        *string          `json:"notes,omitempty"`
+	Lines        []SalesOrderLine `json:"lines"`
+	CreatedBy    *string          `json:"created_by,omitempty"`
+	FulfilledAt  *time.Time       `json:"fulfilled_at,omitempty"`
+	FulfilledBy  *string          `json:"fulfilled_by,omitempty"`
+	CancelledAt  *time.Time       `json:"cancelled_at,omitempty"`
+	CancelledBy  *string          `json:"cancelled_by,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// SalesOrderLine guarda el precio unitario vigente al crear la orden, así los
+// cambios de precio posteriores no alteran lo vendido.
+type SalesOrderLine struct {
+	ID           uuid.UUID    `json:"id"`
+	SalesOrderID uuid.UUID    `json:"sales_order_id"`
+	ProductID    uuid.UUID    `json:"product_id"`
+	Quantity     int          `json:"quantity"`
+	UnitPrice    money.Amount `json:"unit_price"`
+	// Serials son las unidades vendidas cuando el producto se sigue por número de serie.
+	Serials []string `json:"serials,omitempty"`
+}
+
+func (l *SalesOrderLine) Total() money.Amount {
+	return l.UnitPrice.MulInt(l.Quantity)
+}
+
+// Total es el importe de la orden a los precios fijados en sus líneas.
+func (o *SalesOrder) Total() money.Amount {
+	var total money.Amount
+	for _, line := range o.Lines {
+		total += line.Total()
+	}
+	return total
+}
+
+func (o *SalesOrder) TotalQuantity() int {
+	total := 0
+	for _, line := range o.Lines {
+		total += line.Quantity
+	}
+	return total
+}
+
+func (o *SalesOrder) Line(productID uuid.UUID) *SalesOrderLine {
+	for i := range o.Lines {
+		if o.Lines[i].ProductID == productID {
+			return &o.Lines[i]
+		}
+	}
+	return nil
+}
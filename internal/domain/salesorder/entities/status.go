@@ -0,0 +1,55 @@
+package entities
+
+import "time"
+
+type SalesOrderStatus string
+
+const (
+	SalesOrderStatusPending   SalesOrderStatus = "pending"
+	SalesOrderStatusFulfilled SalesOrderStatus = "fulfilled"
+	SalesOrderStatusCancelled SalesOrderStatus = "cancelled"
+)
+
+// salesOrderTransitions: una orden pendiente retiene stock hasta que se cumple o se cancela.
+var salesOrderTransitions = map[SalesOrderStatus][]SalesOrderStatus{
+	SalesOrderStatusPending: {SalesOrderStatusFulfilled, SalesOrderStatusCancelled},
+}
+
+func (s SalesOrderStatus) IsValid() bool {
+	switch s {
+	case SalesOrderStatusPending, SalesOrderStatusFulfilled, SalesOrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (s SalesOrderStatus) CanTransitionTo(next SalesOrderStatus) bool {
+	for _, allowed := range salesOrderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s SalesOrderStatus) IsFinal() bool {
+	return len(salesOrderTransitions[s]) == 0
+}
+
+// TransitionTo mueve la orden al siguiente estado registrando quién y cuándo
+// ejecutó la acción.
+func (o *SalesOrder) TransitionTo(next SalesOrderStatus, actor string, at time.Time) error {
+	if !o.Status.CanTransitionTo(next) {
+		return ErrInvalidStatusTransition
+	}
+
+	switch next {
+	case SalesOrderStatusFulfilled:
+		o.FulfilledAt, o.FulfilledBy = &at, &actor
+	case SalesOrderStatusCancelled:
+		o.CancelledAt, o.CancelledBy = &at, &actor
+	}
+
+	o.Status = next
+	return nil
+}
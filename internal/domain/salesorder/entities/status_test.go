@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"motico-api/pkg/money"
+)
+
+func TestSalesOrderStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from SalesOrderStatus
+		to   SalesOrderStatus
+		want bool
+	}{
+		{SalesOrderStatusPending, SalesOrderStatusFulfilled, true},
+		{SalesOrderStatusPending, SalesOrderStatusCancelled, true},
+		{SalesOrderStatusFulfilled, SalesOrderStatusCancelled, false},
+		{SalesOrderStatusCancelled, SalesOrderStatusFulfilled, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestSalesOrderTransitionToRecordsActor(t *testing.T) {
+	order := &SalesOrder{Status: SalesOrderStatusPending}
+	at := time.Now()
+
+	if err := order.TransitionTo(SalesOrderStatusFulfilled, "cashier", at); err != nil {
+		t.Fatalf("fulfill: %v", err)
+	}
+	if order.FulfilledBy == nil || *order.FulfilledBy != "cashier" || !order.FulfilledAt.Equal(at) {
+		t.Fatalf("fulfiller not recorded: %+v", order)
+	}
+	if err := order.TransitionTo(SalesOrderStatusCancelled, "cashier", at); err != ErrInvalidStatusTransition {
+		t.Fatalf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+}
+
+func TestSalesOrderTotal(t *testing.T) {
+	order := &SalesOrder{Lines: []SalesOrderLine{
+		{Quantity: 2, UnitPrice: money.FromInt(10)},
+		{Quantity: 3, UnitPrice: money.FromInt(5)},
+	}}
+
+	if got, want := order.Total(), money.FromInt(35); got != want {
+		t.Fatalf("total: got %s, want %s", got, want)
+	}
+	if got := order.TotalQuantity(); got != 5 {
+		t.Fatalf("total quantity: got %d, want 5", got)
+	}
+}
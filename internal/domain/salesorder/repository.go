@@ -0,0 +1,26 @@
+package salesorder
+
+import (
+	"context"
+	"motico-api/internal/domain/salesorder/entities"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+)
+
+// ListFilter acota el listado de órdenes de venta.
+type ListFilter struct {
+	Status  *entities.SalesOrderStatus
+	StoreID *uuid.UUID
+	Query   query.Params
+}
+
+type Repository interface {
+	Create(ctx context.Context, order *entities.SalesOrder) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error)
+	// GetByIDForUpdate es GetByID bloqueando la orden hasta el fin de la
+	// transacción en curso.
+	GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.SalesOrder, error)
+	Update(ctx context.Context, order *entities.SalesOrder) error
+}
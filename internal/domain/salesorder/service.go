@@ -0,0 +1,329 @@
+package salesorder
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	"motico-api/internal/domain/salesorder/entities"
+	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	storedomain "motico-api/internal/domain/store"
+	"motico-api/internal/domain/transaction"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MovementReferenceType identifica a las órdenes de venta en el libro de movimientos de stock.
+const MovementReferenceType = "sales_order"
+
+type Service struct {
+	repo         Repository
+	stockService *stock.Service
+	storeRepo    storedomain.Repository
+	productRepo  productdomain.Repository
+	txManager    transaction.Manager
+	config       *config.Config
+	logger       logger.Logger
+}
+
+func NewService(repo Repository, stockService *stock.Service, storeRepo storedomain.Repository, productRepo productdomain.Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:         repo,
+		stockService: stockService,
+		storeRepo:    storeRepo,
+		productRepo:  productRepo,
+		txManager:    txManager,
+		config:       cfg,
+		logger:       log,
+	}
+}
+
+type LineRequest struct {
+	ProductID uuid.UUID
+	Quantity  int
+	// Serials es obligatorio, una por unidad, en productos con seguimiento por serie.
+	Serials []string
+}
+
+type CreateRequest struct {
+	TenantID     uuid.UUID
+	StoreID      uuid.UUID
+	Lines        []LineRequest
+	CustomerName *string
+	Notes        *string
+	CreatedBy    string
+}
+
+// ActionRequest identifica la orden sobre la que se ejecuta una acción y quién la ejecuta.
+type ActionRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Actor    string
+}
+
+// Create registra la orden con el precio vigente de cada producto y reserva su
+// stock; si alguna línea no tiene stock disponible no se crea nada. La moneda de
+// la orden es la de sus productos, por lo que todas las líneas deben compartirla.
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.SalesOrder, error) {
+	if err := validateLines(req.Lines); err != nil {
+		return nil, err
+	}
+
+	store, err := s.storeRepo.GetByID(ctx, req.TenantID, req.StoreID)
+	if err != nil {
+		return nil, entities.ErrInvalidStore
+	}
+	// Una sucursal en cierre o archivada ya no vende
+	if !store.IsOpen() {
+		return nil, entities.ErrStoreNotOpen
+	}
+
+	order := &entities.SalesOrder{
+		TenantID:     req.TenantID,
+		StoreID:      req.StoreID,
+		Status:       entities.SalesOrderStatusPending,
+		CustomerName: req.CustomerName,
+		Notes:        req.Notes,
+	}
+	if req.CreatedBy != "" {
+		order.CreatedBy = &req.CreatedBy
+	}
+
+	for i, line := range req.Lines {
+		priced, currency, err := s.priceLine(ctx, req.TenantID, req.StoreID, line)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			order.Currency = currency
+		} else if currency != order.Currency {
+			return nil, entities.ErrCurrencyMismatch
+		}
+		order.Lines = append(order.Lines, priced)
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, order); err != nil {
+			return err
+		}
+		return s.reserveLines(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.SalesOrder, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, filter, limit, offset)
+}
+
+// Fulfill entrega la orden: cada línea consume su reserva y descuenta del stock
+// las unidades reservadas, dejando las salidas vinculadas a la orden. La orden se
+// lee bloqueada dentro de la transacción para que una cancelación concurrente no
+// parta del mismo estado pendiente.
+func (s *Service) Fulfill(ctx context.Context, req ActionRequest) (*entities.SalesOrder, error) {
+	var order *entities.SalesOrder
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		if err := order.TransitionTo(entities.SalesOrderStatusFulfilled, req.Actor, time.Now()); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, order); err != nil {
+			return err
+		}
+		owner := reservationOwner(order)
+		for _, line := range order.Lines {
+			_, err := s.stockService.Adjust(ctx, stock.AdjustRequest{
+				TenantID:  order.TenantID,
+				ProductID: line.ProductID,
+				Amount:    -line.Quantity,
+				Reason:    stockentities.MovementReasonSale,
+				Reference: movementReference(order),
+				Serials:   line.Serials,
+				Owner:     &owner,
+			})
+			if err != nil {
+				switch {
+				case errors.Is(err, stockentities.ErrInsufficientStock), errors.Is(err, stockentities.ErrInvalidReservedAmount):
+					return entities.ErrInsufficientStock
+				case errors.Is(err, stockentities.ErrSerialNotFound), errors.Is(err, stockentities.ErrSerialNotAvailable):
+					return entities.ErrSerialNotAvailable
+				}
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// Cancel anula una orden pendiente y libera el stock que tenía reservado.
+func (s *Service) Cancel(ctx context.Context, req ActionRequest) (*entities.SalesOrder, error) {
+	var order *entities.SalesOrder
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		if err := order.TransitionTo(entities.SalesOrderStatusCancelled, req.Actor, time.Now()); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, order); err != nil {
+			return err
+		}
+		return s.releaseLines(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// priceLine valida que el producto se pueda vender en la sucursal y fija su precio
+// de venta vigente, junto con la moneda en la que está expresado.
+func (s *Service) priceLine(ctx context.Context, tenantID, storeID uuid.UUID, line LineRequest) (entities.SalesOrderLine, money.Currency, error) {
+	product, err := s.productRepo.GetByID(ctx, tenantID, line.ProductID)
+	if err != nil {
+		if errors.Is(err, productentities.ErrProductNotFound) {
+			return entities.SalesOrderLine{}, "", entities.ErrProductNotInStore
+		}
+		return entities.SalesOrderLine{}, "", err
+	}
+	if product.StoreID != storeID {
+		return entities.SalesOrderLine{}, "", entities.ErrProductNotInStore
+	}
+	// Un producto archivado también queda fuera de la venta
+	if !product.Active || product.IsArchived() {
+		return entities.SalesOrderLine{}, "", entities.ErrProductInactive
+	}
+	if product.HasVariants() {
+		return entities.SalesOrderLine{}, "", entities.ErrProductHasVariants
+	}
+
+	serialTracked := product.Tracking == catalogentities.TrackingSerial
+	if serialTracked && len(line.Serials) == 0 {
+		return entities.SalesOrderLine{}, "", entities.ErrSerialsRequired
+	}
+	if !serialTracked && len(line.Serials) > 0 {
+		return entities.SalesOrderLine{}, "", entities.ErrSerialsNotAllowed
+	}
+
+	price := product.EffectivePrice()
+	if price == nil {
+		return entities.SalesOrderLine{}, "", entities.ErrProductHasNoPrice
+	}
+
+	return entities.SalesOrderLine{
+		ProductID: line.ProductID,
+		Quantity:  line.Quantity,
+		UnitPrice: *price,
+		Serials:   line.Serials,
+	}, product.Currency, nil
+}
+
+func (s *Service) reserveLines(ctx context.Context, order *entities.SalesOrder) error {
+	for _, line := range order.Lines {
+		_, err := s.stockService.Reserve(ctx, stock.ReserveRequest{
+			TenantID:  order.TenantID,
+			ProductID: line.ProductID,
+			Owner:     reservationOwner(order),
+			Quantity:  line.Quantity,
+			Serials:   line.Serials,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, stockentities.ErrInsufficientStock):
+				return entities.ErrInsufficientStock
+			case errors.Is(err, stockentities.ErrSerialNotFound), errors.Is(err, stockentities.ErrSerialNotAvailable):
+				return entities.ErrSerialNotAvailable
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) releaseLines(ctx context.Context, order *entities.SalesOrder) error {
+	for _, line := range order.Lines {
+		err := s.stockService.Release(ctx, stock.ReleaseRequest{
+			TenantID:  order.TenantID,
+			ProductID: line.ProductID,
+			Owner:     reservationOwner(order),
+			Quantity:  line.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func movementReference(order *entities.SalesOrder) *stockentities.MovementReference {
+	return &stockentities.MovementReference{Type: MovementReferenceType, ID: order.ID}
+}
+
+func reservationOwner(order *entities.SalesOrder) stockentities.ReservationOwner {
+	return stockentities.ReservationOwner{Type: stockentities.ReservationOwnerSalesOrder, ID: order.ID}
+}
+
+func validateLines(lines []LineRequest) error {
+	if len(lines) == 0 {
+		return entities.ErrSalesOrderHasNoLines
+	}
+
+	seen := make(map[uuid.UUID]bool, len(lines))
+	serials := make(map[string]bool)
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return entities.ErrInvalidQuantity
+		}
+		if seen[line.ProductID] {
+			return entities.ErrDuplicateProduct
+		}
+		seen[line.ProductID] = true
+
+		if len(line.Serials) > 0 && len(line.Serials) != line.Quantity {
+			return entities.ErrSerialsRequired
+		}
+		for _, serial := range line.Serials {
+			if serials[serial] {
+				return entities.ErrDuplicateSerial
+			}
+			serials[serial] = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package salesorder
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	"motico-api/internal/domain/salesorder/entities"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/domain/stock/stocktest"
+	storedomain "motico-api/internal/domain/store"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transaction/transactiontest"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type salesOrderRepo struct {
+	Repository
+	orders map[uuid.UUID]*entities.SalesOrder
+}
+
+func (r *salesOrderRepo) Create(ctx context.Context, order *entities.SalesOrder) error {
+	order.ID = uuid.New()
+	r.orders[order.ID] = copyOrder(order)
+	return nil
+}
+
+func (r *salesOrderRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error) {
+	order, ok := r.orders[id]
+	if !ok || order.TenantID != tenantID {
+		return nil, entities.ErrSalesOrderNotFound
+	}
+	return copyOrder(order), nil
+}
+
+func (r *salesOrderRepo) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error) {
+	if !transactiontest.InTransaction(ctx) {
+		return nil, errors.New("sales order locked outside a transaction")
+	}
+	return r.GetByID(ctx, tenantID, id)
+}
+
+func (r *salesOrderRepo) Update(ctx context.Context, order *entities.SalesOrder) error {
+	r.orders[order.ID] = copyOrder(order)
+	return nil
+}
+
+func copyOrder(order *entities.SalesOrder) *entities.SalesOrder {
+	copied := *order
+	copied.Lines = append([]entities.SalesOrderLine(nil), order.Lines...)
+	return &copied
+}
+
+type storeRepo struct {
+	storedomain.Repository
+	stores map[uuid.UUID]*storeentities.Store
+}
+
+func (r *storeRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*storeentities.Store, error) {
+	store, ok := r.stores[id]
+	if !ok || store.TenantID != tenantID {
+		return nil, storeentities.ErrStoreNotFound
+	}
+	found := *store
+	return &found, nil
+}
+
+type productRepo struct {
+	productdomain.Repository
+	products map[uuid.UUID]*productentities.Product
+}
+
+func (r *productRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*productentities.Product, error) {
+	product, ok := r.products[id]
+	if !ok || product.TenantID != tenantID {
+		return nil, productentities.ErrProductNotFound
+	}
+	found := *product
+	return &found, nil
+}
+
+func TestCreateTakesCurrencyFromLines(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	storeID := uuid.New()
+	stockRepo := stocktest.NewRepository()
+	stockService := stock.NewService(stockRepo, transactiontest.Manager{}, &config.Config{}, logger.NewNop())
+	orders := &salesOrderRepo{orders: map[uuid.UUID]*entities.SalesOrder{}}
+	products := &productRepo{products: map[uuid.UUID]*productentities.Product{}}
+	stores := &storeRepo{stores: map[uuid.UUID]*storeentities.Store{storeID: {ID: storeID, TenantID: tenantID, Status: storeentities.StoreStatusActive}}}
+	service := NewService(orders, stockService, stores, products, transactiontest.Manager{}, &config.Config{}, logger.NewNop())
+
+	// product da de alta un producto con 10 unidades en la sucursal, con precio en currency.
+	product := func(currency money.Currency) uuid.UUID {
+		t.Helper()
+		id := uuid.New()
+		price := money.FromInt(100)
+		products.products[id] = &productentities.Product{ID: id, TenantID: tenantID, StoreID: storeID, Price: &price, Currency: currency, Active: true}
+		unitCost := money.FromInt(50)
+		if _, err := stockService.Adjust(ctx, stock.AdjustRequest{TenantID: tenantID, ProductID: id, Amount: 10, UnitCost: &unitCost}); err != nil {
+			t.Fatalf("seed stock: %v", err)
+		}
+		return id
+	}
+	usd, otherUSD, ars := product("USD"), product("USD"), product("ARS")
+
+	order, err := service.Create(ctx, CreateRequest{
+		TenantID: tenantID,
+		StoreID:  storeID,
+		Lines:    []LineRequest{{ProductID: usd, Quantity: 1}, {ProductID: otherUSD, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if order.Currency != "USD" {
+		t.Errorf("currency: got %q, want USD", order.Currency)
+	}
+
+	_, err = service.Create(ctx, CreateRequest{
+		TenantID: tenantID,
+		StoreID:  storeID,
+		Lines:    []LineRequest{{ProductID: usd, Quantity: 1}, {ProductID: ars, Quantity: 1}},
+	})
+	if !errors.Is(err, entities.ErrCurrencyMismatch) {
+		t.Fatalf("mixed currencies: got %v, want ErrCurrencyMismatch", err)
+	}
+	if len(orders.orders) != 1 {
+		t.Errorf("orders: got %d, want only the first one", len(orders.orders))
+	}
+	if st := stockRepo.Stock(tenantID, ars); st.ReservedQuantity != 0 {
+		t.Errorf("rejected order should not reserve stock, got %d reserved", st.ReservedQuantity)
+	}
+}
+
+func TestCreateRejectsStoresThatAreNotOpen(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	transferID := uuid.New()
+	closing := &storeentities.Store{ID: uuid.New(), TenantID: tenantID, Status: storeentities.StoreStatusClosing, ClosingTransferID: &transferID}
+	archived := &storeentities.Store{ID: uuid.New(), TenantID: tenantID, Status: storeentities.StoreStatusArchived}
+	stores := &storeRepo{stores: map[uuid.UUID]*storeentities.Store{closing.ID: closing, archived.ID: archived}}
+	orders := &salesOrderRepo{orders: map[uuid.UUID]*entities.SalesOrder{}}
+	products := &productRepo{products: map[uuid.UUID]*productentities.Product{}}
+	service := NewService(orders, nil, stores, products, transactiontest.Manager{}, &config.Config{}, logger.NewNop())
+
+	for _, store := range []*storeentities.Store{closing, archived} {
+		_, err := service.Create(ctx, CreateRequest{
+			TenantID: tenantID,
+			StoreID:  store.ID,
+			Lines:    []LineRequest{{ProductID: uuid.New(), Quantity: 1}},
+		})
+		if !errors.Is(err, entities.ErrStoreNotOpen) {
+			t.Errorf("%s store: got %v, want ErrStoreNotOpen", store.Status, err)
+		}
+	}
+}
+
+func TestCancelAfterFulfillIsRejected(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	storeID := uuid.New()
+	productID := uuid.New()
+	stockRepo := stocktest.NewRepository()
+	stockService := stock.NewService(stockRepo, transactiontest.Manager{}, &config.Config{}, logger.NewNop())
+	orders := &salesOrderRepo{orders: map[uuid.UUID]*entities.SalesOrder{}}
+	price := money.FromInt(100)
+	products := &productRepo{products: map[uuid.UUID]*productentities.Product{
+		productID: {ID: productID, TenantID: tenantID, StoreID: storeID, Price: &price, Currency: "USD", Active: true},
+	}}
+	stores := &storeRepo{stores: map[uuid.UUID]*storeentities.Store{storeID: {ID: storeID, TenantID: tenantID, Status: storeentities.StoreStatusActive}}}
+	service := NewService(orders, stockService, stores, products, transactiontest.Manager{}, &config.Config{}, logger.NewNop())
+
+	unitCost := money.FromInt(50)
+	if _, err := stockService.Adjust(ctx, stock.AdjustRequest{TenantID: tenantID, ProductID: productID, Amount: 10, UnitCost: &unitCost}); err != nil {
+		t.Fatalf("seed stock: %v", err)
+	}
+	order, err := service.Create(ctx, CreateRequest{TenantID: tenantID, StoreID: storeID, Lines: []LineRequest{{ProductID: productID, Quantity: 2}}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	action := ActionRequest{ID: order.ID, TenantID: tenantID, Actor: "clerk"}
+	if _, err := service.Fulfill(ctx, action); err != nil {
+		t.Fatalf("fulfill: %v", err)
+	}
+	// La cancelación lee el estado ya entregado y no vuelve a liberar la reserva
+	if _, err := service.Cancel(ctx, action); !errors.Is(err, entities.ErrInvalidStatusTransition) {
+		t.Fatalf("cancel after fulfill: got %v, want ErrInvalidStatusTransition", err)
+	}
+	if got := orders.orders[order.ID].Status; got != entities.SalesOrderStatusFulfilled {
+		t.Errorf("status: got %s, want fulfilled", got)
+	}
+	if st := stockRepo.Stock(tenantID, productID); st.Quantity != 8 || st.ReservedQuantity != 0 {
+		t.Errorf("stock: got quantity %d reserved %d, want 8 and 0", st.Quantity, st.ReservedQuantity)
+	}
+}
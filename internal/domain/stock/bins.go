@@ -0,0 +1,288 @@
+package stock
+
+import (
+	"context"
+	"motico-api/internal/domain/stock/entities"
+
+	"github.com/google/uuid"
+)
+
+// PutawayRequest guarda en un bin unidades del producto que todavía no están en
+// ningún bin, como las recién recibidas.
+type PutawayRequest struct {
+	TenantID   uuid.UUID
+	ProductID  uuid.UUID
+	LocationID uuid.UUID
+	Quantity   int
+	Actor      string
+}
+
+// BinMoveRequest pasa unidades del producto de un bin a otro de la misma sucursal.
+type BinMoveRequest struct {
+	TenantID       uuid.UUID
+	ProductID      uuid.UUID
+	FromLocationID uuid.UUID
+	ToLocationID   uuid.UUID
+	Quantity       int
+	Actor          string
+}
+
+// ListBins devuelve el stock del producto desglosado por bin.
+func (s *Service) ListBins(ctx context.Context, tenantID, productID uuid.UUID) (*entities.BinSummary, error) {
+	var summary *entities.BinSummary
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		summary, err = s.binSummary(ctx, tenantID, productID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func (s *Service) Putaway(ctx context.Context, req PutawayRequest) (*entities.BinSummary, error) {
+	if req.Quantity <= 0 {
+		return nil, entities.ErrInvalidQuantity
+	}
+
+	var summary *entities.BinSummary
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		bin, err := s.productBin(ctx, req.TenantID, req.ProductID, req.LocationID)
+		if err != nil {
+			return err
+		}
+		if summary, err = s.binSummary(ctx, req.TenantID, req.ProductID); err != nil {
+			return err
+		}
+		if req.Quantity > summary.Unplaced {
+			return entities.ErrPutawayExceedsUnplaced
+		}
+
+		if err := s.addToBin(ctx, req.TenantID, summary, bin, req.Quantity); err != nil {
+			return err
+		}
+		return s.repo.CreateBinMovement(ctx, &entities.BinMovement{
+			TenantID:     req.TenantID,
+			ProductID:    req.ProductID,
+			ToLocationID: &bin.ID,
+			Quantity:     req.Quantity,
+			CreatedBy:    actor(req.Actor),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (s *Service) MoveBetweenBins(ctx context.Context, req BinMoveRequest) (*entities.BinSummary, error) {
+	if req.Quantity <= 0 {
+		return nil, entities.ErrInvalidQuantity
+	}
+	if req.FromLocationID == req.ToLocationID {
+		return nil, entities.ErrSameBin
+	}
+
+	var summary *entities.BinSummary
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		from, err := s.productBin(ctx, req.TenantID, req.ProductID, req.FromLocationID)
+		if err != nil {
+			return err
+		}
+		to, err := s.productBin(ctx, req.TenantID, req.ProductID, req.ToLocationID)
+		if err != nil {
+			return err
+		}
+		if summary, err = s.binSummary(ctx, req.TenantID, req.ProductID); err != nil {
+			return err
+		}
+
+		source := findBinStock(summary.Bins, from.ID)
+		if source == nil || source.Quantity < req.Quantity {
+			return entities.ErrInsufficientBinStock
+		}
+		source.Quantity -= req.Quantity
+		if err := s.repo.SaveBinStock(ctx, source); err != nil {
+			return err
+		}
+
+		if err := s.addToBin(ctx, req.TenantID, summary, to, req.Quantity); err != nil {
+			return err
+		}
+		return s.repo.CreateBinMovement(ctx, &entities.BinMovement{
+			TenantID:       req.TenantID,
+			ProductID:      req.ProductID,
+			FromLocationID: &from.ID,
+			ToLocationID:   &to.ID,
+			Quantity:       req.Quantity,
+			CreatedBy:      actor(req.Actor),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary.Bins = nonEmptyBins(summary.Bins)
+	return summary, nil
+}
+
+// PlanPick indica de qué bins sacar quantity unidades del producto, en orden de
+// recorrido. No reserva nada: es la guía para preparar el pedido.
+func (s *Service) PlanPick(ctx context.Context, tenantID, productID uuid.UUID, quantity int) (*entities.PickLine, error) {
+	var line *entities.PickLine
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		bins, err := s.repo.ListBinStock(ctx, tenantID, productID)
+		if err != nil {
+			return err
+		}
+		allocations, unplaced := entities.PlanPick(bins, quantity)
+		line = &entities.PickLine{ProductID: productID, Quantity: quantity, Bins: allocations, Unplaced: unplaced}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return line, nil
+}
+
+// PickFromBins saca de los bins, en orden de recorrido, las unidades que se
+// preparan antes de que salgan del stock, como al despachar un traspaso. Las
+// salidas de stock de esas unidades ya no vuelven a tomarlas de los bins.
+func (s *Service) PickFromBins(ctx context.Context, tenantID, productID uuid.UUID, quantity int, reference *entities.MovementReference, actorID string) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.drawBins(ctx, tenantID, productID, quantity, func(m *entities.BinMovement) {
+			m.Reference = reference
+			m.CreatedBy = actor(actorID)
+		})
+	})
+}
+
+// pickedBeforeIssue indica si las unidades de una salida ya se tomaron de los bins
+// al prepararse: las de un traspaso se toman al despacharlo.
+func pickedBeforeIssue(reason entities.MovementReason) bool {
+	return reason == entities.MovementReasonTransferOut || reason == entities.MovementReasonTransferLoss
+}
+
+// issueBins toma de los bins las unidades de una salida de stock. Se toman
+// primero de los bins, en orden de recorrido, y después de lo que está sin
+// ubicar, así las cantidades por bin nunca superan el stock.
+func (s *Service) issueBins(ctx context.Context, movement *entities.Movement) error {
+	if movement.Quantity >= 0 || pickedBeforeIssue(movement.Reason) {
+		return nil
+	}
+	return s.drawBins(ctx, movement.TenantID, movement.ProductID, -movement.Quantity, func(m *entities.BinMovement) {
+		m.MovementID = &movement.ID
+		m.Reference = movement.Reference
+	})
+}
+
+func (s *Service) drawBins(ctx context.Context, tenantID, productID uuid.UUID, quantity int, describe func(*entities.BinMovement)) error {
+	bins, err := s.repo.ListBinStock(ctx, tenantID, productID)
+	if err != nil || len(bins) == 0 {
+		return err
+	}
+
+	allocations, _ := entities.PlanPick(bins, quantity)
+	for _, allocation := range allocations {
+		bin := findBinStock(bins, allocation.LocationID)
+		bin.Quantity -= allocation.Quantity
+		if err := s.repo.SaveBinStock(ctx, bin); err != nil {
+			return err
+		}
+
+		from := allocation.LocationID
+		movement := &entities.BinMovement{
+			TenantID:       tenantID,
+			ProductID:      productID,
+			FromLocationID: &from,
+			Quantity:       allocation.Quantity,
+		}
+		describe(movement)
+		if err := s.repo.CreateBinMovement(ctx, movement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// binSummary arma el desglose del producto. La fila de stock y los bins quedan
+// bloqueados hasta el fin de la transacción: load lee la fila con
+// GetByProductID y ListBinStock toma los bins con unidades.
+func (s *Service) binSummary(ctx context.Context, tenantID, productID uuid.UUID) (*entities.BinSummary, error) {
+	stock, err := s.load(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	bins, err := s.repo.ListBinStock(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewBinSummary(productID, stock.Quantity, bins), nil
+}
+
+// productBin devuelve el bin si está en la sucursal del producto.
+func (s *Service) productBin(ctx context.Context, tenantID, productID, locationID uuid.UUID) (*entities.Bin, error) {
+	bin, err := s.repo.GetBin(ctx, tenantID, locationID)
+	if err != nil {
+		return nil, err
+	}
+	storeID, err := s.repo.ProductStoreID(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if bin.StoreID != storeID {
+		return nil, entities.ErrBinNotInStore
+	}
+	return bin, nil
+}
+
+// addToBin suma unidades al bin y actualiza el desglose.
+func (s *Service) addToBin(ctx context.Context, tenantID uuid.UUID, summary *entities.BinSummary, bin *entities.Bin, quantity int) error {
+	target := findBinStock(summary.Bins, bin.ID)
+	if target == nil {
+		target = &entities.BinStock{
+			TenantID:   tenantID,
+			ProductID:  summary.ProductID,
+			LocationID: bin.ID,
+			Path:       bin.Path,
+		}
+		summary.Bins = append(summary.Bins, target)
+		entities.SortPickOrder(summary.Bins)
+	}
+	target.Quantity += quantity
+	if err := s.repo.SaveBinStock(ctx, target); err != nil {
+		return err
+	}
+
+	summary.Placed = entities.PlacedQuantity(summary.Bins)
+	summary.Unplaced = summary.Quantity - summary.Placed
+	return nil
+}
+
+func findBinStock(bins []*entities.BinStock, locationID uuid.UUID) *entities.BinStock {
+	for _, bin := range bins {
+		if bin.LocationID == locationID {
+			return bin
+		}
+	}
+	return nil
+}
+
+func nonEmptyBins(bins []*entities.BinStock) []*entities.BinStock {
+	result := make([]*entities.BinStock, 0, len(bins))
+	for _, bin := range bins {
+		if bin.Quantity > 0 {
+			result = append(result, bin)
+		}
+	}
+	return result
+}
+
+func actor(userID string) *string {
+	if userID == "" {
+		return nil
+	}
+	return &userID
+}
@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bin es un bin de una sucursal visto desde el stock: dónde se pueden guardar unidades.
+type Bin struct {
+	ID      uuid.UUID `json:"id"`
+	StoreID uuid.UUID `json:"store_id"`
+	Path    string    `json:"path"`
+}
+
+// BinStock son las unidades de un producto guardadas en un bin.
+type BinStock struct {
+	ID         uuid.UUID `json:"id"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	ProductID  uuid.UUID `json:"product_id"`
+	LocationID uuid.UUID `json:"location_id"`
+	Path       string    `json:"path"`
+	Quantity   int       `json:"quantity"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// BinMovement registra unidades que entran a un bin (guardado), pasan de un bin a
+// otro o salen de un bin. Un guardado no tiene origen y una salida no tiene
+// destino; las salidas llevan el movimiento de stock o el documento que las causó.
+type BinMovement struct {
+	ID             uuid.UUID          `json:"id"`
+	TenantID       uuid.UUID          `json:"tenant_id"`
+	ProductID      uuid.UUID          `json:"product_id"`
+	FromLocationID *uuid.UUID         `json:"from_location_id,omitempty"`
+	ToLocationID   *uuid.UUID         `json:"to_location_id,omitempty"`
+	Quantity       int                `json:"quantity"`
+	MovementID     *uuid.UUID         `json:"movement_id,omitempty"`
+	Reference      *MovementReference `json:"reference,omitempty"`
+	CreatedBy      *string            `json:"created_by,omitempty"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+// BinSummary es el desglose por bin del stock de un producto. Unplaced son las
+// unidades en stock que todavía no se guardaron en ningún bin.
+type BinSummary struct {
+	ProductID uuid.UUID   `json:"product_id"`
+	Quantity  int         `json:"quantity"`
+	Placed    int         `json:"placed"`
+	Unplaced  int         `json:"unplaced"`
+	Bins      []*BinStock `json:"bins"`
+}
+
+func NewBinSummary(productID uuid.UUID, quantity int, bins []*BinStock) *BinSummary {
+	summary := &BinSummary{ProductID: productID, Quantity: quantity, Bins: bins}
+	summary.Placed = PlacedQuantity(bins)
+	summary.Unplaced = quantity - summary.Placed
+	if summary.Unplaced < 0 {
+		summary.Unplaced = 0
+	}
+	return summary
+}
+
+// PlacedQuantity suma las unidades guardadas en bins.
+func PlacedQuantity(bins []*BinStock) int {
+	total := 0
+	for _, bin := range bins {
+		total += bin.Quantity
+	}
+	return total
+}
+
+// PickLine es lo que hay que tomar de cada bin para sacar quantity unidades de un
+// producto. Unplaced son las unidades que no están en ningún bin.
+type PickLine struct {
+	ProductID uuid.UUID        `json:"product_id"`
+	Quantity  int              `json:"quantity"`
+	Bins      []PickAllocation `json:"bins"`
+	Unplaced  int              `json:"unplaced"`
+}
+
+// PickAllocation indica cuántas unidades tomar de un bin.
+type PickAllocation struct {
+	LocationID uuid.UUID `json:"location_id"`
+	Path       string    `json:"path"`
+	Quantity   int       `json:"quantity"`
+}
+
+// SortPickOrder ordena los bins por path, que es el orden en que se recorre la
+// sucursal: zona, pasillo y bin.
+func SortPickOrder(bins []*BinStock) {
+	sort.SliceStable(bins, func(i, j int) bool { return bins[i].Path < bins[j].Path })
+}
+
+// PlanPick reparte quantity entre los bins en orden de recorrido. Devuelve lo que
+// se toma de cada bin y las unidades que no alcanzan a cubrirse desde bins, que
+// salen de lo que está sin ubicar.
+func PlanPick(bins []*BinStock, quantity int) ([]PickAllocation, int) {
+	sorted := make([]*BinStock, len(bins))
+	copy(sorted, bins)
+	SortPickOrder(sorted)
+
+	allocations := []PickAllocation{}
+	remaining := quantity
+	for _, bin := range sorted {
+		if remaining == 0 {
+			break
+		}
+		if bin.Quantity <= 0 {
+			continue
+		}
+		take := bin.Quantity
+		if take > remaining {
+			take = remaining
+		}
+		allocations = append(allocations, PickAllocation{LocationID: bin.LocationID, Path: bin.Path, Quantity: take})
+		remaining -= take
+	}
+	return allocations, remaining
+}
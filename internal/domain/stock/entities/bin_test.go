@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPlanPick(t *testing.T) {
+	bins := []*BinStock{
+		{LocationID: uuid.New(), Path: "B-01-01", Quantity: 5},
+		{LocationID: uuid.New(), Path: "A-02-03", Quantity: 2},
+		{LocationID: uuid.New(), Path: "A-01-07", Quantity: 3},
+	}
+
+	allocations, unplaced := PlanPick(bins, 6)
+	if unplaced != 0 {
+		t.Fatalf("unplaced: got %d, want 0", unplaced)
+	}
+	want := []struct {
+		path     string
+		quantity int
+	}{{"A-01-07", 3}, {"A-02-03", 2}, {"B-01-01", 1}}
+	if len(allocations) != len(want) {
+		t.Fatalf("allocations: got %d, want %d", len(allocations), len(want))
+	}
+	for i, w := range want {
+		if allocations[i].Path != w.path || allocations[i].Quantity != w.quantity {
+			t.Errorf("allocation %d: got %s x%d, want %s x%d", i, allocations[i].Path, allocations[i].Quantity, w.path, w.quantity)
+		}
+	}
+
+	if _, unplaced := PlanPick(bins, 12); unplaced != 2 {
+		t.Fatalf("unplaced: got %d, want 2", unplaced)
+	}
+	if bins[0].Path != "B-01-01" {
+		t.Fatal("PlanPick must not reorder the given bins")
+	}
+}
+
+func TestNewBinSummary(t *testing.T) {
+	summary := NewBinSummary(uuid.New(), 10, []*BinStock{{Quantity: 4}, {Quantity: 3}})
+	if summary.Placed != 7 || summary.Unplaced != 3 {
+		t.Fatalf("got placed %d unplaced %d, want 7 and 3", summary.Placed, summary.Unplaced)
+	}
+}
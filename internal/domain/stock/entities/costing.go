@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CostingMethod define cómo se valorizan las salidas de stock del tenant.
+type CostingMethod string
+
+const (
+	CostingMethodAverage CostingMethod = "average"
+	CostingMethodFIFO    CostingMethod = "fifo"
+)
+
+// CostLayer es una entrada de stock con su costo unitario y las unidades que
+// todavía no salieron.
+type CostLayer struct {
+	ID                uuid.UUID    `json:"id"`
+	TenantID          uuid.UUID    `json:"tenant_id"`
+	ProductID         uuid.UUID    `json:"product_id"`
+	UnitCost          money.Amount `json:"unit_cost"`
+	Quantity          int          `json:"quantity"`
+	RemainingQuantity int          `json:"remaining_quantity"`
+	ReceivedAt        time.Time    `json:"received_at"`
+}
+
+// ConsumeLayers descuenta quantity de las capas en el orden recibido (las más
+// antiguas primero) y devuelve el costo de lo consumido, las capas modificadas y
+// las unidades que no alcanzaron a cubrirse.
+func ConsumeLayers(layers []*CostLayer, quantity int) (money.Amount, []*CostLayer, int) {
+	var cost money.Amount
+	var consumed []*CostLayer
+	for _, layer := range layers {
+		if quantity == 0 {
+			break
+		}
+		if layer.RemainingQuantity == 0 {
+			continue
+		}
+		taken := min(layer.RemainingQuantity, quantity)
+		layer.RemainingQuantity -= taken
+		quantity -= taken
+		cost += layer.UnitCost.MulInt(taken)
+		consumed = append(consumed, layer)
+	}
+	return cost, consumed, quantity
+}
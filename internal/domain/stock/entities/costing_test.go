@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"testing"
+)
+
+func TestConsumeLayersOldestFirst(t *testing.T) {
+	layers := []*CostLayer{
+		{UnitCost: money.FromInt(10), Quantity: 3, RemainingQuantity: 3},
+		{UnitCost: money.FromInt(12), Quantity: 5, RemainingQuantity: 5},
+	}
+
+	cost, consumed, uncovered := ConsumeLayers(layers, 4)
+
+	if cost != money.FromInt(42) {
+		t.Errorf("expected cost 42, got %s", cost)
+	}
+	if len(consumed) != 2 || uncovered != 0 {
+		t.Errorf("expected 2 consumed layers and nothing uncovered, got %d and %d", len(consumed), uncovered)
+	}
+	if layers[0].RemainingQuantity != 0 || layers[1].RemainingQuantity != 4 {
+		t.Errorf("unexpected remaining quantities: %d, %d", layers[0].RemainingQuantity, layers[1].RemainingQuantity)
+	}
+}
+
+func TestConsumeLayersReportsUncovered(t *testing.T) {
+	layers := []*CostLayer{{UnitCost: money.FromInt(7), Quantity: 2, RemainingQuantity: 2}}
+
+	cost, _, uncovered := ConsumeLayers(layers, 5)
+
+	if cost != money.FromInt(14) || uncovered != 3 {
+		t.Errorf("expected cost 14 with 3 uncovered, got %s with %d", cost, uncovered)
+	}
+}
+
+func TestAverageCost(t *testing.T) {
+	stock := &Stock{Quantity: 3, InventoryValue: money.FromInt(10)}
+
+	if got, want := stock.AverageCost().String(), "3.3333"; got != want {
+		t.Errorf("expected average %s, got %s", want, got)
+	}
+	if got := stock.AverageIssueCost(2).String(); got != "6.6667" {
+		t.Errorf("expected issue cost 6.6667, got %s", got)
+	}
+	if got := stock.AverageIssueCost(3); got != money.FromInt(10) {
+		t.Errorf("issuing every unit should take the whole value, got %s", got)
+	}
+}
@@ -1,10 +1,34 @@
 package entities
 
-import "errors"
+import "motico-api/pkg/apperror"
 
 var (
-	ErrStockNotFound         = errors.New("stock not found")
-	ErrInsufficientStock     = errors.New("insufficient stock available")
-	ErrInvalidQuantity       = errors.New("quantity must be greater than or equal to zero")
-	ErrInvalidReservedAmount = errors.New("reserved quantity cannot exceed total quantity")
+	ErrStockNotFound             = apperror.New(apperror.KindNotFound, "stock_not_found", "stock not found")
+	ErrInsufficientStock         = apperror.New(apperror.KindConflict, "insufficient_stock", "insufficient stock available")
+	ErrInvalidQuantity           = apperror.New(apperror.KindInvalid, "invalid_quantity", "quantity must be greater than or equal to zero")
+	ErrInvalidReservedAmount     = apperror.New(apperror.KindConflict, "invalid_reserved_amount", "reserved quantity cannot exceed total quantity")
+	ErrReservationNotFound       = apperror.New(apperror.KindNotFound, "reservation_not_found", "reservation not found")
+	ErrInvalidReservationOwner   = apperror.New(apperror.KindInvalid, "invalid_reservation_owner", "invalid reservation owner")
+	ErrReleaseExceedsReservation = apperror.New(apperror.KindConflict, "release_exceeds_reservation", "release quantity exceeds the reserved quantity")
+	ErrInvalidUnitCost           = apperror.New(apperror.KindInvalid, "invalid_unit_cost", "unit cost must be greater than or equal to zero")
+	ErrReleaseExceedsQuarantine  = apperror.New(apperror.KindConflict, "release_exceeds_quarantine", "release quantity exceeds the quarantined quantity")
+	ErrLotRequired               = apperror.New(apperror.KindInvalid, "lot_required", "lot number is required to receive a lot-tracked product")
+	ErrLotNotFound               = apperror.New(apperror.KindNotFound, "lot_not_found", "lot not found")
+	ErrLotExpiryMismatch         = apperror.New(apperror.KindConflict, "lot_expiry_mismatch", "lot already exists with a different expiry date")
+	ErrProductNotLotTracked      = apperror.New(apperror.KindInvalid, "product_not_lot_tracked", "product is not lot-tracked")
+	ErrInsufficientLotStock      = apperror.New(apperror.KindConflict, "insufficient_lot_stock", "insufficient available stock in the lot")
+	ErrSerialsRequired           = apperror.New(apperror.KindInvalid, "serials_required", "serial numbers are required for serial-tracked products")
+	ErrSerialCountMismatch       = apperror.New(apperror.KindInvalid, "serial_count_mismatch", "number of serials must match the quantity")
+	ErrDuplicateSerial           = apperror.New(apperror.KindInvalid, "duplicate_serial", "a serial number can only appear once")
+	ErrProductNotSerialTracked   = apperror.New(apperror.KindInvalid, "product_not_serial_tracked", "product is not serial-tracked")
+	ErrSerialNotFound            = apperror.New(apperror.KindNotFound, "serial_not_found", "serial not found")
+	ErrSerialNotAvailable        = apperror.New(apperror.KindConflict, "serial_not_available", "serial is not available in this product stock")
+	ErrSerialAlreadyInStock      = apperror.New(apperror.KindConflict, "serial_already_in_stock", "serial is already in stock")
+	ErrInvalidSerialTransition   = apperror.New(apperror.KindConflict, "invalid_serial_transition", "invalid serial status transition")
+	ErrBinNotFound               = apperror.New(apperror.KindUnprocessable, "bin_not_found", "bin not found")
+	ErrBinNotInStore             = apperror.New(apperror.KindUnprocessable, "bin_not_in_store", "bin is not in the product store")
+	ErrSameBin                   = apperror.New(apperror.KindInvalid, "same_bin", "source and destination bins must be different")
+	ErrPutawayExceedsUnplaced    = apperror.New(apperror.KindConflict, "putaway_exceeds_unplaced", "quantity exceeds the units not yet placed in a bin")
+	ErrInsufficientBinStock      = apperror.New(apperror.KindConflict, "insufficient_bin_stock", "insufficient stock in the source bin")
+	ErrProductArchived           = apperror.New(apperror.KindConflict, "product_archived", "product or its store is archived")
 )
@@ -0,0 +1,115 @@
+package entities
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lot es el stock de un producto de un mismo lote. La suma de los lotes de un
+// producto con seguimiento por lote es su cantidad en stock.
+type Lot struct {
+	ID               uuid.UUID  `json:"id"`
+	TenantID         uuid.UUID  `json:"tenant_id"`
+	ProductID        uuid.UUID  `json:"product_id"`
+	LotNumber        string     `json:"lot_number"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	Quantity         int        `json:"quantity"`
+	ReservedQuantity int        `json:"reserved_quantity"`
+	ReceivedAt       time.Time  `json:"received_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+func (l *Lot) AvailableQuantity() int {
+	return l.Quantity - l.ReservedQuantity
+}
+
+// IsExpired indica si el lote venció antes del día de now. Un lote sin fecha de
+// vencimiento no vence.
+func (l *Lot) IsExpired(now time.Time) bool {
+	if l.ExpiresAt == nil {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return l.ExpiresAt.Before(today)
+}
+
+// LotSpec identifica un lote: en una entrada, el lote que recibe las unidades y
+// su vencimiento; en una salida, el lote puntual del que salen.
+type LotSpec struct {
+	Number    string
+	ExpiresAt *time.Time
+}
+
+// LotMovement es la parte de un movimiento que corresponde a un lote. Quantity
+// lleva el signo del movimiento.
+type LotMovement struct {
+	LotID     uuid.UUID  `json:"lot_id"`
+	LotNumber string     `json:"lot_number"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Quantity  int        `json:"quantity"`
+}
+
+// LotReservation es la parte de una reserva retenida en un lote.
+type LotReservation struct {
+	TenantID  uuid.UUID
+	LotID     uuid.UUID
+	Owner     ReservationOwner
+	Quantity  int
+	ExpiresAt *time.Time
+}
+
+// SortFEFO ordena los lotes del que vence primero al que vence último; los lotes
+// sin vencimiento van al final y, a igual vencimiento, sale primero el más antiguo.
+func SortFEFO(lots []*Lot) {
+	sort.SliceStable(lots, func(i, j int) bool {
+		a, b := lots[i], lots[j]
+		switch {
+		case a.ExpiresAt == nil && b.ExpiresAt != nil:
+			return false
+		case a.ExpiresAt != nil && b.ExpiresAt == nil:
+			return true
+		case a.ExpiresAt != nil && !a.ExpiresAt.Equal(*b.ExpiresAt):
+			return a.ExpiresAt.Before(*b.ExpiresAt)
+		}
+		return a.ReceivedAt.Before(b.ReceivedAt)
+	})
+}
+
+// AllocateFEFO toma quantity unidades disponibles de los lotes en orden FEFO.
+// Con skipExpired no toma de lotes vencidos, que no se pueden reservar para la
+// venta. Devuelve lo tomado de cada lote (en positivo) y las unidades que los
+// lotes no alcanzaron a cubrir. No modifica los lotes.
+func AllocateFEFO(lots []*Lot, quantity int, now time.Time, skipExpired bool) ([]LotMovement, int) {
+	ordered := make([]*Lot, len(lots))
+	copy(ordered, lots)
+	SortFEFO(ordered)
+
+	var allocations []LotMovement
+	remaining := quantity
+	for _, lot := range ordered {
+		if remaining == 0 {
+			break
+		}
+		if skipExpired && lot.IsExpired(now) {
+			continue
+		}
+		take := lot.AvailableQuantity()
+		if take <= 0 {
+			continue
+		}
+		if take > remaining {
+			take = remaining
+		}
+		allocations = append(allocations, LotMovement{
+			LotID:     lot.ID,
+			LotNumber: lot.LotNumber,
+			ExpiresAt: lot.ExpiresAt,
+			Quantity:  take,
+		})
+		remaining -= take
+	}
+
+	return allocations, remaining
+}
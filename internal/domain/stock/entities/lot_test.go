@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func date(s string) *time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return &t
+}
+
+func TestAllocateFEFOTakesEarliestExpiryFirst(t *testing.T) {
+	now, _ := time.Parse("2006-01-02", "2026-03-01")
+	noExpiry := &Lot{ID: uuid.New(), LotNumber: "C", Quantity: 10}
+	late := &Lot{ID: uuid.New(), LotNumber: "B", ExpiresAt: date("2026-09-01"), Quantity: 5}
+	early := &Lot{ID: uuid.New(), LotNumber: "A", ExpiresAt: date("2026-04-01"), Quantity: 4, ReservedQuantity: 1}
+
+	allocations, uncovered := AllocateFEFO([]*Lot{noExpiry, late, early}, 10, now, true)
+	if uncovered != 0 {
+		t.Fatalf("uncovered: got %d, want 0", uncovered)
+	}
+
+	want := []struct {
+		number   string
+		quantity int
+	}{{"A", 3}, {"B", 5}, {"C", 2}}
+	if len(allocations) != len(want) {
+		t.Fatalf("allocations: got %d, want %d", len(allocations), len(want))
+	}
+	for i, w := range want {
+		if allocations[i].LotNumber != w.number || allocations[i].Quantity != w.quantity {
+			t.Errorf("allocation %d: got %s x%d, want %s x%d", i, allocations[i].LotNumber, allocations[i].Quantity, w.number, w.quantity)
+		}
+	}
+}
+
+func TestAllocateFEFOSkipsExpiredLots(t *testing.T) {
+	now, _ := time.Parse("2006-01-02", "2026-03-01")
+	expired := &Lot{ID: uuid.New(), LotNumber: "OLD", ExpiresAt: date("2026-02-28"), Quantity: 5}
+	valid := &Lot{ID: uuid.New(), LotNumber: "NEW", ExpiresAt: date("2026-03-01"), Quantity: 2}
+
+	allocations, uncovered := AllocateFEFO([]*Lot{expired, valid}, 4, now, true)
+	if len(allocations) != 1 || allocations[0].LotNumber != "NEW" || uncovered != 2 {
+		t.Errorf("got %+v uncovered %d, want only NEW and 2 uncovered", allocations, uncovered)
+	}
+
+	allocations, uncovered = AllocateFEFO([]*Lot{expired, valid}, 4, now, false)
+	if len(allocations) != 1 || allocations[0].LotNumber != "OLD" || uncovered != 0 {
+		t.Errorf("got %+v uncovered %d, want OLD first when expired lots are allowed", allocations, uncovered)
+	}
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MovementReason string
+
+const (
+	MovementReasonOpening      MovementReason = "opening"
+	MovementReasonReceipt      MovementReason = "receipt"
+	MovementReasonAdjustment   MovementReason = "adjustment"
+	MovementReasonTransferIn   MovementReason = "transfer_in"
+	MovementReasonTransferOut  MovementReason = "transfer_out"
+	MovementReasonTransferLoss MovementReason = "transfer_loss"
+	// MovementReasonTransferSurplus son unidades que llegaron a destino por
+	// encima de lo enviado en un traspaso.
+	MovementReasonTransferSurplus MovementReason = "transfer_surplus"
+	MovementReasonSale            MovementReason = "sale"
+	MovementReasonCustomerReturn  MovementReason = "customer_return"
+	MovementReasonSupplierReturn  MovementReason = "supplier_return"
+	MovementReasonScrap           MovementReason = "scrap"
+	MovementReasonCycleCount      MovementReason = "cycle_count"
+)
+
+// MovementReference identifica el documento que originó un movimiento.
+type MovementReference struct {
+	Type string    `json:"type"`
+	ID   uuid.UUID `json:"id"`
+}
+
+// Movement es un cambio de cantidad de stock con su costo y el saldo que dejó. Los
+// saldos permiten valorizar el inventario a una fecha sin recorrer todo el libro.
+type Movement struct {
+	ID            uuid.UUID          `json:"id"`
+	TenantID      uuid.UUID          `json:"tenant_id"`
+	ProductID     uuid.UUID          `json:"product_id"`
+	Reason        MovementReason     `json:"reason"`
+	Quantity      int                `json:"quantity"`
+	UnitCost      money.Amount       `json:"unit_cost"`
+	TotalCost     money.Amount       `json:"total_cost"`
+	QuantityAfter int                `json:"quantity_after"`
+	ValueAfter    money.Amount       `json:"value_after"`
+	Reference     *MovementReference `json:"reference,omitempty"`
+	Lots          []LotMovement      `json:"lots,omitempty"`
+	Serials       []string           `json:"serials,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+}
@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReservationOwnerType string
+
+const (
+	ReservationOwnerTransfer   ReservationOwnerType = "transfer"
+	ReservationOwnerSalesOrder ReservationOwnerType = "sales_order"
+	ReservationOwnerCart       ReservationOwnerType = "cart"
+)
+
+func (t ReservationOwnerType) IsValid() bool {
+	switch t {
+	case ReservationOwnerTransfer, ReservationOwnerSalesOrder, ReservationOwnerCart:
+		return true
+	}
+	return false
+}
+
+// ReservationOwner identifica quién retiene el stock reservado.
+type ReservationOwner struct {
+	Type ReservationOwnerType `json:"type"`
+	ID   uuid.UUID            `json:"id"`
+}
+
+// Reservation es una retención de stock de un producto a nombre de un dueño. La
+// suma de las reservas de un producto es su stock.reserved_quantity.
+type Reservation struct {
+	ID        uuid.UUID        `json:"id"`
+	TenantID  uuid.UUID        `json:"tenant_id"`
+	ProductID uuid.UUID        `json:"product_id"`
+	Owner     ReservationOwner `json:"owner"`
+	Quantity  int              `json:"quantity"`
+	ExpiresAt *time.Time       `json:"expires_at,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+func (r *Reservation) IsExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !r.ExpiresAt.After(now)
+}
@@ -0,0 +1,22 @@
+package entities
+
+import "github.com/google/uuid"
+
+// ReservationDrift compara el reserved_quantity guardado con la suma de las
+// reservas vigentes del producto.
+type ReservationDrift struct {
+	TenantID         uuid.UUID `json:"tenant_id"`
+	ProductID        uuid.UUID `json:"product_id"`
+	Quantity         int       `json:"quantity"`
+	ReservedQuantity int       `json:"reserved_quantity"`
+	ExpectedReserved int       `json:"expected_reserved"`
+}
+
+func (d *ReservationDrift) Drift() int {
+	return d.ReservedQuantity - d.ExpectedReserved
+}
+
+// CorrectedReserved es el valor a guardar: nunca puede superar la cantidad en stock.
+func (d *ReservationDrift) CorrectedReserved() int {
+	return min(d.ExpectedReserved, d.Quantity)
+}
@@ -0,0 +1,130 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SerialStatus string
+
+const (
+	SerialStatusInStock   SerialStatus = "in_stock"
+	SerialStatusReserved  SerialStatus = "reserved"
+	SerialStatusInTransit SerialStatus = "in_transit"
+	SerialStatusSold      SerialStatus = "sold"
+	// SerialStatusRemoved es una unidad que salió del stock sin venderse: baja,
+	// devolución al proveedor, pérdida en un traspaso o ajuste.
+	SerialStatusRemoved SerialStatus = "removed"
+)
+
+// serialTransitions define los cambios de estado de una unidad. Las unidades
+// vendidas o dadas de baja pueden volver al stock con una devolución o un ajuste.
+var serialTransitions = map[SerialStatus][]SerialStatus{
+	SerialStatusInStock:   {SerialStatusReserved, SerialStatusInTransit, SerialStatusSold, SerialStatusRemoved},
+	SerialStatusReserved:  {SerialStatusInStock, SerialStatusInTransit},
+	SerialStatusInTransit: {SerialStatusInStock, SerialStatusRemoved},
+	SerialStatusSold:      {SerialStatusInStock},
+	SerialStatusRemoved:   {SerialStatusInStock},
+}
+
+func (s SerialStatus) IsValid() bool {
+	_, ok := serialTransitions[s]
+	return ok
+}
+
+func (s SerialStatus) CanTransitionTo(next SerialStatus) bool {
+	for _, allowed := range serialTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// InStock indica si la unidad cuenta en el stock de su publicación: las
+// reservadas y las que están en tránsito siguen en el stock de origen hasta que
+// se reciben en destino.
+func (s SerialStatus) InStock() bool {
+	return s == SerialStatusInStock || s == SerialStatusReserved || s == SerialStatusInTransit
+}
+
+// SerialUnit es una unidad de un producto con seguimiento por número de serie.
+// ProductID es la publicación donde está la unidad y StoreID su sucursal.
+type SerialUnit struct {
+	ID           uuid.UUID         `json:"id"`
+	TenantID     uuid.UUID         `json:"tenant_id"`
+	SerialNumber string            `json:"serial_number"`
+	ProductID    uuid.UUID         `json:"product_id"`
+	StoreID      uuid.UUID         `json:"store_id"`
+	Status       SerialStatus      `json:"status"`
+	Owner        *ReservationOwner `json:"owner,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// TransitionTo cambia el estado de la unidad. Solo las unidades reservadas o en
+// tránsito conservan su dueño.
+func (u *SerialUnit) TransitionTo(next SerialStatus) error {
+	if !u.Status.CanTransitionTo(next) {
+		return ErrInvalidSerialTransition
+	}
+	u.Status = next
+	if next != SerialStatusReserved && next != SerialStatusInTransit {
+		u.Owner = nil
+	}
+	return nil
+}
+
+// SerialEvent es un paso en la historia de una unidad: el estado y la publicación
+// en que quedó, con el movimiento de stock o el documento que lo causó.
+type SerialEvent struct {
+	ID         uuid.UUID          `json:"id"`
+	TenantID   uuid.UUID          `json:"tenant_id"`
+	UnitID     uuid.UUID          `json:"unit_id"`
+	Status     SerialStatus       `json:"status"`
+	ProductID  uuid.UUID          `json:"product_id"`
+	StoreID    uuid.UUID          `json:"store_id"`
+	MovementID *uuid.UUID         `json:"movement_id,omitempty"`
+	Reason     *MovementReason    `json:"reason,omitempty"`
+	Reference  *MovementReference `json:"reference,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// NewSerialEvent registra el estado actual de la unidad.
+func NewSerialEvent(unit *SerialUnit) *SerialEvent {
+	return &SerialEvent{
+		TenantID:  unit.TenantID,
+		UnitID:    unit.ID,
+		Status:    unit.Status,
+		ProductID: unit.ProductID,
+		StoreID:   unit.StoreID,
+	}
+}
+
+// SerialHistory es una unidad con todos sus eventos, del más antiguo al más reciente.
+type SerialHistory struct {
+	Unit   *SerialUnit    `json:"unit"`
+	Events []*SerialEvent `json:"events"`
+}
+
+// ValidateSerials controla que haya un número de serie distinto por unidad.
+func ValidateSerials(serials []string, quantity int) error {
+	if len(serials) == 0 {
+		return ErrSerialsRequired
+	}
+	if len(serials) != quantity {
+		return ErrSerialCountMismatch
+	}
+	seen := make(map[string]bool, len(serials))
+	for _, serial := range serials {
+		if serial == "" {
+			return ErrSerialsRequired
+		}
+		if seen[serial] {
+			return ErrDuplicateSerial
+		}
+		seen[serial] = true
+	}
+	return nil
+}
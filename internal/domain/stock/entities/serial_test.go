@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSerialUnitTransitionKeepsOwnerOnlyWhileHeld(t *testing.T) {
+	owner := ReservationOwner{Type: ReservationOwnerTransfer, ID: uuid.New()}
+	unit := &SerialUnit{Status: SerialStatusReserved, Owner: &owner}
+
+	if err := unit.TransitionTo(SerialStatusInTransit); err != nil {
+		t.Fatalf("reserved -> in_transit: %v", err)
+	}
+	if unit.Owner == nil {
+		t.Fatal("in_transit unit lost its owner")
+	}
+
+	if err := unit.TransitionTo(SerialStatusInStock); err != nil {
+		t.Fatalf("in_transit -> in_stock: %v", err)
+	}
+	if unit.Owner != nil {
+		t.Errorf("in_stock unit kept owner %+v", unit.Owner)
+	}
+}
+
+func TestSerialUnitTransitionRejectsInvalidMoves(t *testing.T) {
+	cases := []struct {
+		from, to SerialStatus
+	}{
+		{SerialStatusSold, SerialStatusReserved},
+		{SerialStatusInTransit, SerialStatusSold},
+		{SerialStatusRemoved, SerialStatusInTransit},
+		{SerialStatusInStock, SerialStatusInStock},
+	}
+	for _, c := range cases {
+		unit := &SerialUnit{Status: c.from}
+		if err := unit.TransitionTo(c.to); err != ErrInvalidSerialTransition {
+			t.Errorf("%s -> %s: got %v, want ErrInvalidSerialTransition", c.from, c.to, err)
+		}
+	}
+}
+
+func TestSerialStatusInStock(t *testing.T) {
+	for status, want := range map[SerialStatus]bool{
+		SerialStatusInStock:   true,
+		SerialStatusReserved:  true,
+		SerialStatusInTransit: true,
+		SerialStatusSold:      false,
+		SerialStatusRemoved:   false,
+	} {
+		if got := status.InStock(); got != want {
+			t.Errorf("%s.InStock(): got %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestValidateSerials(t *testing.T) {
+	cases := []struct {
+		name     string
+		serials  []string
+		quantity int
+		want     error
+	}{
+		{"ok", []string{"A1", "A2"}, 2, nil},
+		{"missing", nil, 1, ErrSerialsRequired},
+		{"empty serial", []string{""}, 1, ErrSerialsRequired},
+		{"count mismatch", []string{"A1"}, 2, ErrSerialCountMismatch},
+		{"duplicate", []string{"A1", "A1"}, 2, ErrDuplicateSerial},
+	}
+	for _, c := range cases {
+		if got := ValidateSerials(c.serials, c.quantity); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"motico-api/pkg/money"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,14 +13,40 @@ type Stock struct {
 	ProductID        uuid.UUID `json:"product_id"`
 	Quantity         int       `json:"quantity"`
 	ReservedQuantity int       `json:"reserved_quantity"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	// QuarantinedQuantity son unidades en stock retenidas hasta su inspección.
+	QuarantinedQuantity int          `json:"quarantined_quantity"`
+	InventoryValue      money.Amount `json:"inventory_value"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+}
+
+// HeldQuantity son las unidades en stock que no se pueden vender ni mover:
+// reservadas o en cuarentena.
+func (s *Stock) HeldQuantity() int {
+	return s.ReservedQuantity + s.QuarantinedQuantity
 }
 
 func (s *Stock) AvailableQuantity() int {
-	available := s.Quantity - s.ReservedQuantity
+	available := s.Quantity - s.HeldQuantity()
 	if available < 0 {
 		return 0
 	}
 	return available
 }
+
+// AverageCost es el costo promedio ponderado de las unidades en stock.
+func (s *Stock) AverageCost() money.Amount {
+	if s.Quantity <= 0 {
+		return 0
+	}
+	return s.InventoryValue.DivInt(s.Quantity)
+}
+
+// AverageIssueCost valoriza una salida al costo promedio. Si salen todas las
+// unidades sale todo el valor, así no quedan restos de redondeo en el stock.
+func (s *Stock) AverageIssueCost(quantity int) money.Amount {
+	if quantity >= s.Quantity {
+		return s.InventoryValue
+	}
+	return s.InventoryValue.MulInt(quantity).DivInt(s.Quantity)
+}
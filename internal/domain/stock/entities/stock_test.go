@@ -0,0 +1,19 @@
+package entities
+
+import "testing"
+
+func TestAvailableQuantityExcludesHeldUnits(t *testing.T) {
+	stock := &Stock{Quantity: 10, ReservedQuantity: 3, QuarantinedQuantity: 4}
+
+	if got := stock.HeldQuantity(); got != 7 {
+		t.Fatalf("held quantity: got %d, want 7", got)
+	}
+	if got := stock.AvailableQuantity(); got != 3 {
+		t.Fatalf("available quantity: got %d, want 3", got)
+	}
+
+	stock.QuarantinedQuantity = 9
+	if got := stock.AvailableQuantity(); got != 0 {
+		t.Fatalf("available quantity should not go negative, got %d", got)
+	}
+}
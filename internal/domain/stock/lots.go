@@ -0,0 +1,343 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/stock/entities"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListLots devuelve los lotes con unidades de un producto, en orden FEFO.
+func (s *Service) ListLots(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Lot, error) {
+	lots, err := s.repo.ListLots(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	entities.SortFEFO(lots)
+	return lots, nil
+}
+
+func (s *Service) isLotTracked(ctx context.Context, tenantID, productID uuid.UUID) (bool, error) {
+	tracking, err := s.repo.ProductTracking(ctx, tenantID, productID)
+	if err != nil {
+		return false, err
+	}
+	return tracking == catalogentities.TrackingLot, nil
+}
+
+// applyLots reparte el cambio entre los lotes del producto. Las entradas van al
+// lote indicado (o a los lotes de origen en un traspaso) y las salidas salen de
+// los lotes que retenía la reserva consumida, del lote indicado o, sin ellos, de
+// los lotes disponibles en orden FEFO.
+func (s *Service) applyLots(ctx context.Context, stock *entities.Stock, tracking catalogentities.Tracking, c change) ([]entities.LotMovement, error) {
+	if tracking != catalogentities.TrackingLot {
+		if c.lot != nil {
+			return nil, entities.ErrProductNotLotTracked
+		}
+		return nil, nil
+	}
+
+	switch {
+	case c.quantity > 0 && c.lots != nil:
+		moves := make([]entities.LotMovement, 0, len(c.lots))
+		for _, lot := range c.lots {
+			move, err := s.receiveLot(ctx, stock, entities.LotSpec{Number: lot.LotNumber, ExpiresAt: lot.ExpiresAt}, lot.Quantity)
+			if err != nil {
+				return nil, err
+			}
+			moves = append(moves, move)
+		}
+		return moves, nil
+	case c.quantity > 0:
+		if c.lot == nil || c.lot.Number == "" {
+			return nil, entities.ErrLotRequired
+		}
+		move, err := s.receiveLot(ctx, stock, *c.lot, c.quantity)
+		if err != nil {
+			return nil, err
+		}
+		return []entities.LotMovement{move}, nil
+	case c.quantity < 0 && c.lots != nil:
+		return s.issueReservedLots(ctx, stock, c.lots, -c.quantity)
+	case c.quantity < 0:
+		return s.issueLots(ctx, stock, c.lot, -c.quantity)
+	}
+	return nil, nil
+}
+
+func (s *Service) receiveLot(ctx context.Context, stock *entities.Stock, spec entities.LotSpec, quantity int) (entities.LotMovement, error) {
+	lot, err := s.repo.GetLot(ctx, stock.TenantID, stock.ProductID, spec.Number)
+	switch {
+	case errors.Is(err, entities.ErrLotNotFound):
+		lot = &entities.Lot{
+			TenantID:  stock.TenantID,
+			ProductID: stock.ProductID,
+			LotNumber: spec.Number,
+			ExpiresAt: spec.ExpiresAt,
+			Quantity:  quantity,
+		}
+		err = s.repo.CreateLot(ctx, lot)
+	case err != nil:
+		return entities.LotMovement{}, err
+	default:
+		if spec.ExpiresAt != nil && lot.ExpiresAt != nil && !sameDay(*spec.ExpiresAt, *lot.ExpiresAt) {
+			return entities.LotMovement{}, entities.ErrLotExpiryMismatch
+		}
+		if lot.ExpiresAt == nil {
+			lot.ExpiresAt = spec.ExpiresAt
+		}
+		lot.Quantity += quantity
+		err = s.repo.UpdateLot(ctx, lot)
+	}
+	if err != nil {
+		return entities.LotMovement{}, err
+	}
+
+	return entities.LotMovement{LotID: lot.ID, LotNumber: lot.LotNumber, ExpiresAt: lot.ExpiresAt, Quantity: quantity}, nil
+}
+
+// issueLots descuenta unidades no reservadas. Sin lote indicado toma primero de
+// los lotes vencidos, que son los que deben salir antes.
+func (s *Service) issueLots(ctx context.Context, stock *entities.Stock, spec *entities.LotSpec, quantity int) ([]entities.LotMovement, error) {
+	var lots []*entities.Lot
+	if spec != nil && spec.Number != "" {
+		lot, err := s.repo.GetLot(ctx, stock.TenantID, stock.ProductID, spec.Number)
+		if err != nil {
+			return nil, err
+		}
+		if lot.AvailableQuantity() < quantity {
+			return nil, entities.ErrInsufficientLotStock
+		}
+		lots = []*entities.Lot{lot}
+	} else {
+		var err error
+		if lots, err = s.repo.ListLots(ctx, stock.TenantID, stock.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	allocations, uncovered := entities.AllocateFEFO(lots, quantity, time.Now(), false)
+	if uncovered > 0 {
+		return nil, entities.ErrInsufficientStock
+	}
+
+	byID := make(map[uuid.UUID]*entities.Lot, len(lots))
+	for _, lot := range lots {
+		byID[lot.ID] = lot
+	}
+	for i := range allocations {
+		lot := byID[allocations[i].LotID]
+		lot.Quantity -= allocations[i].Quantity
+		if err := s.repo.UpdateLot(ctx, lot); err != nil {
+			return nil, err
+		}
+		allocations[i].Quantity = -allocations[i].Quantity
+	}
+
+	return allocations, nil
+}
+
+// issueReservedLots descuenta de cada lote lo que la reserva consumida retenía en
+// él. Si la reserva no cubría toda la salida, el resto sale por FEFO.
+func (s *Service) issueReservedLots(ctx context.Context, stock *entities.Stock, reserved []entities.LotMovement, quantity int) ([]entities.LotMovement, error) {
+	lots, err := s.repo.ListLots(ctx, stock.TenantID, stock.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entities.Lot, len(lots))
+	for _, lot := range lots {
+		byID[lot.ID] = lot
+	}
+
+	moves := make([]entities.LotMovement, 0, len(reserved))
+	for _, move := range reserved {
+		lot, ok := byID[move.LotID]
+		if !ok || lot.Quantity < -move.Quantity {
+			return nil, entities.ErrInsufficientLotStock
+		}
+		lot.Quantity += move.Quantity
+		if err := s.repo.UpdateLot(ctx, lot); err != nil {
+			return nil, err
+		}
+		moves = append(moves, move)
+		quantity += move.Quantity
+	}
+
+	if quantity > 0 {
+		rest, err := s.issueLots(ctx, stock, nil, quantity)
+		if err != nil {
+			return nil, err
+		}
+		moves = append(moves, rest...)
+	}
+	return moves, nil
+}
+
+// consumeReservation libera quantity unidades de la reserva del dueño y devuelve
+// lo que retenía en cada lote, empezando por el que vence primero, con signo de
+// salida: es lo que tiene que salir de cada lote. Sin dueño no hace nada.
+func (s *Service) consumeReservation(ctx context.Context, tenantID, productID uuid.UUID, owner *entities.ReservationOwner, quantity int) ([]entities.LotMovement, error) {
+	if owner == nil {
+		return nil, nil
+	}
+	if err := s.repo.Release(ctx, tenantID, productID, *owner, quantity); err != nil {
+		return nil, err
+	}
+	if err := s.releaseSerials(ctx, tenantID, productID, *owner, quantity); err != nil {
+		return nil, err
+	}
+
+	reservations, err := s.repo.ListLotReservations(ctx, tenantID, productID, *owner)
+	if err != nil || len(reservations) == 0 {
+		return nil, err
+	}
+	lots, err := s.repo.ListLots(ctx, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entities.Lot, len(lots))
+	for _, lot := range lots {
+		byID[lot.ID] = lot
+	}
+
+	var moves []entities.LotMovement
+	remaining := quantity
+	for i := len(reservations) - 1; i >= 0 && remaining > 0; i-- {
+		reservation := reservations[i]
+		lot, ok := byID[reservation.LotID]
+		if !ok {
+			continue
+		}
+		take := min(reservation.Quantity, remaining)
+
+		lot.ReservedQuantity -= take
+		if err := s.repo.UpdateLot(ctx, lot); err != nil {
+			return nil, err
+		}
+		reservation.Quantity -= take
+		if err := s.repo.SaveLotReservation(ctx, reservation); err != nil {
+			return nil, err
+		}
+		moves = append(moves, entities.LotMovement{
+			LotID:     lot.ID,
+			LotNumber: lot.LotNumber,
+			ExpiresAt: lot.ExpiresAt,
+			Quantity:  -take,
+		})
+		remaining -= take
+	}
+
+	return moves, nil
+}
+
+// reserveLots retiene en los lotes lo que se reservó del producto, tomando por
+// FEFO de los lotes no vencidos.
+func (s *Service) reserveLots(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error {
+	tracked, err := s.isLotTracked(ctx, tenantID, productID)
+	if err != nil || !tracked {
+		return err
+	}
+
+	lots, err := s.repo.ListLots(ctx, tenantID, productID)
+	if err != nil {
+		return err
+	}
+	allocations, uncovered := entities.AllocateFEFO(lots, quantity, time.Now(), true)
+	if uncovered > 0 {
+		return entities.ErrInsufficientStock
+	}
+
+	existing, err := s.repo.ListLotReservations(ctx, tenantID, productID, owner)
+	if err != nil {
+		return err
+	}
+	held := make(map[uuid.UUID]int, len(existing))
+	for _, r := range existing {
+		held[r.LotID] = r.Quantity
+	}
+
+	byID := make(map[uuid.UUID]*entities.Lot, len(lots))
+	for _, lot := range lots {
+		byID[lot.ID] = lot
+	}
+	for _, allocation := range allocations {
+		lot := byID[allocation.LotID]
+		lot.ReservedQuantity += allocation.Quantity
+		if err := s.repo.UpdateLot(ctx, lot); err != nil {
+			return err
+		}
+		err := s.repo.SaveLotReservation(ctx, &entities.LotReservation{
+			TenantID: tenantID,
+			LotID:    lot.ID,
+			Owner:    owner,
+			Quantity: held[lot.ID] + allocation.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseLots libera lo retenido por el dueño empezando por el lote que vence
+// último, así lo que sigue reservado es lo que vence primero.
+func (s *Service) releaseLots(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error {
+	reservations, err := s.repo.ListLotReservations(ctx, tenantID, productID, owner)
+	if err != nil || len(reservations) == 0 {
+		return err
+	}
+
+	lots, err := s.repo.ListLots(ctx, tenantID, productID)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uuid.UUID]*entities.Lot, len(lots))
+	for _, lot := range lots {
+		byID[lot.ID] = lot
+	}
+
+	remaining := quantity
+	for _, reservation := range reservations {
+		if remaining == 0 {
+			break
+		}
+		release := reservation.Quantity
+		if release > remaining {
+			release = remaining
+		}
+
+		if lot, ok := byID[reservation.LotID]; ok {
+			lot.ReservedQuantity -= release
+			if err := s.repo.UpdateLot(ctx, lot); err != nil {
+				return err
+			}
+		}
+		reservation.Quantity -= release
+		if err := s.repo.SaveLotReservation(ctx, reservation); err != nil {
+			return err
+		}
+		remaining -= release
+	}
+
+	return nil
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+func negateLots(lots []entities.LotMovement) []entities.LotMovement {
+	if lots == nil {
+		return nil
+	}
+	negated := make([]entities.LotMovement, len(lots))
+	for i, lot := range lots {
+		lot.Quantity = -lot.Quantity
+		negated[i] = lot
+	}
+	return negated
+}
@@ -2,15 +2,67 @@ package stock
 
 import (
 	"context"
+	catalogentities "motico-api/internal/domain/catalog/entities"
 	"motico-api/internal/domain/stock/entities"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Repository interface {
+	// GetByProductID bloquea la fila hasta el fin de la transacción.
 	GetByProductID(ctx context.Context, tenantID, productID uuid.UUID) (*entities.Stock, error)
+	SumByParentProduct(ctx context.Context, tenantID, parentID uuid.UUID) (*entities.Stock, error)
 	Create(ctx context.Context, stock *entities.Stock) error
+	// Update guarda cantidad, cuarentena y valor; reserved_quantity solo cambia
+	// con Reserve, Release y SetReservedQuantity.
 	Update(ctx context.Context, stock *entities.Stock) error
-	Reserve(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error
-	Release(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error
+	Reserve(ctx context.Context, reservation *entities.Reservation) error
+	Release(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error
+	ListReservations(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Reservation, error)
+	ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]*entities.Reservation, error)
+	ListReservationDrift(ctx context.Context, tenantID *uuid.UUID) ([]*entities.ReservationDrift, error)
+	SetReservedQuantity(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error
+	CostingMethod(ctx context.Context, tenantID uuid.UUID) (entities.CostingMethod, error)
+	ListOpenCostLayers(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.CostLayer, error)
+	CreateCostLayer(ctx context.Context, layer *entities.CostLayer) error
+	UpdateCostLayer(ctx context.Context, layer *entities.CostLayer) error
+	CreateMovement(ctx context.Context, movement *entities.Movement) error
+	ListMovementsByReference(ctx context.Context, tenantID uuid.UUID, reference entities.MovementReference) ([]*entities.Movement, error)
+	ProductTracking(ctx context.Context, tenantID, productID uuid.UUID) (catalogentities.Tracking, error)
+	// ListLots devuelve los lotes del producto que todavía tienen unidades.
+	ListLots(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Lot, error)
+	GetLot(ctx context.Context, tenantID, productID uuid.UUID, lotNumber string) (*entities.Lot, error)
+	CreateLot(ctx context.Context, lot *entities.Lot) error
+	UpdateLot(ctx context.Context, lot *entities.Lot) error
+	CreateLotMovements(ctx context.Context, tenantID, movementID uuid.UUID, lots []entities.LotMovement) error
+	// ListLotReservations devuelve lo que el dueño retiene en cada lote del
+	// producto, del lote que vence último al que vence primero.
+	ListLotReservations(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner) ([]*entities.LotReservation, error)
+	// SaveLotReservation fija la cantidad retenida; en cero la elimina.
+	SaveLotReservation(ctx context.Context, reservation *entities.LotReservation) error
+	// GetSerialUnit bloquea la unidad hasta el fin de la transacción.
+	GetSerialUnit(ctx context.Context, tenantID uuid.UUID, serialNumber string) (*entities.SerialUnit, error)
+	ListSerialUnits(ctx context.Context, tenantID, productID uuid.UUID, status *entities.SerialStatus) ([]*entities.SerialUnit, error)
+	// ListOwnerSerialUnits devuelve las unidades del producto que retiene el dueño en el estado indicado.
+	ListOwnerSerialUnits(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, status entities.SerialStatus) ([]*entities.SerialUnit, error)
+	// CreateSerialUnit y UpdateSerialUnit toman la sucursal de la publicación de la unidad.
+	CreateSerialUnit(ctx context.Context, unit *entities.SerialUnit) error
+	UpdateSerialUnit(ctx context.Context, unit *entities.SerialUnit) error
+	CreateSerialEvent(ctx context.Context, event *entities.SerialEvent) error
+	ListSerialEvents(ctx context.Context, tenantID, unitID uuid.UUID) ([]*entities.SerialEvent, error)
+	// GetBin devuelve la ubicación si es un bin; ErrBinNotFound si no existe o es
+	// una zona o un pasillo.
+	GetBin(ctx context.Context, tenantID, locationID uuid.UUID) (*entities.Bin, error)
+	ProductStoreID(ctx context.Context, tenantID, productID uuid.UUID) (uuid.UUID, error)
+	// ProductArchived indica si el producto o su sucursal están archivados.
+	ProductArchived(ctx context.Context, tenantID, productID uuid.UUID) (bool, error)
+	// ListByStore devuelve el stock con unidades de los productos de la sucursal.
+	ListByStore(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.Stock, error)
+	// ListBinStock devuelve los bins con unidades del producto en orden de recorrido
+	// y los bloquea hasta el fin de la transacción.
+	ListBinStock(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.BinStock, error)
+	// SaveBinStock fija la cantidad del producto en el bin, creando la fila si no existe.
+	SaveBinStock(ctx context.Context, bin *entities.BinStock) error
+	CreateBinMovement(ctx context.Context, movement *entities.BinMovement) error
 }
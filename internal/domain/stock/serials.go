@@ -0,0 +1,239 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/stock/entities"
+
+	"github.com/google/uuid"
+)
+
+// GetSerialHistory devuelve una unidad con su historia completa.
+func (s *Service) GetSerialHistory(ctx context.Context, tenantID uuid.UUID, serialNumber string) (*entities.SerialHistory, error) {
+	unit, err := s.repo.GetSerialUnit(ctx, tenantID, serialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.ListSerialEvents(ctx, tenantID, unit.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.SerialHistory{Unit: unit, Events: events}, nil
+}
+
+// ListSerials devuelve las unidades que están en la publicación, opcionalmente
+// filtradas por estado.
+func (s *Service) ListSerials(ctx context.Context, tenantID, productID uuid.UUID, status *entities.SerialStatus) ([]*entities.SerialUnit, error) {
+	return s.repo.ListSerialUnits(ctx, tenantID, productID, status)
+}
+
+// DispatchSerials pasa a tránsito las unidades que el dueño tiene reservadas en el
+// producto. Las unidades siguen en la publicación de origen hasta que se reciben.
+func (s *Service) DispatchSerials(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		units, err := s.repo.ListOwnerSerialUnits(ctx, tenantID, productID, owner, entities.SerialStatusReserved)
+		if err != nil {
+			return err
+		}
+		for _, unit := range units {
+			if err := s.transitionSerial(ctx, unit, entities.SerialStatusInTransit, ownerReference(owner)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// applySerials mueve las unidades indicadas en el cambio. Las entradas crean la
+// unidad o la devuelven al stock en esta publicación; las salidas la dejan
+// vendida, en tránsito o dada de baja según el motivo.
+func (s *Service) applySerials(ctx context.Context, stock *entities.Stock, tracking catalogentities.Tracking, c change) ([]*entities.SerialUnit, error) {
+	if tracking != catalogentities.TrackingSerial {
+		if len(c.serials) > 0 {
+			return nil, entities.ErrProductNotSerialTracked
+		}
+		return nil, nil
+	}
+	if c.quantity == 0 {
+		return nil, nil
+	}
+
+	quantity := c.quantity
+	if quantity < 0 {
+		quantity = -quantity
+	}
+	if err := entities.ValidateSerials(c.serials, quantity); err != nil {
+		return nil, err
+	}
+
+	units := make([]*entities.SerialUnit, 0, len(c.serials))
+	for _, serial := range c.serials {
+		var unit *entities.SerialUnit
+		var err error
+		if c.quantity > 0 {
+			unit, err = s.receiveSerial(ctx, stock, serial, c.reason)
+		} else {
+			unit, err = s.issueSerial(ctx, stock, serial, c.reason)
+		}
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, unit)
+	}
+
+	return units, nil
+}
+
+func (s *Service) receiveSerial(ctx context.Context, stock *entities.Stock, serial string, reason entities.MovementReason) (*entities.SerialUnit, error) {
+	unit, err := s.repo.GetSerialUnit(ctx, stock.TenantID, serial)
+	if errors.Is(err, entities.ErrSerialNotFound) {
+		unit = &entities.SerialUnit{
+			TenantID:     stock.TenantID,
+			SerialNumber: serial,
+			ProductID:    stock.ProductID,
+			Status:       entities.SerialStatusInStock,
+		}
+		return unit, s.repo.CreateSerialUnit(ctx, unit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Solo una unidad en tránsito puede entrar estando en stock en otra publicación,
+	// y únicamente al recibir el traspaso.
+	arriving := unit.Status == entities.SerialStatusInTransit && reason == entities.MovementReasonTransferIn
+	if unit.Status.InStock() && !arriving {
+		return nil, entities.ErrSerialAlreadyInStock
+	}
+	if err := unit.TransitionTo(entities.SerialStatusInStock); err != nil {
+		return nil, err
+	}
+	unit.ProductID = stock.ProductID
+
+	return unit, s.repo.UpdateSerialUnit(ctx, unit)
+}
+
+func (s *Service) issueSerial(ctx context.Context, stock *entities.Stock, serial string, reason entities.MovementReason) (*entities.SerialUnit, error) {
+	unit, err := s.repo.GetSerialUnit(ctx, stock.TenantID, serial)
+	if err != nil {
+		return nil, err
+	}
+	if unit.ProductID != stock.ProductID {
+		return nil, entities.ErrSerialNotAvailable
+	}
+
+	next := entities.SerialStatusRemoved
+	switch reason {
+	case entities.MovementReasonSale:
+		next = entities.SerialStatusSold
+	case entities.MovementReasonTransferOut:
+		next = entities.SerialStatusInTransit
+	}
+
+	inTransit := unit.Status == entities.SerialStatusInTransit
+	switch {
+	case inTransit && reason == entities.MovementReasonTransferOut:
+		// Ya salió al despacharse el traspaso; el movimiento solo lo registra.
+	case unit.Status == entities.SerialStatusInStock, inTransit && reason == entities.MovementReasonTransferLoss:
+		if err := unit.TransitionTo(next); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, entities.ErrSerialNotAvailable
+	}
+
+	return unit, s.repo.UpdateSerialUnit(ctx, unit)
+}
+
+// reserveSerials reserva las unidades indicadas a nombre del dueño. Sin números
+// de serie la reserva queda solo sobre la cantidad, como en un carrito.
+func (s *Service) reserveSerials(ctx context.Context, req ReserveRequest) error {
+	if len(req.Serials) == 0 {
+		return nil
+	}
+
+	tracking, err := s.repo.ProductTracking(ctx, req.TenantID, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if tracking != catalogentities.TrackingSerial {
+		return entities.ErrProductNotSerialTracked
+	}
+	if err := entities.ValidateSerials(req.Serials, req.Quantity); err != nil {
+		return err
+	}
+
+	for _, serial := range req.Serials {
+		unit, err := s.repo.GetSerialUnit(ctx, req.TenantID, serial)
+		if err != nil {
+			return err
+		}
+		if unit.ProductID != req.ProductID || unit.Status != entities.SerialStatusInStock {
+			return entities.ErrSerialNotAvailable
+		}
+
+		owner := req.Owner
+		unit.Owner = &owner
+		if err := s.transitionSerial(ctx, unit, entities.SerialStatusReserved, ownerReference(owner)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseSerials devuelve al stock hasta quantity unidades reservadas por el
+// dueño. Las que ya están en tránsito no se tocan: vuelven al stock al recibirse.
+func (s *Service) releaseSerials(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error {
+	units, err := s.repo.ListOwnerSerialUnits(ctx, tenantID, productID, owner, entities.SerialStatusReserved)
+	if err != nil {
+		return err
+	}
+
+	for i, unit := range units {
+		if i == quantity {
+			break
+		}
+		if err := s.transitionSerial(ctx, unit, entities.SerialStatusInStock, ownerReference(owner)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transitionSerial cambia el estado de una unidad sin movimiento de stock y deja
+// el evento con el documento que lo causó.
+func (s *Service) transitionSerial(ctx context.Context, unit *entities.SerialUnit, next entities.SerialStatus, reference *entities.MovementReference) error {
+	if err := unit.TransitionTo(next); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateSerialUnit(ctx, unit); err != nil {
+		return err
+	}
+
+	event := entities.NewSerialEvent(unit)
+	event.Reference = reference
+	return s.repo.CreateSerialEvent(ctx, event)
+}
+
+// recordSerialEvents deja en la historia de cada unidad el movimiento que la movió.
+func (s *Service) recordSerialEvents(ctx context.Context, units []*entities.SerialUnit, movement *entities.Movement) error {
+	for _, unit := range units {
+		event := entities.NewSerialEvent(unit)
+		event.MovementID = &movement.ID
+		event.Reason = &movement.Reason
+		event.Reference = movement.Reference
+		if err := s.repo.CreateSerialEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ownerReference(owner entities.ReservationOwner) *entities.MovementReference {
+	return &entities.MovementReference{Type: string(owner.Type), ID: owner.ID}
+}
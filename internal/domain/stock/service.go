@@ -2,37 +2,105 @@ package stock
 
 import (
 	"context"
+	"errors"
 	"motico-api/config"
 	"motico-api/internal/domain/stock/entities"
+	"motico-api/internal/domain/transaction"
 	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo   Repository
-	config *config.Config
-	logger logger.Logger
+	repo      Repository
+	txManager transaction.Manager
+	config    *config.Config
+	logger    logger.Logger
 }
 
-func NewService(repo Repository, cfg *config.Config, log logger.Logger) *Service {
+func NewService(repo Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		config: cfg,
-		logger: log,
+		repo:      repo,
+		txManager: txManager,
+		config:    cfg,
+		logger:    log,
 	}
 }
 
+// UpdateRequest fija la cantidad en stock. Si la cantidad sube, las unidades que
+// entran se valorizan a UnitCost o, sin él, al costo promedio actual. En productos
+// con seguimiento por lote, Lot indica el lote que entra o del que salen las
+// unidades; con seguimiento por serie, Serials indica cada unidad que entra o sale.
 type UpdateRequest struct {
 	TenantID  uuid.UUID
 	ProductID uuid.UUID
 	Quantity  int
+	UnitCost  *money.Amount
+	Lot       *entities.LotSpec
+	Serials   []string
 }
 
+// AdjustRequest suma o resta unidades. Una entrada con UnitCost se registra como
+// recepción salvo que se indique otro motivo. Una salida con Owner consume la
+// reserva de ese dueño: las unidades salen de los lotes que retenía.
 type AdjustRequest struct {
 	TenantID  uuid.UUID
 	ProductID uuid.UUID
 	Amount    int
+	UnitCost  *money.Amount
+	Reason    entities.MovementReason
+	Reference *entities.MovementReference
+	Lot       *entities.LotSpec
+	Serials   []string
+	Owner     *entities.ReservationOwner
+}
+
+// MoveRequest pasa unidades de una publicación a otra llevando su costo de origen.
+// Con Owner las unidades salen de la reserva de ese dueño, como en AdjustRequest.
+type MoveRequest struct {
+	TenantID      uuid.UUID
+	FromProductID uuid.UUID
+	ToProductID   uuid.UUID
+	Quantity      int
+	Reference     *entities.MovementReference
+	Serials       []string
+	Owner         *entities.ReservationOwner
+}
+
+// ReserveRequest retiene stock a nombre de un dueño. En productos con seguimiento
+// por serie, Serials indica qué unidades quedan reservadas. Sin ExpiresAt la
+// reserva vence según el TTL configurado para el tipo de dueño, si lo tiene.
+type ReserveRequest struct {
+	TenantID  uuid.UUID
+	ProductID uuid.UUID
+	Owner     entities.ReservationOwner
+	Quantity  int
+	ExpiresAt *time.Time
+	Serials   []string
+}
+
+// QuarantineRequest retiene o libera unidades en cuarentena de un producto.
+type QuarantineRequest struct {
+	TenantID  uuid.UUID
+	ProductID uuid.UUID
+	Quantity  int
+}
+
+type ReleaseRequest struct {
+	TenantID  uuid.UUID
+	ProductID uuid.UUID
+	Owner     entities.ReservationOwner
+	Quantity  int
+}
+
+// CartHoldRequest aparta stock para un carrito mientras el cliente compra.
+type CartHoldRequest struct {
+	TenantID  uuid.UUID
+	ProductID uuid.UUID
+	CartID    uuid.UUID
+	Quantity  int
 }
 
 func (s *Service) GetByProductID(ctx context.Context, tenantID, productID uuid.UUID) (*entities.Stock, error) {
@@ -43,90 +111,560 @@ func (s *Service) GetByProductID(ctx context.Context, tenantID, productID uuid.U
 	return stock, nil
 }
 
+// GetByParentProductID suma el stock de todas las variantes de un producto padre.
+func (s *Service) GetByParentProductID(ctx context.Context, tenantID, parentID uuid.UUID) (*entities.Stock, error) {
+	return s.repo.SumByParentProduct(ctx, tenantID, parentID)
+}
+
 func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Stock, error) {
 	if req.Quantity < 0 {
 		return nil, entities.ErrInvalidQuantity
 	}
+	if req.UnitCost != nil && req.UnitCost.IsNegative() {
+		return nil, entities.ErrInvalidUnitCost
+	}
 
-	stock, err := s.repo.GetByProductID(ctx, req.TenantID, req.ProductID)
+	var stock *entities.Stock
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.ensureNotArchived(ctx, req.TenantID, req.ProductID); err != nil {
+			return err
+		}
+
+		var err error
+		if stock, err = s.load(ctx, req.TenantID, req.ProductID); err != nil {
+			return err
+		}
+		if req.Quantity < stock.HeldQuantity() {
+			return entities.ErrInvalidReservedAmount
+		}
+
+		delta := req.Quantity - stock.Quantity
+		_, err = s.apply(ctx, stock, change{
+			quantity: delta,
+			unitCost: req.UnitCost,
+			reason:   defaultReason(delta, req.UnitCost),
+			lot:      req.Lot,
+			serials:  req.Serials,
+		})
+		return err
+	})
 	if err != nil {
-		if err == entities.ErrStockNotFound {
-			stock = &entities.Stock{
-				TenantID:         req.TenantID,
-				ProductID:        req.ProductID,
-				Quantity:         req.Quantity,
-				ReservedQuantity: 0,
-			}
-			if err := s.repo.Create(ctx, stock); err != nil {
-				return nil, err
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (s *Service) Adjust(ctx context.Context, req AdjustRequest) (*entities.Stock, error) {
+	if req.UnitCost != nil && req.UnitCost.IsNegative() {
+		return nil, entities.ErrInvalidUnitCost
+	}
+	if req.Owner != nil && req.Amount >= 0 {
+		return nil, entities.ErrInvalidQuantity
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = defaultReason(req.Amount, req.UnitCost)
+	}
+
+	var stock *entities.Stock
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.ensureNotArchived(ctx, req.TenantID, req.ProductID); err != nil {
+			return err
+		}
+
+		reserved, err := s.consumeReservation(ctx, req.TenantID, req.ProductID, req.Owner, -req.Amount)
+		if err != nil {
+			return err
+		}
+		if stock, err = s.load(ctx, req.TenantID, req.ProductID); err != nil {
+			return err
+		}
+
+		newQuantity := stock.Quantity + req.Amount
+		if newQuantity < 0 {
+			return entities.ErrInsufficientStock
+		}
+		if newQuantity < stock.HeldQuantity() {
+			return entities.ErrInvalidReservedAmount
+		}
+
+		_, err = s.apply(ctx, stock, change{
+			quantity:  req.Amount,
+			unitCost:  req.UnitCost,
+			reason:    reason,
+			reference: req.Reference,
+			lot:       req.Lot,
+			lots:      reserved,
+			serials:   req.Serials,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+// Move descuenta unidades disponibles del origen y las suma al destino con el
+// mismo costo total con que salieron, así el traspaso no cambia el valor del
+// inventario. Los lotes que salen del origen (los de la reserva consumida o, sin
+// ella, por FEFO) y las unidades con número de serie llegan tal cual al destino.
+func (s *Service) Move(ctx context.Context, req MoveRequest) error {
+	if req.Quantity <= 0 {
+		return entities.ErrInvalidQuantity
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		reserved, err := s.consumeReservation(ctx, req.TenantID, req.FromProductID, req.Owner, req.Quantity)
+		if err != nil {
+			return err
+		}
+
+		source, err := s.repo.GetByProductID(ctx, req.TenantID, req.FromProductID)
+		if err != nil {
+			if errors.Is(err, entities.ErrStockNotFound) {
+				return entities.ErrInsufficientStock
 			}
-			return stock, nil
+			return err
+		}
+		if source.AvailableQuantity() < req.Quantity {
+			return entities.ErrInsufficientStock
+		}
+
+		out, err := s.apply(ctx, source, change{
+			quantity:  -req.Quantity,
+			reason:    entities.MovementReasonTransferOut,
+			reference: req.Reference,
+			lots:      reserved,
+			serials:   req.Serials,
+		})
+		if err != nil {
+			return err
+		}
+
+		target, err := s.load(ctx, req.TenantID, req.ToProductID)
+		if err != nil {
+			return err
 		}
+		_, err = s.apply(ctx, target, change{
+			quantity:  req.Quantity,
+			totalCost: &out.TotalCost,
+			reason:    entities.MovementReasonTransferIn,
+			reference: req.Reference,
+			lots:      negateLots(out.Lots),
+			serials:   req.Serials,
+		})
+		return err
+	})
+}
+
+// ListMovements devuelve los movimientos de stock generados por un documento.
+func (s *Service) ListMovements(ctx context.Context, tenantID uuid.UUID, reference entities.MovementReference) ([]*entities.Movement, error) {
+	return s.repo.ListMovementsByReference(ctx, tenantID, reference)
+}
+
+// change es un cambio de cantidad a aplicar sobre una fila de stock. Las entradas
+// se valorizan con totalCost, con unitCost o al costo promedio, en ese orden. lot
+// y lots solo aplican a productos con seguimiento por lote: lots reparte una
+// entrada entre varios lotes, como en un traspaso. serials solo aplica a productos
+// con seguimiento por serie y trae una unidad por cada una que entra o sale.
+type change struct {
+	quantity  int
+	unitCost  *money.Amount
+	totalCost *money.Amount
+	reason    entities.MovementReason
+	reference *entities.MovementReference
+	lot       *entities.LotSpec
+	lots      []entities.LotMovement
+	serials   []string
+}
+
+// ListByStore devuelve el stock con unidades de los productos de la sucursal.
+func (s *Service) ListByStore(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.Stock, error) {
+	return s.repo.ListByStore(ctx, tenantID, storeID)
+}
+
+// ensureNotArchived rechaza los ajustes sobre productos archivados o de
+// sucursales archivadas.
+func (s *Service) ensureNotArchived(ctx context.Context, tenantID, productID uuid.UUID) error {
+	archived, err := s.repo.ProductArchived(ctx, tenantID, productID)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return entities.ErrProductArchived
+	}
+	return nil
+}
+
+func defaultReason(quantity int, unitCost *money.Amount) entities.MovementReason {
+	if quantity > 0 && unitCost != nil {
+		return entities.MovementReasonReceipt
+	}
+	return entities.MovementReasonAdjustment
+}
+
+// load devuelve la fila de stock del producto o una nueva en cero si todavía no existe.
+func (s *Service) load(ctx context.Context, tenantID, productID uuid.UUID) (*entities.Stock, error) {
+	stock, err := s.repo.GetByProductID(ctx, tenantID, productID)
+	if errors.Is(err, entities.ErrStockNotFound) {
+		return &entities.Stock{TenantID: tenantID, ProductID: productID}, nil
+	}
+	return stock, err
+}
+
+// apply valoriza el cambio, actualiza cantidad, valor, lotes, unidades y bins de
+// la fila y registra el movimiento en el libro. Devuelve el movimiento, o nil si la
+// cantidad no cambió.
+func (s *Service) apply(ctx context.Context, stock *entities.Stock, c change) (*entities.Movement, error) {
+	tracking, err := s.repo.ProductTracking(ctx, stock.TenantID, stock.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	lots, err := s.applyLots(ctx, stock, tracking, c)
+	if err != nil {
+		return nil, err
+	}
+	units, err := s.applySerials(ctx, stock, tracking, c)
+	if err != nil {
 		return nil, err
 	}
 
-	if req.Quantity < stock.ReservedQuantity {
-		return nil, entities.ErrInvalidReservedAmount
+	var cost money.Amount
+	switch {
+	case c.quantity > 0:
+		cost, err = s.receive(ctx, stock, c)
+		stock.InventoryValue += cost
+	case c.quantity < 0:
+		cost, err = s.issue(ctx, stock, -c.quantity)
+		stock.InventoryValue -= cost
 	}
+	if err != nil {
+		return nil, err
+	}
+	stock.Quantity += c.quantity
 
-	stock.Quantity = req.Quantity
-	if err := s.repo.Update(ctx, stock); err != nil {
+	if stock.ID == uuid.Nil {
+		err = s.repo.Create(ctx, stock)
+	} else {
+		err = s.repo.Update(ctx, stock)
+	}
+	if err != nil || c.quantity == 0 {
 		return nil, err
 	}
 
-	return stock, nil
+	quantity := c.quantity
+	if quantity < 0 {
+		quantity = -quantity
+	}
+	movement := &entities.Movement{
+		TenantID:      stock.TenantID,
+		ProductID:     stock.ProductID,
+		Reason:        c.reason,
+		Quantity:      c.quantity,
+		UnitCost:      cost.DivInt(quantity),
+		TotalCost:     cost,
+		QuantityAfter: stock.Quantity,
+		ValueAfter:    stock.InventoryValue,
+		Reference:     c.reference,
+		Lots:          lots,
+		Serials:       c.serials,
+	}
+	if err := s.repo.CreateMovement(ctx, movement); err != nil {
+		return nil, err
+	}
+	if len(lots) > 0 {
+		if err := s.repo.CreateLotMovements(ctx, stock.TenantID, movement.ID, lots); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.recordSerialEvents(ctx, units, movement); err != nil {
+		return nil, err
+	}
+	if err := s.issueBins(ctx, movement); err != nil {
+		return nil, err
+	}
+	return movement, nil
 }
 
-func (s *Service) Adjust(ctx context.Context, req AdjustRequest) (*entities.Stock, error) {
-	stock, err := s.repo.GetByProductID(ctx, req.TenantID, req.ProductID)
+// receive valoriza una entrada y abre su capa de costo.
+func (s *Service) receive(ctx context.Context, stock *entities.Stock, c change) (money.Amount, error) {
+	unitCost := stock.AverageCost()
+	cost := unitCost.MulInt(c.quantity)
+	switch {
+	case c.totalCost != nil:
+		cost = *c.totalCost
+		unitCost = cost.DivInt(c.quantity)
+	case c.unitCost != nil:
+		unitCost = *c.unitCost
+		cost = unitCost.MulInt(c.quantity)
+	}
+
+	layer := &entities.CostLayer{
+		TenantID:          stock.TenantID,
+		ProductID:         stock.ProductID,
+		UnitCost:          unitCost,
+		Quantity:          c.quantity,
+		RemainingQuantity: c.quantity,
+	}
+	if err := s.repo.CreateCostLayer(ctx, layer); err != nil {
+		return 0, err
+	}
+
+	return cost, nil
+}
+
+// issue valoriza una salida según el método de costeo del tenant. Las capas se
+// consumen siempre en orden FIFO para que sigan reflejando las unidades en stock.
+func (s *Service) issue(ctx context.Context, stock *entities.Stock, quantity int) (money.Amount, error) {
+	method, err := s.repo.CostingMethod(ctx, stock.TenantID)
 	if err != nil {
-		if err == entities.ErrStockNotFound {
-			if req.Amount < 0 {
-				return nil, entities.ErrInsufficientStock
-			}
-			stock = &entities.Stock{
-				TenantID:         req.TenantID,
-				ProductID:        req.ProductID,
-				Quantity:         req.Amount,
-				ReservedQuantity: 0,
+		return 0, err
+	}
+
+	layers, err := s.repo.ListOpenCostLayers(ctx, stock.TenantID, stock.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	fifoCost, consumed, uncovered := entities.ConsumeLayers(layers, quantity)
+	for _, layer := range consumed {
+		if err := s.repo.UpdateCostLayer(ctx, layer); err != nil {
+			return 0, err
+		}
+	}
+
+	switch {
+	case quantity >= stock.Quantity:
+		return stock.InventoryValue, nil
+	case method == entities.CostingMethodFIFO:
+		return fifoCost + stock.AverageCost().MulInt(uncovered), nil
+	default:
+		return stock.AverageIssueCost(quantity), nil
+	}
+}
+
+// Reserve retiene stock a nombre de un dueño. Si el dueño ya tiene una reserva del
+// producto se suma a ella y vence cuando venza la más tardía de las dos.
+func (s *Service) Reserve(ctx context.Context, req ReserveRequest) (*entities.Reservation, error) {
+	if req.Quantity <= 0 {
+		return nil, entities.ErrInvalidQuantity
+	}
+	if !req.Owner.Type.IsValid() || req.Owner.ID == uuid.Nil {
+		return nil, entities.ErrInvalidReservationOwner
+	}
+
+	expiresAt := req.ExpiresAt
+	if expiresAt == nil {
+		ttl, err := s.config.Reservations.GetTTL(string(req.Owner.Type))
+		if err != nil {
+			return nil, err
+		}
+		if ttl > 0 {
+			at := time.Now().Add(ttl)
+			expiresAt = &at
+		}
+	}
+
+	reservation := &entities.Reservation{
+		TenantID:  req.TenantID,
+		ProductID: req.ProductID,
+		Owner:     req.Owner,
+		Quantity:  req.Quantity,
+		ExpiresAt: expiresAt,
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Reserve(ctx, reservation); err != nil {
+			return err
+		}
+		if err := s.reserveLots(ctx, req.TenantID, req.ProductID, req.Owner, req.Quantity); err != nil {
+			return err
+		}
+		return s.reserveSerials(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (s *Service) Release(ctx context.Context, req ReleaseRequest) error {
+	if req.Quantity <= 0 {
+		return entities.ErrInvalidQuantity
+	}
+	if !req.Owner.Type.IsValid() {
+		return entities.ErrInvalidReservationOwner
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.release(ctx, req.TenantID, req.ProductID, req.Owner, req.Quantity)
+	})
+}
+
+// HoldForCart aparta stock para un carrito. La reserva vence según el TTL de los
+// carritos y el barrido de reservas vencidas la libera si nadie compra.
+func (s *Service) HoldForCart(ctx context.Context, req CartHoldRequest) (*entities.Reservation, error) {
+	return s.Reserve(ctx, ReserveRequest{
+		TenantID:  req.TenantID,
+		ProductID: req.ProductID,
+		Owner:     entities.ReservationOwner{Type: entities.ReservationOwnerCart, ID: req.CartID},
+		Quantity:  req.Quantity,
+	})
+}
+
+// ReleaseCartHold libera todo lo que el carrito tiene apartado del producto.
+func (s *Service) ReleaseCartHold(ctx context.Context, tenantID, productID, cartID uuid.UUID) error {
+	owner := entities.ReservationOwner{Type: entities.ReservationOwnerCart, ID: cartID}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		reservations, err := s.repo.ListReservations(ctx, tenantID, productID)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range reservations {
+			if reservation.Owner == owner {
+				return s.release(ctx, tenantID, productID, owner, reservation.Quantity)
 			}
-			if err := s.repo.Create(ctx, stock); err != nil {
-				return nil, err
+		}
+		return entities.ErrReservationNotFound
+	})
+}
+
+// release libera la reserva, lo que retenía en cada lote y las unidades reservadas.
+func (s *Service) release(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error {
+	if err := s.repo.Release(ctx, tenantID, productID, owner, quantity); err != nil {
+		return err
+	}
+	if err := s.releaseLots(ctx, tenantID, productID, owner, quantity); err != nil {
+		return err
+	}
+	return s.releaseSerials(ctx, tenantID, productID, owner, quantity)
+}
+
+// Quarantine aparta unidades disponibles hasta que se inspeccionen. Siguen en
+// stock y valorizadas, pero no cuentan como disponibles.
+func (s *Service) Quarantine(ctx context.Context, req QuarantineRequest) (*entities.Stock, error) {
+	if req.Quantity <= 0 {
+		return nil, entities.ErrInvalidQuantity
+	}
+
+	var stock *entities.Stock
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if stock, err = s.repo.GetByProductID(ctx, req.TenantID, req.ProductID); err != nil {
+			if errors.Is(err, entities.ErrStockNotFound) {
+				return entities.ErrInsufficientStock
 			}
-			return stock, nil
+			return err
+		}
+		if stock.AvailableQuantity() < req.Quantity {
+			return entities.ErrInsufficientStock
 		}
+
+		stock.QuarantinedQuantity += req.Quantity
+		return s.repo.Update(ctx, stock)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	newQuantity := stock.Quantity + req.Amount
-	if newQuantity < 0 {
-		return nil, entities.ErrInsufficientStock
-	}
+	return stock, nil
+}
 
-	if newQuantity < stock.ReservedQuantity {
-		return nil, entities.ErrInvalidReservedAmount
+// ReleaseQuarantine devuelve unidades en cuarentena al stock disponible. Para
+// descartarlas se libera la cuarentena y luego se ajusta el stock.
+func (s *Service) ReleaseQuarantine(ctx context.Context, req QuarantineRequest) (*entities.Stock, error) {
+	if req.Quantity <= 0 {
+		return nil, entities.ErrInvalidQuantity
 	}
 
-	stock.Quantity = newQuantity
-	if err := s.repo.Update(ctx, stock); err != nil {
+	var stock *entities.Stock
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if stock, err = s.repo.GetByProductID(ctx, req.TenantID, req.ProductID); err != nil {
+			if errors.Is(err, entities.ErrStockNotFound) {
+				return entities.ErrReleaseExceedsQuarantine
+			}
+			return err
+		}
+		if stock.QuarantinedQuantity < req.Quantity {
+			return entities.ErrReleaseExceedsQuarantine
+		}
+
+		stock.QuarantinedQuantity -= req.Quantity
+		return s.repo.Update(ctx, stock)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return stock, nil
 }
 
-func (s *Service) Reserve(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error {
-	if quantity <= 0 {
-		return entities.ErrInvalidQuantity
+func (s *Service) ListReservations(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Reservation, error) {
+	return s.repo.ListReservations(ctx, tenantID, productID)
+}
+
+// ReleaseExpired libera las reservas vencidas y devuelve cuántas se liberaron.
+// Cada reserva se libera en su propia transacción para que un fallo no frene al resto.
+func (s *Service) ReleaseExpired(ctx context.Context, batchSize int) (int, error) {
+	expired, err := s.repo.ListExpiredReservations(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, err
 	}
 
-	return s.repo.Reserve(ctx, tenantID, productID, quantity)
+	released := 0
+	for _, reservation := range expired {
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			return s.release(ctx, reservation.TenantID, reservation.ProductID, reservation.Owner, reservation.Quantity)
+		})
+		if err != nil {
+			s.logger.Error("Error releasing expired reservation",
+				logger.Error(err),
+				logger.String("reservation_id", reservation.ID.String()),
+			)
+			continue
+		}
+
+		s.logger.Info("Expired reservation released",
+			logger.String("tenant_id", reservation.TenantID.String()),
+			logger.String("product_id", reservation.ProductID.String()),
+			logger.String("owner_type", string(reservation.Owner.Type)),
+			logger.String("owner_id", reservation.Owner.ID.String()),
+			logger.Int("quantity", reservation.Quantity),
+		)
+		released++
+	}
+
+	return released, nil
 }
 
-func (s *Service) Release(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error {
-	if quantity <= 0 {
-		return entities.ErrInvalidQuantity
+// ReconcileReservations recalcula reserved_quantity a partir de las reservas
+// vigentes y devuelve los productos que no coinciden. Con apply corrige el valor.
+func (s *Service) ReconcileReservations(ctx context.Context, tenantID *uuid.UUID, apply bool) ([]*entities.ReservationDrift, error) {
+	drifts, err := s.repo.ListReservationDrift(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, drift := range drifts {
+		s.logger.Warn("Reserved quantity drift detected",
+			logger.String("tenant_id", drift.TenantID.String()),
+			logger.String("product_id", drift.ProductID.String()),
+			logger.Int("reserved_quantity", drift.ReservedQuantity),
+			logger.Int("expected_reserved", drift.ExpectedReserved),
+		)
+
+		if !apply {
+			continue
+		}
+		if err := s.repo.SetReservedQuantity(ctx, drift.TenantID, drift.ProductID, drift.CorrectedReserved()); err != nil {
+			return nil, err
+		}
 	}
 
-	return s.repo.Release(ctx, tenantID, productID, quantity)
+	return drifts, nil
 }
@@ -0,0 +1,228 @@
+package stock_test
+
+import (
+	"context"
+	"motico-api/config"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/domain/stock/entities"
+	"motico-api/internal/domain/stock/stocktest"
+	"motico-api/internal/domain/transaction/transactiontest"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newService(repo *stocktest.Repository) *stock.Service {
+	return stock.NewService(repo, transactiontest.Manager{}, &config.Config{}, logger.NewNop())
+}
+
+func TestIssueConsumesReservedLotsBeforeExpiredOnes(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	expired := time.Now().AddDate(0, 0, -2)
+	fresh := time.Now().AddDate(1, 0, 0)
+
+	// setup deja un lote vencido y uno vigente de 5 unidades cada uno, y reserva 3
+	// para owner: la reserva toma del vigente porque los vencidos no se reservan.
+	setup := func(t *testing.T, owner entities.ReservationOwner) (*stocktest.Repository, *stock.Service, uuid.UUID) {
+		t.Helper()
+		repo := stocktest.NewRepository()
+		service := newService(repo)
+		productID := uuid.New()
+		repo.Tracking[productID] = catalogentities.TrackingLot
+
+		unitCost := money.FromInt(10)
+		for _, lot := range []entities.LotSpec{{Number: "OLD", ExpiresAt: &expired}, {Number: "NEW", ExpiresAt: &fresh}} {
+			if _, err := service.Adjust(ctx, stock.AdjustRequest{TenantID: tenantID, ProductID: productID, Amount: 5, UnitCost: &unitCost, Lot: &lot}); err != nil {
+				t.Fatalf("receive lot %s: %v", lot.Number, err)
+			}
+		}
+		if _, err := service.Reserve(ctx, stock.ReserveRequest{TenantID: tenantID, ProductID: productID, Owner: owner, Quantity: 3}); err != nil {
+			t.Fatalf("reserve: %v", err)
+		}
+		if lot, _ := repo.Lot(tenantID, productID, "NEW"); lot.ReservedQuantity != 3 {
+			t.Fatalf("reserved in NEW: got %d, want 3", lot.ReservedQuantity)
+		}
+		return repo, service, productID
+	}
+
+	checkLots := func(t *testing.T, repo *stocktest.Repository, productID uuid.UUID, owner entities.ReservationOwner) {
+		t.Helper()
+		old, _ := repo.Lot(tenantID, productID, "OLD")
+		if old.Quantity != 5 || old.ReservedQuantity != 0 {
+			t.Errorf("OLD lot: got quantity %d reserved %d, want 5 and 0", old.Quantity, old.ReservedQuantity)
+		}
+		reserved, _ := repo.Lot(tenantID, productID, "NEW")
+		if reserved.Quantity != 2 || reserved.ReservedQuantity != 0 {
+			t.Errorf("NEW lot: got quantity %d reserved %d, want 2 and 0", reserved.Quantity, reserved.ReservedQuantity)
+		}
+		if lots, _ := repo.ListLotReservations(ctx, tenantID, productID, owner); len(lots) != 0 {
+			t.Errorf("lot reservations left: %+v", lots)
+		}
+		if st := repo.Stock(tenantID, productID); st.Quantity != 7 || st.ReservedQuantity != 0 {
+			t.Errorf("stock: got quantity %d reserved %d, want 7 and 0", st.Quantity, st.ReservedQuantity)
+		}
+	}
+
+	t.Run("adjust", func(t *testing.T) {
+		owner := entities.ReservationOwner{Type: entities.ReservationOwnerSalesOrder, ID: uuid.New()}
+		repo, service, productID := setup(t, owner)
+
+		_, err := service.Adjust(ctx, stock.AdjustRequest{
+			TenantID:  tenantID,
+			ProductID: productID,
+			Amount:    -3,
+			Reason:    entities.MovementReasonSale,
+			Owner:     &owner,
+		})
+		if err != nil {
+			t.Fatalf("adjust: %v", err)
+		}
+		checkLots(t, repo, productID, owner)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		owner := entities.ReservationOwner{Type: entities.ReservationOwnerTransfer, ID: uuid.New()}
+		repo, service, productID := setup(t, owner)
+		targetID := uuid.New()
+		repo.Tracking[targetID] = catalogentities.TrackingLot
+
+		err := service.Move(ctx, stock.MoveRequest{
+			TenantID:      tenantID,
+			FromProductID: productID,
+			ToProductID:   targetID,
+			Quantity:      3,
+			Owner:         &owner,
+		})
+		if err != nil {
+			t.Fatalf("move: %v", err)
+		}
+		checkLots(t, repo, productID, owner)
+		if lot, ok := repo.Lot(tenantID, targetID, "NEW"); !ok || lot.Quantity != 3 {
+			t.Errorf("destination NEW lot: got %+v, want 3 units", lot)
+		}
+	})
+}
+
+func TestReleaseExpiredReleasesOnlyExpiredHolds(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	repo := stocktest.NewRepository()
+	cfg := &config.Config{Reservations: config.ReservationsConfig{TTL: map[string]string{"cart": "30m"}}}
+	service := stock.NewService(repo, transactiontest.Manager{}, cfg, logger.NewNop())
+
+	unitCost := money.FromInt(10)
+	if _, err := service.Adjust(ctx, stock.AdjustRequest{TenantID: tenantID, ProductID: productID, Amount: 10, UnitCost: &unitCost}); err != nil {
+		t.Fatalf("seed stock: %v", err)
+	}
+
+	abandoned := entities.ReservationOwner{Type: entities.ReservationOwnerCart, ID: uuid.New()}
+	past := time.Now().Add(-time.Minute)
+	if _, err := service.Reserve(ctx, stock.ReserveRequest{TenantID: tenantID, ProductID: productID, Owner: abandoned, Quantity: 3, ExpiresAt: &past}); err != nil {
+		t.Fatalf("reserve abandoned cart: %v", err)
+	}
+	active, err := service.HoldForCart(ctx, stock.CartHoldRequest{TenantID: tenantID, ProductID: productID, CartID: uuid.New(), Quantity: 2})
+	if err != nil {
+		t.Fatalf("hold for cart: %v", err)
+	}
+	if active.ExpiresAt == nil || time.Until(*active.ExpiresAt) < 29*time.Minute || time.Until(*active.ExpiresAt) > 30*time.Minute {
+		t.Errorf("cart hold should expire in 30m, got %v", active.ExpiresAt)
+	}
+	order := entities.ReservationOwner{Type: entities.ReservationOwnerSalesOrder, ID: uuid.New()}
+	held, err := service.Reserve(ctx, stock.ReserveRequest{TenantID: tenantID, ProductID: productID, Owner: order, Quantity: 1})
+	if err != nil {
+		t.Fatalf("reserve sales order: %v", err)
+	}
+	if held.ExpiresAt != nil {
+		t.Errorf("owner types without TTL should not expire, got %v", held.ExpiresAt)
+	}
+
+	released, err := service.ReleaseExpired(ctx, 100)
+	if err != nil {
+		t.Fatalf("release expired: %v", err)
+	}
+	if released != 1 {
+		t.Errorf("released: got %d, want 1", released)
+	}
+	if _, ok := repo.Reservation(tenantID, productID, abandoned); ok {
+		t.Error("expired cart hold should be released")
+	}
+	if st := repo.Stock(tenantID, productID); st.ReservedQuantity != 3 {
+		t.Errorf("reserved quantity: got %d, want 3", st.ReservedQuantity)
+	}
+
+	if released, err := service.ReleaseExpired(ctx, 100); err != nil || released != 0 {
+		t.Errorf("second sweep: got %d, %v; want nothing to release", released, err)
+	}
+}
+
+func TestReconcileReservations(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	repo := stocktest.NewRepository()
+	service := newService(repo)
+
+	// seed deja 10 unidades del producto con 3 reservadas por una orden de venta.
+	seed := func(tenantID uuid.UUID) uuid.UUID {
+		t.Helper()
+		productID := uuid.New()
+		unitCost := money.FromInt(10)
+		if _, err := service.Adjust(ctx, stock.AdjustRequest{TenantID: tenantID, ProductID: productID, Amount: 10, UnitCost: &unitCost}); err != nil {
+			t.Fatalf("seed stock: %v", err)
+		}
+		owner := entities.ReservationOwner{Type: entities.ReservationOwnerSalesOrder, ID: uuid.New()}
+		if _, err := service.Reserve(ctx, stock.ReserveRequest{TenantID: tenantID, ProductID: productID, Owner: owner, Quantity: 3}); err != nil {
+			t.Fatalf("reserve: %v", err)
+		}
+		return productID
+	}
+	drifted := seed(tenantID)
+	healthy := seed(tenantID)
+	elsewhere := seed(otherTenantID)
+	repo.SetReserved(tenantID, drifted, 7)
+	repo.SetReserved(otherTenantID, elsewhere, 0)
+
+	drifts, err := service.ReconcileReservations(ctx, &tenantID, false)
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	if len(drifts) != 1 || drifts[0].ProductID != drifted || drifts[0].Drift() != 4 {
+		t.Fatalf("report: got %+v, want a drift of 4 on the drifted product", drifts)
+	}
+	if st := repo.Stock(tenantID, drifted); st.ReservedQuantity != 7 {
+		t.Errorf("report only: reserved got %d, want it untouched at 7", st.ReservedQuantity)
+	}
+
+	if _, err := service.ReconcileReservations(ctx, &tenantID, true); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if st := repo.Stock(tenantID, drifted); st.ReservedQuantity != 3 {
+		t.Errorf("apply: reserved got %d, want 3", st.ReservedQuantity)
+	}
+	if st := repo.Stock(tenantID, healthy); st.ReservedQuantity != 3 {
+		t.Errorf("healthy product: reserved got %d, want 3", st.ReservedQuantity)
+	}
+	if st := repo.Stock(otherTenantID, elsewhere); st.ReservedQuantity != 0 {
+		t.Errorf("other tenant: reserved got %d, want it untouched at 0", st.ReservedQuantity)
+	}
+	if drifts, err := service.ReconcileReservations(ctx, &tenantID, false); err != nil || len(drifts) != 0 {
+		t.Errorf("after apply: got %+v, %v; want no drift", drifts, err)
+	}
+
+	drifts, err = service.ReconcileReservations(ctx, nil, true)
+	if err != nil {
+		t.Fatalf("apply to all tenants: %v", err)
+	}
+	if len(drifts) != 1 || drifts[0].TenantID != otherTenantID {
+		t.Errorf("all tenants: got %+v, want only the other tenant's drift", drifts)
+	}
+	if st := repo.Stock(otherTenantID, elsewhere); st.ReservedQuantity != 3 {
+		t.Errorf("other tenant after apply: reserved got %d, want 3", st.ReservedQuantity)
+	}
+}
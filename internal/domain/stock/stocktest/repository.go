@@ -0,0 +1,641 @@
+// Package stocktest ofrece un stock.Repository en memoria para probar los
+// servicios que mueven stock sin base de datos.
+package stocktest
+
+import (
+	"context"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/domain/stock/entities"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type productKey struct {
+	tenantID  uuid.UUID
+	productID uuid.UUID
+}
+
+type ownerKey struct {
+	productKey
+	owner entities.ReservationOwner
+}
+
+type lotOwnerKey struct {
+	lotID uuid.UUID
+	owner entities.ReservationOwner
+}
+
+type binKey struct {
+	productID  uuid.UUID
+	locationID uuid.UUID
+}
+
+// Repository guarda el stock en memoria. Como la base, devuelve copias: los
+// cambios de una fila solo quedan al guardarla. Tracking, Stores, Parents,
+// Archived y Bins describen los productos y las ubicaciones, que en la base viven
+// en otras tablas.
+type Repository struct {
+	Tracking map[uuid.UUID]catalogentities.Tracking
+	Stores   map[uuid.UUID]uuid.UUID
+	Parents  map[uuid.UUID]uuid.UUID
+	Archived map[uuid.UUID]bool
+	Bins     map[uuid.UUID]*entities.Bin
+	Costing  entities.CostingMethod
+
+	mu              sync.Mutex
+	stocks          map[productKey]*entities.Stock
+	reservations    map[ownerKey]*entities.Reservation
+	layers          []*entities.CostLayer
+	movements       []*entities.Movement
+	lots            map[uuid.UUID]*entities.Lot
+	lotReservations map[lotOwnerKey]*entities.LotReservation
+	units           map[string]*entities.SerialUnit
+	events          []*entities.SerialEvent
+	binStock        map[binKey]*entities.BinStock
+	binMovements    []*entities.BinMovement
+}
+
+var _ stock.Repository = (*Repository)(nil)
+
+func NewRepository() *Repository {
+	return &Repository{
+		Tracking:        make(map[uuid.UUID]catalogentities.Tracking),
+		Stores:          make(map[uuid.UUID]uuid.UUID),
+		Parents:         make(map[uuid.UUID]uuid.UUID),
+		Archived:        make(map[uuid.UUID]bool),
+		Bins:            make(map[uuid.UUID]*entities.Bin),
+		Costing:         entities.CostingMethodAverage,
+		stocks:          make(map[productKey]*entities.Stock),
+		reservations:    make(map[ownerKey]*entities.Reservation),
+		lots:            make(map[uuid.UUID]*entities.Lot),
+		lotReservations: make(map[lotOwnerKey]*entities.LotReservation),
+		units:           make(map[string]*entities.SerialUnit),
+		binStock:        make(map[binKey]*entities.BinStock),
+	}
+}
+
+// Stock devuelve la fila de stock del producto, o una en cero si no existe.
+func (r *Repository) Stock(tenantID, productID uuid.UUID) entities.Stock {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.stocks[productKey{tenantID, productID}]; ok {
+		return *s
+	}
+	return entities.Stock{TenantID: tenantID, ProductID: productID}
+}
+
+// Movements devuelve los movimientos del producto en el orden en que se registraron.
+func (r *Repository) Movements(tenantID, productID uuid.UUID) []entities.Movement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var movements []entities.Movement
+	for _, m := range r.movements {
+		if m.TenantID == tenantID && m.ProductID == productID {
+			movements = append(movements, *m)
+		}
+	}
+	return movements
+}
+
+// Reservation devuelve la reserva del dueño sobre el producto.
+func (r *Repository) Reservation(tenantID, productID uuid.UUID, owner entities.ReservationOwner) (entities.Reservation, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if reservation, ok := r.reservations[ownerKey{productKey{tenantID, productID}, owner}]; ok {
+		return *reservation, true
+	}
+	return entities.Reservation{}, false
+}
+
+// Lot devuelve el lote del producto por su número.
+func (r *Repository) Lot(tenantID, productID uuid.UUID, lotNumber string) (entities.Lot, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if lot := r.findLot(tenantID, productID, lotNumber); lot != nil {
+		return *lot, true
+	}
+	return entities.Lot{}, false
+}
+
+// SetReserved pisa reserved_quantity sin tocar las reservas, como lo dejaría un
+// error que desincroniza el contador.
+func (r *Repository) SetReserved(tenantID, productID uuid.UUID, quantity int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.stocks[productKey{tenantID, productID}]; ok {
+		s.ReservedQuantity = quantity
+	}
+}
+
+func (r *Repository) GetByProductID(ctx context.Context, tenantID, productID uuid.UUID) (*entities.Stock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.stocks[productKey{tenantID, productID}]
+	if !ok {
+		return nil, entities.ErrStockNotFound
+	}
+	found := *s
+	return &found, nil
+}
+
+func (r *Repository) SumByParentProduct(ctx context.Context, tenantID, parentID uuid.UUID) (*entities.Stock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sum := entities.Stock{TenantID: tenantID, ProductID: parentID}
+	for key, s := range r.stocks {
+		if key.tenantID == tenantID && r.Parents[key.productID] == parentID {
+			sum.Quantity += s.Quantity
+			sum.ReservedQuantity += s.ReservedQuantity
+			sum.QuarantinedQuantity += s.QuarantinedQuantity
+			sum.InventoryValue += s.InventoryValue
+		}
+	}
+	return &sum, nil
+}
+
+func (r *Repository) Create(ctx context.Context, s *entities.Stock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s.ID = uuid.New()
+	s.CreatedAt = time.Now()
+	s.UpdatedAt = s.CreatedAt
+	created := *s
+	r.stocks[productKey{s.TenantID, s.ProductID}] = &created
+	return nil
+}
+
+func (r *Repository) Update(ctx context.Context, s *entities.Stock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.stocks[productKey{s.TenantID, s.ProductID}]
+	if !ok {
+		return entities.ErrStockNotFound
+	}
+	s.UpdatedAt = time.Now()
+	stored.Quantity = s.Quantity
+	stored.QuarantinedQuantity = s.QuarantinedQuantity
+	stored.InventoryValue = s.InventoryValue
+	stored.UpdatedAt = s.UpdatedAt
+	s.ReservedQuantity = stored.ReservedQuantity
+	return nil
+}
+
+func (r *Repository) Reserve(ctx context.Context, reservation *entities.Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := productKey{reservation.TenantID, reservation.ProductID}
+	s, ok := r.stocks[key]
+	if !ok || s.Quantity-s.HeldQuantity() < reservation.Quantity {
+		return entities.ErrInsufficientStock
+	}
+	s.ReservedQuantity += reservation.Quantity
+
+	now := time.Now()
+	existing, ok := r.reservations[ownerKey{key, reservation.Owner}]
+	if !ok {
+		created := *reservation
+		created.ID = uuid.New()
+		created.CreatedAt = now
+		created.UpdatedAt = now
+		r.reservations[ownerKey{key, reservation.Owner}] = &created
+		*reservation = created
+		return nil
+	}
+	existing.Quantity += reservation.Quantity
+	if existing.ExpiresAt == nil || (reservation.ExpiresAt != nil && reservation.ExpiresAt.After(*existing.ExpiresAt)) {
+		existing.ExpiresAt = reservation.ExpiresAt
+	}
+	existing.UpdatedAt = now
+	*reservation = *existing
+	return nil
+}
+
+func (r *Repository) Release(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := productKey{tenantID, productID}
+	reservation, ok := r.reservations[ownerKey{key, owner}]
+	if !ok {
+		return entities.ErrReservationNotFound
+	}
+	if quantity > reservation.Quantity {
+		return entities.ErrReleaseExceedsReservation
+	}
+	s, ok := r.stocks[key]
+	if !ok {
+		return entities.ErrStockNotFound
+	}
+
+	reservation.Quantity -= quantity
+	if reservation.Quantity == 0 {
+		delete(r.reservations, ownerKey{key, owner})
+	}
+	s.ReservedQuantity = max(0, s.ReservedQuantity-quantity)
+	return nil
+}
+
+func (r *Repository) ListReservations(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Reservation, error) {
+	return r.listReservations(func(reservation *entities.Reservation) bool {
+		return reservation.TenantID == tenantID && reservation.ProductID == productID
+	}), nil
+}
+
+func (r *Repository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]*entities.Reservation, error) {
+	expired := r.listReservations(func(reservation *entities.Reservation) bool {
+		return reservation.IsExpired(now)
+	})
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].ExpiresAt.Before(*expired[j].ExpiresAt)
+	})
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return expired, nil
+}
+
+func (r *Repository) listReservations(match func(*entities.Reservation) bool) []*entities.Reservation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var reservations []*entities.Reservation
+	for _, reservation := range r.reservations {
+		if match(reservation) {
+			found := *reservation
+			reservations = append(reservations, &found)
+		}
+	}
+	sort.SliceStable(reservations, func(i, j int) bool {
+		return reservations[i].CreatedAt.Before(reservations[j].CreatedAt)
+	})
+	return reservations
+}
+
+func (r *Repository) ListReservationDrift(ctx context.Context, tenantID *uuid.UUID) ([]*entities.ReservationDrift, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	expected := make(map[productKey]int)
+	for key, reservation := range r.reservations {
+		expected[key.productKey] += reservation.Quantity
+	}
+
+	var drifts []*entities.ReservationDrift
+	for key, s := range r.stocks {
+		if tenantID != nil && key.tenantID != *tenantID {
+			continue
+		}
+		if s.ReservedQuantity != expected[key] {
+			drifts = append(drifts, &entities.ReservationDrift{
+				TenantID:         key.tenantID,
+				ProductID:        key.productID,
+				Quantity:         s.Quantity,
+				ReservedQuantity: s.ReservedQuantity,
+				ExpectedReserved: expected[key],
+			})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].ProductID.String() < drifts[j].ProductID.String()
+	})
+	return drifts, nil
+}
+
+func (r *Repository) SetReservedQuantity(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.stocks[productKey{tenantID, productID}]
+	if !ok {
+		return entities.ErrStockNotFound
+	}
+	s.ReservedQuantity = quantity
+	return nil
+}
+
+func (r *Repository) CostingMethod(ctx context.Context, tenantID uuid.UUID) (entities.CostingMethod, error) {
+	return r.Costing, nil
+}
+
+func (r *Repository) ListOpenCostLayers(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.CostLayer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var layers []*entities.CostLayer
+	for _, layer := range r.layers {
+		if layer.TenantID == tenantID && layer.ProductID == productID && layer.RemainingQuantity > 0 {
+			found := *layer
+			layers = append(layers, &found)
+		}
+	}
+	return layers, nil
+}
+
+func (r *Repository) CreateCostLayer(ctx context.Context, layer *entities.CostLayer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	layer.ID = uuid.New()
+	layer.ReceivedAt = time.Now()
+	created := *layer
+	r.layers = append(r.layers, &created)
+	return nil
+}
+
+func (r *Repository) UpdateCostLayer(ctx context.Context, layer *entities.CostLayer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.layers {
+		if stored.ID == layer.ID {
+			stored.RemainingQuantity = layer.RemainingQuantity
+		}
+	}
+	return nil
+}
+
+func (r *Repository) CreateMovement(ctx context.Context, movement *entities.Movement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	movement.ID = uuid.New()
+	movement.CreatedAt = time.Now()
+	created := *movement
+	r.movements = append(r.movements, &created)
+	return nil
+}
+
+func (r *Repository) ListMovementsByReference(ctx context.Context, tenantID uuid.UUID, reference entities.MovementReference) ([]*entities.Movement, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var movements []*entities.Movement
+	for _, m := range r.movements {
+		if m.TenantID == tenantID && m.Reference != nil && *m.Reference == reference {
+			found := *m
+			movements = append(movements, &found)
+		}
+	}
+	return movements, nil
+}
+
+func (r *Repository) ProductTracking(ctx context.Context, tenantID, productID uuid.UUID) (catalogentities.Tracking, error) {
+	if tracking, ok := r.Tracking[productID]; ok {
+		return tracking, nil
+	}
+	return catalogentities.TrackingNone, nil
+}
+
+func (r *Repository) ListLots(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Lot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var lots []*entities.Lot
+	for _, lot := range r.lots {
+		if lot.TenantID == tenantID && lot.ProductID == productID && lot.Quantity > 0 {
+			found := *lot
+			lots = append(lots, &found)
+		}
+	}
+	entities.SortFEFO(lots)
+	return lots, nil
+}
+
+func (r *Repository) GetLot(ctx context.Context, tenantID, productID uuid.UUID, lotNumber string) (*entities.Lot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lot := r.findLot(tenantID, productID, lotNumber)
+	if lot == nil {
+		return nil, entities.ErrLotNotFound
+	}
+	found := *lot
+	return &found, nil
+}
+
+func (r *Repository) findLot(tenantID, productID uuid.UUID, lotNumber string) *entities.Lot {
+	for _, lot := range r.lots {
+		if lot.TenantID == tenantID && lot.ProductID == productID && lot.LotNumber == lotNumber {
+			return lot
+		}
+	}
+	return nil
+}
+
+func (r *Repository) CreateLot(ctx context.Context, lot *entities.Lot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lot.ID = uuid.New()
+	if lot.ReceivedAt.IsZero() {
+		lot.ReceivedAt = time.Now()
+	}
+	lot.UpdatedAt = time.Now()
+	created := *lot
+	r.lots[lot.ID] = &created
+	return nil
+}
+
+func (r *Repository) UpdateLot(ctx context.Context, lot *entities.Lot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.lots[lot.ID]
+	if !ok {
+		return entities.ErrLotNotFound
+	}
+	stored.ExpiresAt = lot.ExpiresAt
+	stored.Quantity = lot.Quantity
+	stored.ReservedQuantity = lot.ReservedQuantity
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Repository) CreateLotMovements(ctx context.Context, tenantID, movementID uuid.UUID, lots []entities.LotMovement) error {
+	return nil
+}
+
+func (r *Repository) ListLotReservations(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner) ([]*entities.LotReservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var held []*entities.Lot
+	for key := range r.lotReservations {
+		lot := r.lots[key.lotID]
+		if key.owner == owner && lot.TenantID == tenantID && lot.ProductID == productID {
+			held = append(held, lot)
+		}
+	}
+	// Del lote que vence último al que vence primero, al revés de FEFO.
+	entities.SortFEFO(held)
+	reservations := make([]*entities.LotReservation, 0, len(held))
+	for i := len(held) - 1; i >= 0; i-- {
+		found := *r.lotReservations[lotOwnerKey{held[i].ID, owner}]
+		found.ExpiresAt = held[i].ExpiresAt
+		reservations = append(reservations, &found)
+	}
+	return reservations, nil
+}
+
+func (r *Repository) SaveLotReservation(ctx context.Context, reservation *entities.LotReservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := lotOwnerKey{reservation.LotID, reservation.Owner}
+	if reservation.Quantity <= 0 {
+		delete(r.lotReservations, key)
+		return nil
+	}
+	saved := *reservation
+	r.lotReservations[key] = &saved
+	return nil
+}
+
+func (r *Repository) GetSerialUnit(ctx context.Context, tenantID uuid.UUID, serialNumber string) (*entities.SerialUnit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	unit, ok := r.units[serialNumber]
+	if !ok || unit.TenantID != tenantID {
+		return nil, entities.ErrSerialNotFound
+	}
+	found := *unit
+	return &found, nil
+}
+
+func (r *Repository) ListSerialUnits(ctx context.Context, tenantID, productID uuid.UUID, status *entities.SerialStatus) ([]*entities.SerialUnit, error) {
+	return r.listSerialUnits(func(unit *entities.SerialUnit) bool {
+		return unit.TenantID == tenantID && unit.ProductID == productID && (status == nil || unit.Status == *status)
+	}), nil
+}
+
+func (r *Repository) ListOwnerSerialUnits(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, status entities.SerialStatus) ([]*entities.SerialUnit, error) {
+	return r.listSerialUnits(func(unit *entities.SerialUnit) bool {
+		return unit.TenantID == tenantID && unit.ProductID == productID && unit.Status == status &&
+			unit.Owner != nil && *unit.Owner == owner
+	}), nil
+}
+
+func (r *Repository) listSerialUnits(match func(*entities.SerialUnit) bool) []*entities.SerialUnit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var units []*entities.SerialUnit
+	for _, unit := range r.units {
+		if match(unit) {
+			found := *unit
+			units = append(units, &found)
+		}
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].SerialNumber < units[j].SerialNumber
+	})
+	return units
+}
+
+func (r *Repository) CreateSerialUnit(ctx context.Context, unit *entities.SerialUnit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	unit.ID = uuid.New()
+	unit.StoreID = r.Stores[unit.ProductID]
+	unit.CreatedAt = time.Now()
+	unit.UpdatedAt = unit.CreatedAt
+	created := *unit
+	r.units[unit.SerialNumber] = &created
+	return nil
+}
+
+func (r *Repository) UpdateSerialUnit(ctx context.Context, unit *entities.SerialUnit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.units[unit.SerialNumber]; !ok {
+		return entities.ErrSerialNotFound
+	}
+	unit.StoreID = r.Stores[unit.ProductID]
+	unit.UpdatedAt = time.Now()
+	updated := *unit
+	r.units[unit.SerialNumber] = &updated
+	return nil
+}
+
+func (r *Repository) CreateSerialEvent(ctx context.Context, event *entities.SerialEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	event.ID = uuid.New()
+	event.CreatedAt = time.Now()
+	created := *event
+	r.events = append(r.events, &created)
+	return nil
+}
+
+func (r *Repository) ListSerialEvents(ctx context.Context, tenantID, unitID uuid.UUID) ([]*entities.SerialEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var events []*entities.SerialEvent
+	for _, event := range r.events {
+		if event.TenantID == tenantID && event.UnitID == unitID {
+			found := *event
+			events = append(events, &found)
+		}
+	}
+	return events, nil
+}
+
+func (r *Repository) GetBin(ctx context.Context, tenantID, locationID uuid.UUID) (*entities.Bin, error) {
+	bin, ok := r.Bins[locationID]
+	if !ok {
+		return nil, entities.ErrBinNotFound
+	}
+	found := *bin
+	return &found, nil
+}
+
+func (r *Repository) ProductStoreID(ctx context.Context, tenantID, productID uuid.UUID) (uuid.UUID, error) {
+	return r.Stores[productID], nil
+}
+
+func (r *Repository) ProductArchived(ctx context.Context, tenantID, productID uuid.UUID) (bool, error) {
+	return r.Archived[productID] || r.Archived[r.Stores[productID]], nil
+}
+
+func (r *Repository) ListByStore(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.Stock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var stocks []*entities.Stock
+	for key, s := range r.stocks {
+		if key.tenantID == tenantID && r.Stores[key.productID] == storeID && s.Quantity > 0 {
+			found := *s
+			stocks = append(stocks, &found)
+		}
+	}
+	sort.Slice(stocks, func(i, j int) bool {
+		return stocks[i].ProductID.String() < stocks[j].ProductID.String()
+	})
+	return stocks, nil
+}
+
+func (r *Repository) ListBinStock(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.BinStock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bins := []*entities.BinStock{}
+	for key, bin := range r.binStock {
+		if key.productID == productID && bin.TenantID == tenantID && bin.Quantity > 0 {
+			found := *bin
+			bins = append(bins, &found)
+		}
+	}
+	entities.SortPickOrder(bins)
+	return bins, nil
+}
+
+func (r *Repository) SaveBinStock(ctx context.Context, bin *entities.BinStock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := binKey{bin.ProductID, bin.LocationID}
+	if stored, ok := r.binStock[key]; ok {
+		bin.ID = stored.ID
+	} else {
+		bin.ID = uuid.New()
+	}
+	bin.UpdatedAt = time.Now()
+	saved := *bin
+	r.binStock[key] = &saved
+	return nil
+}
+
+func (r *Repository) CreateBinMovement(ctx context.Context, movement *entities.BinMovement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	movement.ID = uuid.New()
+	movement.CreatedAt = time.Now()
+	created := *movement
+	r.binMovements = append(r.binMovements, &created)
+	return nil
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrReturnNotFound         = apperror.New(apperror.KindNotFound, "return_not_found", "return not found")
+	ErrInvalidReturnType      = apperror.New(apperror.KindInvalid, "invalid_return_type", "invalid return type")
+	ErrInvalidReturnReason    = apperror.New(apperror.KindInvalid, "invalid_return_reason", "invalid return reason")
+	ErrReturnHasNoLines       = apperror.New(apperror.KindInvalid, "return_has_no_lines", "return must have at least one line")
+	ErrDuplicateProduct       = apperror.New(apperror.KindInvalid, "duplicate_product", "a product can only appear once per return")
+	ErrInvalidQuantity        = apperror.New(apperror.KindInvalid, "invalid_quantity", "quantity must be greater than zero")
+	ErrInvalidUnitCost        = apperror.New(apperror.KindInvalid, "invalid_unit_cost", "unit cost must be greater than or equal to zero")
+	ErrInvalidStore           = apperror.New(apperror.KindUnprocessable, "invalid_store", "store not found")
+	ErrProductNotInStore      = apperror.New(apperror.KindUnprocessable, "product_not_in_store", "product is not listed in the return store")
+	ErrSupplierRequired       = apperror.New(apperror.KindInvalid, "supplier_required", "supplier returns require a supplier")
+	ErrInvalidSupplier        = apperror.New(apperror.KindUnprocessable, "invalid_supplier", "supplier not found")
+	ErrInvalidSalesOrder      = apperror.New(apperror.KindUnprocessable, "invalid_sales_order", "sales order not found or not fulfilled in this store")
+	ErrProductNotInSalesOrder = apperror.New(apperror.KindUnprocessable, "product_not_in_sales_order", "returned quantity exceeds what the sales order sold")
+	ErrReturnNotQuarantined   = apperror.New(apperror.KindConflict, "return_not_quarantined", "return has no units in quarantine")
+	ErrReturnLineNotFound     = apperror.New(apperror.KindInvalid, "return_line_not_found", "product is not part of this return")
+	ErrInvalidDecision        = apperror.New(apperror.KindInvalid, "invalid_decision", "decision is not allowed for this return type")
+	ErrDecisionExceedsPending = apperror.New(apperror.KindInvalid, "decision_exceeds_pending", "decision quantity exceeds the units pending inspection")
+	ErrInsufficientStock      = apperror.New(apperror.KindConflict, "insufficient_stock", "insufficient available stock for the return")
+	ErrLotRequired            = apperror.New(apperror.KindInvalid, "lot_required", "lot number is required to return a lot-tracked product")
+	ErrLotNotFound            = apperror.New(apperror.KindUnprocessable, "lot_not_found", "lot not found for the returned product")
+	ErrLotExpiryMismatch      = apperror.New(apperror.KindConflict, "lot_expiry_mismatch", "lot already exists with a different expiry date")
+	ErrSerialsRequired        = apperror.New(apperror.KindInvalid, "serials_required", "serial-tracked products require one serial per unit returned")
+	ErrSerialsNotAllowed      = apperror.New(apperror.KindInvalid, "serials_not_allowed", "serials only apply to serial-tracked products")
+	ErrDuplicateSerial        = apperror.New(apperror.KindInvalid, "duplicate_serial", "a serial number can only appear once per return")
+	ErrSerialNotInSalesOrder  = apperror.New(apperror.KindUnprocessable, "serial_not_in_sales_order", "serial was not sold in the sales order")
+	ErrSerialNotAvailable     = apperror.New(apperror.KindConflict, "serial_not_available", "serial is not in stock at the return store")
+	ErrSerialAlreadyInStock   = apperror.New(apperror.KindConflict, "serial_already_in_stock", "serial is already in stock")
+	ErrProductArchived        = apperror.New(apperror.KindConflict, "product_archived", "product or its store is archived")
+)
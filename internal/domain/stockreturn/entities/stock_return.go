@@ -0,0 +1,191 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReturnType string
+
+const (
+	// ReturnTypeCustomer reingresa al stock unidades devueltas por un cliente.
+	ReturnTypeCustomer ReturnType = "customer_return"
+	// ReturnTypeSupplier saca del stock unidades que vuelven al proveedor.
+	ReturnTypeSupplier ReturnType = "supplier_return"
+	// ReturnTypeWriteOff da de baja unidades dañadas, vencidas o perdidas.
+	ReturnTypeWriteOff ReturnType = "write_off"
+)
+
+func (t ReturnType) IsValid() bool {
+	switch t {
+	case ReturnTypeCustomer, ReturnTypeSupplier, ReturnTypeWriteOff:
+		return true
+	}
+	return false
+}
+
+// DefaultDecision es lo que se hace con las unidades cuando la devolución no pasa
+// por cuarentena.
+func (t ReturnType) DefaultDecision() Decision {
+	switch t {
+	case ReturnTypeSupplier:
+		return DecisionShipToSupplier
+	case ReturnTypeWriteOff:
+		return DecisionScrap
+	}
+	return DecisionRestock
+}
+
+type ReturnReason string
+
+const (
+	ReturnReasonDamaged   ReturnReason = "damaged"
+	ReturnReasonDefective ReturnReason = "defective"
+	ReturnReasonWrongItem ReturnReason = "wrong_item"
+	ReturnReasonUnwanted  ReturnReason = "unwanted"
+	ReturnReasonExpired   ReturnReason = "expired"
+	ReturnReasonLost      ReturnReason = "lost"
+	ReturnReasonOther     ReturnReason = "other"
+)
+
+func (r ReturnReason) IsValid() bool {
+	switch r {
+	case ReturnReasonDamaged, ReturnReasonDefective, ReturnReasonWrongItem, ReturnReasonUnwanted,
+		ReturnReasonExpired, ReturnReasonLost, ReturnReasonOther:
+		return true
+	}
+	return false
+}
+
+// Decision es el destino de unidades devueltas al resolverse la devolución.
+type Decision string
+
+const (
+	DecisionRestock        Decision = "restock"
+	DecisionScrap          Decision = "scrap"
+	DecisionShipToSupplier Decision = "ship_to_supplier"
+)
+
+// AllowedFor indica si la decisión aplica al tipo de devolución: solo lo que
+// vuelve al proveedor se le puede enviar.
+func (d Decision) AllowedFor(t ReturnType) bool {
+	switch d {
+	case DecisionRestock, DecisionScrap:
+		return true
+	case DecisionShipToSupplier:
+		return t == ReturnTypeSupplier
+	}
+	return false
+}
+
+type ReturnStatus string
+
+const (
+	ReturnStatusQuarantined ReturnStatus = "quarantined"
+	ReturnStatusCompleted   ReturnStatus = "completed"
+)
+
+func (s ReturnStatus) IsValid() bool {
+	return s == ReturnStatusQuarantined || s == ReturnStatusCompleted
+}
+
+type StockReturn struct {
+	ID           uuid.UUID         `json:"id"`
+	TenantID     uuid.UUID         `json:"tenant_id"`
+	StoreID      uuid.UUID         `json:"store_id"`
+	Type         ReturnType        `json:"type"`
+	Reason       ReturnReason      `json:"reason"`
+	Status       ReturnStatus      `json:"status"`
+	SalesOrderID *uuid.UUID        `json:"sales_order_id,omitempty"`
+	SupplierID   *uuid.UUID        `json:"supplier_id,omitempty"`
+	Notes        *string           `json:"notes,omitempty"`
+	Lines        []StockReturnLine `json:"lines"`
+	CreatedBy    *string           `json:"created_by,omitempty"`
+	CompletedAt  *time.Time        `json:"completed_at,omitempty"`
+	CompletedBy  *string           `json:"completed_by,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+type StockReturnLine struct {
+	ID           uuid.UUID     `json:"id"`
+	ReturnID     uuid.UUID     `json:"return_id"`
+	ProductID    uuid.UUID     `json:"product_id"`
+	Quantity     int           `json:"quantity"`
+	UnitCost     *money.Amount `json:"unit_cost,omitempty"`
+	LotNumber    *string       `json:"lot_number,omitempty"`
+	LotExpiresAt *time.Time    `json:"lot_expires_at,omitempty"`
+	// Serials son las unidades devueltas de un producto con seguimiento por serie.
+	// Las decisiones las toman en orden.
+	Serials           []string `json:"serials,omitempty"`
+	RestockedQuantity int      `json:"restocked_quantity"`
+	ScrappedQuantity  int      `json:"scrapped_quantity"`
+	ShippedQuantity   int      `json:"shipped_quantity"`
+}
+
+// PendingQuantity son las unidades de la línea que siguen en cuarentena sin decisión.
+func (l *StockReturnLine) PendingQuantity() int {
+	return l.Quantity - l.RestockedQuantity - l.ScrappedQuantity - l.ShippedQuantity
+}
+
+// NextSerials son las series de las próximas quantity unidades pendientes. Las
+// decisiones consumen las series en el orden en que se devolvieron.
+func (l *StockReturnLine) NextSerials(quantity int) []string {
+	if len(l.Serials) == 0 {
+		return nil
+	}
+	start := l.Quantity - l.PendingQuantity()
+	end := start + quantity
+	if end > len(l.Serials) {
+		end = len(l.Serials)
+	}
+	return l.Serials[start:end]
+}
+
+// Resolve registra la decisión sobre quantity unidades pendientes de la línea.
+func (l *StockReturnLine) Resolve(decision Decision, quantity int) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+	if quantity > l.PendingQuantity() {
+		return ErrDecisionExceedsPending
+	}
+
+	switch decision {
+	case DecisionRestock:
+		l.RestockedQuantity += quantity
+	case DecisionScrap:
+		l.ScrappedQuantity += quantity
+	case DecisionShipToSupplier:
+		l.ShippedQuantity += quantity
+	default:
+		return ErrInvalidDecision
+	}
+	return nil
+}
+
+func (r *StockReturn) Line(productID uuid.UUID) *StockReturnLine {
+	for i := range r.Lines {
+		if r.Lines[i].ProductID == productID {
+			return &r.Lines[i]
+		}
+	}
+	return nil
+}
+
+func (r *StockReturn) IsResolved() bool {
+	for i := range r.Lines {
+		if r.Lines[i].PendingQuantity() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Complete cierra la devolución cuando ya no quedan unidades en cuarentena.
+func (r *StockReturn) Complete(actor string, at time.Time) {
+	r.Status = ReturnStatusCompleted
+	r.CompletedAt, r.CompletedBy = &at, &actor
+}
@@ -0,0 +1,60 @@
+package entities
+
+import "testing"
+
+func TestStockReturnLineResolve(t *testing.T) {
+	line := &StockReturnLine{Quantity: 5}
+
+	if err := line.Resolve(DecisionRestock, 2); err != nil {
+		t.Fatalf("restock: %v", err)
+	}
+	if err := line.Resolve(DecisionScrap, 2); err != nil {
+		t.Fatalf("scrap: %v", err)
+	}
+	if got := line.PendingQuantity(); got != 1 {
+		t.Fatalf("pending: got %d, want 1", got)
+	}
+	if err := line.Resolve(DecisionScrap, 2); err != ErrDecisionExceedsPending {
+		t.Fatalf("expected ErrDecisionExceedsPending, got %v", err)
+	}
+	if err := line.Resolve(DecisionRestock, 0); err != ErrInvalidQuantity {
+		t.Fatalf("expected ErrInvalidQuantity, got %v", err)
+	}
+}
+
+func TestDecisionAllowedFor(t *testing.T) {
+	tests := []struct {
+		decision   Decision
+		returnType ReturnType
+		want       bool
+	}{
+		{DecisionRestock, ReturnTypeCustomer, true},
+		{DecisionScrap, ReturnTypeWriteOff, true},
+		{DecisionShipToSupplier, ReturnTypeSupplier, true},
+		{DecisionShipToSupplier, ReturnTypeCustomer, false},
+		{Decision("burn"), ReturnTypeWriteOff, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.decision.AllowedFor(tt.returnType); got != tt.want {
+			t.Errorf("%s for %s: got %v, want %v", tt.decision, tt.returnType, got, tt.want)
+		}
+	}
+}
+
+func TestStockReturnLineNextSerials(t *testing.T) {
+	line := &StockReturnLine{Quantity: 3, Serials: []string{"A", "B", "C"}}
+
+	if got := line.NextSerials(2); len(got) != 2 || got[0] != "A" || got[1] != "B" {
+		t.Fatalf("first decision: got %v", got)
+	}
+	if err := line.Resolve(DecisionScrap, 2); err != nil {
+		t.Fatalf("scrap: %v", err)
+	}
+	if got := line.NextSerials(1); len(got) != 1 || got[0] != "C" {
+		t.Fatalf("second decision: got %v", got)
+	}
+	if got := (&StockReturnLine{Quantity: 2}).NextSerials(1); got != nil {
+		t.Fatalf("untracked line: got %v", got)
+	}
+}
@@ -0,0 +1,26 @@
+package stockreturn
+
+import (
+	"context"
+	"motico-api/internal/domain/stockreturn/entities"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+)
+
+// ListFilter acota el listado de devoluciones.
+type ListFilter struct {
+	Type    *entities.ReturnType
+	Reason  *entities.ReturnReason
+	Status  *entities.ReturnStatus
+	StoreID *uuid.UUID
+	Query   query.Params
+}
+
+type Repository interface {
+	Create(ctx context.Context, ret *entities.StockReturn) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.StockReturn, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.StockReturn, error)
+	// Update persiste el estado y las cantidades resueltas de cada línea.
+	Update(ctx context.Context, ret *entities.StockReturn) error
+}
@@ -0,0 +1,463 @@
+package stockreturn
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	"motico-api/internal/domain/salesorder"
+	salesorderentities "motico-api/internal/domain/salesorder/entities"
+	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	"motico-api/internal/domain/stockreturn/entities"
+	storedomain "motico-api/internal/domain/store"
+	"motico-api/internal/domain/supplier"
+	"motico-api/internal/domain/transaction"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// movementReferenceType identifica a las devoluciones en el libro de movimientos de stock.
+const movementReferenceType = "return"
+
+type Service struct {
+	repo           Repository
+	stockService   *stock.Service
+	storeRepo      storedomain.Repository
+	productRepo    productdomain.Repository
+	supplierRepo   supplier.Repository
+	salesOrderRepo salesorder.Repository
+	txManager      transaction.Manager
+	config         *config.Config
+	logger         logger.Logger
+}
+
+func NewService(repo Repository, stockService *stock.Service, storeRepo storedomain.Repository, productRepo productdomain.Repository, supplierRepo supplier.Repository, salesOrderRepo salesorder.Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:           repo,
+		stockService:   stockService,
+		storeRepo:      storeRepo,
+		productRepo:    productRepo,
+		supplierRepo:   supplierRepo,
+		salesOrderRepo: salesOrderRepo,
+		txManager:      txManager,
+		config:         cfg,
+		logger:         log,
+	}
+}
+
+// LineRequest es un producto devuelto. UnitCost solo se usa en devoluciones de
+// clientes: es el costo al que las unidades reingresan al stock. Lot indica el
+// lote devuelto en productos con seguimiento por lote: el lote al que reingresan
+// las unidades o del que salen. Serials es obligatorio, una por unidad, en
+// productos con seguimiento por serie.
+type LineRequest struct {
+	ProductID uuid.UUID
+	Quantity  int
+	UnitCost  *money.Amount
+	Lot       *stockentities.LotSpec
+	Serials   []string
+}
+
+type CreateRequest struct {
+	TenantID     uuid.UUID
+	StoreID      uuid.UUID
+	Type         entities.ReturnType
+	Reason       entities.ReturnReason
+	Quarantine   bool
+	SalesOrderID *uuid.UUID
+	SupplierID   *uuid.UUID
+	Lines        []LineRequest
+	Notes        *string
+	CreatedBy    string
+}
+
+type DecisionRequest struct {
+	ProductID uuid.UUID
+	Decision  entities.Decision
+	Quantity  int
+}
+
+type ResolveRequest struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Actor     string
+	Decisions []DecisionRequest
+}
+
+// Create registra la devolución y mueve el stock. Las devoluciones de clientes
+// reingresan las unidades; con cuarentena, las unidades quedan retenidas hasta
+// resolverse y, sin ella, se aplica de inmediato la decisión por defecto del tipo.
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.StockReturn, error) {
+	if err := s.validateCreateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	ret := &entities.StockReturn{
+		TenantID:     req.TenantID,
+		StoreID:      req.StoreID,
+		Type:         req.Type,
+		Reason:       req.Reason,
+		Status:       entities.ReturnStatusQuarantined,
+		SalesOrderID: req.SalesOrderID,
+		SupplierID:   req.SupplierID,
+		Notes:        req.Notes,
+	}
+	if req.CreatedBy != "" {
+		ret.CreatedBy = &req.CreatedBy
+	}
+	for _, line := range req.Lines {
+		retLine := entities.StockReturnLine{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			UnitCost:  line.UnitCost,
+			Serials:   line.Serials,
+		}
+		if line.Lot != nil {
+			retLine.LotNumber, retLine.LotExpiresAt = &line.Lot.Number, line.Lot.ExpiresAt
+		}
+		ret.Lines = append(ret.Lines, retLine)
+	}
+
+	if ret.Type == entities.ReturnTypeCustomer && ret.SalesOrderID != nil {
+		if err := s.applySaleCosts(ctx, ret); err != nil {
+			return nil, err
+		}
+	}
+
+	decision := ret.Type.DefaultDecision()
+	if !req.Quarantine {
+		for i := range ret.Lines {
+			if err := ret.Lines[i].Resolve(decision, ret.Lines[i].Quantity); err != nil {
+				return nil, err
+			}
+		}
+		ret.Complete(req.CreatedBy, time.Now())
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, ret); err != nil {
+			return err
+		}
+		for _, line := range ret.Lines {
+			if ret.Type == entities.ReturnTypeCustomer {
+				if err := s.adjust(ctx, ret, &line, line.Quantity, line.Serials, line.UnitCost, stockentities.MovementReasonCustomerReturn); err != nil {
+					return err
+				}
+			}
+			if req.Quarantine {
+				if err := s.quarantine(ctx, ret, line.ProductID, line.Quantity); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := s.applyDecision(ctx, ret, &line, decision, line.Quantity, line.Serials); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.StockReturn, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter, limit, offset int) ([]*entities.StockReturn, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, filter, limit, offset)
+}
+
+// Resolve aplica las decisiones de la inspección sobre unidades en cuarentena:
+// reingresarlas al stock disponible, descartarlas o enviarlas al proveedor. La
+// devolución se completa cuando no quedan unidades pendientes.
+func (s *Service) Resolve(ctx context.Context, req ResolveRequest) (*entities.StockReturn, error) {
+	ret, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ret.Status != entities.ReturnStatusQuarantined {
+		return nil, entities.ErrReturnNotQuarantined
+	}
+	if len(req.Decisions) == 0 {
+		return nil, entities.ErrReturnHasNoLines
+	}
+
+	// Las series de cada decisión se toman antes de resolverla, porque resolver
+	// avanza las unidades pendientes de la línea.
+	serials := make([][]string, len(req.Decisions))
+	for i, decision := range req.Decisions {
+		line := ret.Line(decision.ProductID)
+		if line == nil {
+			return nil, entities.ErrReturnLineNotFound
+		}
+		if !decision.Decision.AllowedFor(ret.Type) {
+			return nil, entities.ErrInvalidDecision
+		}
+		serials[i] = line.NextSerials(decision.Quantity)
+		if err := line.Resolve(decision.Decision, decision.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	if ret.IsResolved() {
+		ret.Complete(req.Actor, time.Now())
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, ret); err != nil {
+			return err
+		}
+		for i, decision := range req.Decisions {
+			_, err := s.stockService.ReleaseQuarantine(ctx, stock.QuarantineRequest{
+				TenantID:  ret.TenantID,
+				ProductID: decision.ProductID,
+				Quantity:  decision.Quantity,
+			})
+			if err != nil {
+				return err
+			}
+			if err := s.applyDecision(ctx, ret, ret.Line(decision.ProductID), decision.Decision, decision.Quantity, serials[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// applyDecision registra la salida de stock que corresponde a la decisión. Las
+// unidades que se reingresan ya están en stock y no generan movimiento.
+func (s *Service) applyDecision(ctx context.Context, ret *entities.StockReturn, line *entities.StockReturnLine, decision entities.Decision, quantity int, serials []string) error {
+	switch decision {
+	case entities.DecisionScrap:
+		return s.adjust(ctx, ret, line, -quantity, serials, nil, stockentities.MovementReasonScrap)
+	case entities.DecisionShipToSupplier:
+		return s.adjust(ctx, ret, line, -quantity, serials, nil, stockentities.MovementReasonSupplierReturn)
+	}
+	return nil
+}
+
+// adjust mueve el stock de la línea. Si la línea indica un lote, las unidades
+// entran a ese lote o salen de él; si no, las salidas de productos con
+// seguimiento por lote se toman por FEFO. serials son las unidades que se mueven
+// en productos con seguimiento por serie.
+func (s *Service) adjust(ctx context.Context, ret *entities.StockReturn, line *entities.StockReturnLine, amount int, serials []string, unitCost *money.Amount, reason stockentities.MovementReason) error {
+	var lot *stockentities.LotSpec
+	if line.LotNumber != nil {
+		lot = &stockentities.LotSpec{Number: *line.LotNumber, ExpiresAt: line.LotExpiresAt}
+	}
+
+	_, err := s.stockService.Adjust(ctx, stock.AdjustRequest{
+		TenantID:  ret.TenantID,
+		ProductID: line.ProductID,
+		Amount:    amount,
+		UnitCost:  unitCost,
+		Reason:    reason,
+		Reference: movementReference(ret),
+		Lot:       lot,
+		Serials:   serials,
+	})
+	switch {
+	case errors.Is(err, stockentities.ErrInsufficientStock), errors.Is(err, stockentities.ErrInvalidReservedAmount), errors.Is(err, stockentities.ErrInsufficientLotStock):
+		return entities.ErrInsufficientStock
+	case errors.Is(err, stockentities.ErrLotRequired):
+		return entities.ErrLotRequired
+	case errors.Is(err, stockentities.ErrLotNotFound):
+		return entities.ErrLotNotFound
+	case errors.Is(err, stockentities.ErrLotExpiryMismatch):
+		return entities.ErrLotExpiryMismatch
+	case errors.Is(err, stockentities.ErrSerialNotFound), errors.Is(err, stockentities.ErrSerialNotAvailable):
+		return entities.ErrSerialNotAvailable
+	case errors.Is(err, stockentities.ErrSerialAlreadyInStock):
+		return entities.ErrSerialAlreadyInStock
+	case errors.Is(err, stockentities.ErrProductArchived):
+		return entities.ErrProductArchived
+	}
+	return err
+}
+
+func (s *Service) quarantine(ctx context.Context, ret *entities.StockReturn, productID uuid.UUID, quantity int) error {
+	_, err := s.stockService.Quarantine(ctx, stock.QuarantineRequest{
+		TenantID:  ret.TenantID,
+		ProductID: productID,
+		Quantity:  quantity,
+	})
+	if errors.Is(err, stockentities.ErrInsufficientStock) {
+		return entities.ErrInsufficientStock
+	}
+	return err
+}
+
+// applySaleCosts toma como costo de reingreso, para las líneas que no lo indican,
+// el costo unitario con que las unidades salieron en la venta.
+func (s *Service) applySaleCosts(ctx context.Context, ret *entities.StockReturn) error {
+	movements, err := s.stockService.ListMovements(ctx, ret.TenantID, stockentities.MovementReference{
+		Type: salesorder.MovementReferenceType,
+		ID:   *ret.SalesOrderID,
+	})
+	if err != nil {
+		return err
+	}
+
+	costs := make(map[uuid.UUID]money.Amount, len(movements))
+	for _, movement := range movements {
+		if movement.Reason == stockentities.MovementReasonSale {
+			costs[movement.ProductID] = movement.UnitCost
+		}
+	}
+
+	for i := range ret.Lines {
+		line := &ret.Lines[i]
+		if cost, ok := costs[line.ProductID]; ok && line.UnitCost == nil {
+			line.UnitCost = &cost
+		}
+	}
+	return nil
+}
+
+func (s *Service) validateCreateRequest(ctx context.Context, req CreateRequest) error {
+	if !req.Type.IsValid() {
+		return entities.ErrInvalidReturnType
+	}
+	if !req.Reason.IsValid() {
+		return entities.ErrInvalidReturnReason
+	}
+	if err := validateLines(req.Lines); err != nil {
+		return err
+	}
+
+	if _, err := s.storeRepo.GetByID(ctx, req.TenantID, req.StoreID); err != nil {
+		return entities.ErrInvalidStore
+	}
+
+	for _, line := range req.Lines {
+		product, err := s.productRepo.GetByID(ctx, req.TenantID, line.ProductID)
+		if err != nil {
+			if errors.Is(err, productentities.ErrProductNotFound) {
+				return entities.ErrProductNotInStore
+			}
+			return err
+		}
+		if product.StoreID != req.StoreID {
+			return entities.ErrProductNotInStore
+		}
+
+		serialTracked := product.Tracking == catalogentities.TrackingSerial
+		if serialTracked && len(line.Serials) == 0 {
+			return entities.ErrSerialsRequired
+		}
+		if !serialTracked && len(line.Serials) > 0 {
+			return entities.ErrSerialsNotAllowed
+		}
+	}
+
+	if req.Type == entities.ReturnTypeSupplier {
+		if req.SupplierID == nil {
+			return entities.ErrSupplierRequired
+		}
+		if _, err := s.supplierRepo.GetByID(ctx, req.TenantID, *req.SupplierID); err != nil {
+			return entities.ErrInvalidSupplier
+		}
+	}
+
+	if req.Type == entities.ReturnTypeCustomer && req.SalesOrderID != nil {
+		return s.validateSalesOrder(ctx, req)
+	}
+
+	return nil
+}
+
+// validateSalesOrder exige que la venta esté cumplida en la misma sucursal y que
+// cada producto devuelto se haya vendido en al menos esa cantidad. Las series
+// devueltas tienen que ser de las unidades vendidas.
+func (s *Service) validateSalesOrder(ctx context.Context, req CreateRequest) error {
+	order, err := s.salesOrderRepo.GetByID(ctx, req.TenantID, *req.SalesOrderID)
+	if err != nil {
+		if errors.Is(err, salesorderentities.ErrSalesOrderNotFound) {
+			return entities.ErrInvalidSalesOrder
+		}
+		return err
+	}
+	if order.Status != salesorderentities.SalesOrderStatusFulfilled || order.StoreID != req.StoreID {
+		return entities.ErrInvalidSalesOrder
+	}
+
+	for _, line := range req.Lines {
+		sold := order.Line(line.ProductID)
+		if sold == nil || line.Quantity > sold.Quantity {
+			return entities.ErrProductNotInSalesOrder
+		}
+		soldSerials := make(map[string]bool, len(sold.Serials))
+		for _, serial := range sold.Serials {
+			soldSerials[serial] = true
+		}
+		for _, serial := range line.Serials {
+			if !soldSerials[serial] {
+				return entities.ErrSerialNotInSalesOrder
+			}
+		}
+	}
+	return nil
+}
+
+func validateLines(lines []LineRequest) error {
+	if len(lines) == 0 {
+		return entities.ErrReturnHasNoLines
+	}
+
+	seen := make(map[uuid.UUID]bool, len(lines))
+	serials := make(map[string]bool)
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return entities.ErrInvalidQuantity
+		}
+		if line.UnitCost != nil && line.UnitCost.IsNegative() {
+			return entities.ErrInvalidUnitCost
+		}
+		if seen[line.ProductID] {
+			return entities.ErrDuplicateProduct
+		}
+		seen[line.ProductID] = true
+
+		if len(line.Serials) > 0 && len(line.Serials) != line.Quantity {
+			return entities.ErrSerialsRequired
+		}
+		for _, serial := range line.Serials {
+			if serials[serial] {
+				return entities.ErrDuplicateSerial
+			}
+			serials[serial] = true
+		}
+	}
+
+	return nil
+}
+
+func movementReference(ret *entities.StockReturn) *stockentities.MovementReference {
+	return &stockentities.MovementReference{Type: movementReferenceType, ID: ret.ID}
+}
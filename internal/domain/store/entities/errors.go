@@ -1,10 +1,23 @@
 package entities
 
-import "errors"
+import "motico-api/pkg/apperror"
 
 var (
-	ErrStoreNotFound    = errors.New("store not found")
-	ErrStoreNameExists  = errors.New("store name already exists for this tenant")
-	ErrStoreHasProducts = errors.New("store has associated products and cannot be deleted")
-	ErrInvalidStoreName = errors.New("store name is invalid")
+	ErrStoreNotFound         = apperror.New(apperror.KindNotFound, "store_not_found", "store not found")
+	ErrStoreNameExists       = apperror.New(apperror.KindConflict, "store_name_exists", "store name already exists for this tenant")
+	ErrStoreHasProducts      = apperror.New(apperror.KindConflict, "store_has_products", "store has associated products and cannot be deleted")
+	ErrInvalidStoreName      = apperror.New(apperror.KindInvalid, "invalid_store_name", "store name is invalid")
+	ErrStoreArchived         = apperror.New(apperror.KindConflict, "store_archived", "store is archived")
+	ErrStoreNotArchived      = apperror.New(apperror.KindConflict, "store_not_archived", "store is not archived")
+	ErrStoreClosing          = apperror.New(apperror.KindConflict, "store_closing", "store is already closing")
+	ErrStoreNotClosing       = apperror.New(apperror.KindConflict, "store_not_closing", "store is not closing")
+	ErrStoreHasStock         = apperror.New(apperror.KindConflict, "store_has_stock", "store has stock and cannot be archived; close it to transfer the stock first")
+	ErrStoreHasOpenTransfers = apperror.New(apperror.KindConflict, "store_has_open_transfers", "store has open transfers and cannot be archived")
+
+	ErrLocationNotFound      = apperror.New(apperror.KindNotFound, "location_not_found", "location not found")
+	ErrLocationExists        = apperror.New(apperror.KindConflict, "location_exists", "a location with this code already exists under the same parent")
+	ErrInvalidLocationType   = apperror.New(apperror.KindInvalid, "invalid_location_type", "location type must be one of zone, aisle, bin")
+	ErrInvalidLocationCode   = apperror.New(apperror.KindInvalid, "invalid_location_code", "location code must be 1 to 20 letters or digits")
+	ErrInvalidLocationParent = apperror.New(apperror.KindUnprocessable, "invalid_location_parent", "zones have no parent, aisles belong to a zone and bins to an aisle of the same store")
+	ErrLocationInUse         = apperror.New(apperror.KindConflict, "location_in_use", "location has child locations or stock and cannot be deleted")
 )
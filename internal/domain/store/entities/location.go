@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LocationType string
+
+const (
+	LocationTypeZone  LocationType = "zone"
+	LocationTypeAisle LocationType = "aisle"
+	LocationTypeBin   LocationType = "bin"
+)
+
+// locationParents define de qué tipo tiene que ser el padre de cada ubicación:
+// las zonas son la raíz, los pasillos cuelgan de una zona y los bins de un pasillo.
+var locationParents = map[LocationType]LocationType{
+	LocationTypeZone:  "",
+	LocationTypeAisle: LocationTypeZone,
+	LocationTypeBin:   LocationTypeAisle,
+}
+
+func (t LocationType) IsValid() bool {
+	_, ok := locationParents[t]
+	return ok
+}
+
+// ParentType es el tipo que debe tener el padre; vacío para las zonas.
+func (t LocationType) ParentType() LocationType {
+	return locationParents[t]
+}
+
+var locationCodePattern = regexp.MustCompile(`^[A-Za-z0-9]{1,20}$`)
+
+// ValidLocationCode indica si el código sirve como segmento del path: letras y
+// números, sin separadores.
+func ValidLocationCode(code string) bool {
+	return locationCodePattern.MatchString(code)
+}
+
+// Location es una zona, un pasillo o un bin de una sucursal. Path es el código
+// completo desde la zona, por ejemplo A-03-12.
+type Location struct {
+	ID        uuid.UUID    `json:"id"`
+	TenantID  uuid.UUID    `json:"tenant_id"`
+	StoreID   uuid.UUID    `json:"store_id"`
+	ParentID  *uuid.UUID   `json:"parent_id,omitempty"`
+	Type      LocationType `json:"type"`
+	Code      string       `json:"code"`
+	Name      *string      `json:"name,omitempty"`
+	Path      string       `json:"path"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// NewLocation arma la ubicación bajo parent, que tiene que ser nil para una zona.
+func NewLocation(storeID uuid.UUID, parent *Location, locationType LocationType, code string, name *string) (*Location, error) {
+	if !locationType.IsValid() {
+		return nil, ErrInvalidLocationType
+	}
+	if !ValidLocationCode(code) {
+		return nil, ErrInvalidLocationCode
+	}
+
+	location := &Location{
+		StoreID: storeID,
+		Type:    locationType,
+		Code:    code,
+		Name:    name,
+		Path:    code,
+	}
+
+	parentType := locationType.ParentType()
+	switch {
+	case parentType == "" && parent != nil, parentType != "" && parent == nil:
+		return nil, ErrInvalidLocationParent
+	case parent != nil:
+		if parent.Type != parentType || parent.StoreID != storeID {
+			return nil, ErrInvalidLocationParent
+		}
+		location.ParentID = &parent.ID
+		location.Path = parent.Path + "-" + code
+	}
+
+	return location, nil
+}
+
+// LocationNode es una ubicación con las que cuelgan de ella.
+type LocationNode struct {
+	*Location
+	Children []*LocationNode `json:"children"`
+}
+
+// BuildLocationTree arma el árbol de ubicaciones de una sucursal ordenado por path.
+func BuildLocationTree(locations []*Location) []*LocationNode {
+	sorted := make([]*Location, len(locations))
+	copy(sorted, locations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Path < sorted[j].Path })
+
+	nodes := make(map[uuid.UUID]*LocationNode, len(sorted))
+	roots := []*LocationNode{}
+	for _, location := range sorted {
+		node := &LocationNode{Location: location, Children: []*LocationNode{}}
+		nodes[location.ID] = node
+	}
+	for _, location := range sorted {
+		node := nodes[location.ID]
+		if location.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[*location.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	return roots
+}
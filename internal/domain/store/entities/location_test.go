@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewLocation(t *testing.T) {
+	storeID := uuid.New()
+
+	zone, err := NewLocation(storeID, nil, LocationTypeZone, "A", nil)
+	if err != nil {
+		t.Fatalf("zone: %v", err)
+	}
+	zone.ID = uuid.New()
+
+	aisle, err := NewLocation(storeID, zone, LocationTypeAisle, "03", nil)
+	if err != nil {
+		t.Fatalf("aisle: %v", err)
+	}
+	aisle.ID = uuid.New()
+
+	bin, err := NewLocation(storeID, aisle, LocationTypeBin, "12", nil)
+	if err != nil {
+		t.Fatalf("bin: %v", err)
+	}
+	if bin.Path != "A-03-12" || *bin.ParentID != aisle.ID {
+		t.Fatalf("bin: got path %s", bin.Path)
+	}
+
+	tests := []struct {
+		name   string
+		parent *Location
+		typ    LocationType
+		code   string
+		want   error
+	}{
+		{"zone with parent", zone, LocationTypeZone, "B", ErrInvalidLocationParent},
+		{"bin under zone", zone, LocationTypeBin, "01", ErrInvalidLocationParent},
+		{"aisle without parent", nil, LocationTypeAisle, "01", ErrInvalidLocationParent},
+		{"code with separator", aisle, LocationTypeBin, "1-2", ErrInvalidLocationCode},
+		{"unknown type", nil, LocationType("shelf"), "S", ErrInvalidLocationType},
+	}
+	for _, tt := range tests {
+		if _, err := NewLocation(storeID, tt.parent, tt.typ, tt.code, nil); err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if _, err := NewLocation(uuid.New(), zone, LocationTypeAisle, "01", nil); err != ErrInvalidLocationParent {
+		t.Errorf("parent in another store: got %v", err)
+	}
+}
+
+func TestBuildLocationTree(t *testing.T) {
+	zoneID, aisleID := uuid.New(), uuid.New()
+	locations := []*Location{
+		{ID: uuid.New(), ParentID: &aisleID, Type: LocationTypeBin, Path: "A-01-02"},
+		{ID: aisleID, ParentID: &zoneID, Type: LocationTypeAisle, Path: "A-01"},
+		{ID: uuid.New(), ParentID: &aisleID, Type: LocationTypeBin, Path: "A-01-01"},
+		{ID: zoneID, Type: LocationTypeZone, Path: "A"},
+	}
+
+	tree := BuildLocationTree(locations)
+	if len(tree) != 1 || len(tree[0].Children) != 1 {
+		t.Fatalf("unexpected tree shape")
+	}
+	bins := tree[0].Children[0].Children
+	if len(bins) != 2 || bins[0].Path != "A-01-01" || bins[1].Path != "A-01-02" {
+		t.Fatalf("bins not ordered by path")
+	}
+}
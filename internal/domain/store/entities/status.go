@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StoreStatus string
+
+const (
+	StoreStatusActive StoreStatus = "active"
+	// StoreStatusClosing es una sucursal que está evacuando su stock antes de
+	// archivarse: no vende ni participa de otros traspasos.
+	StoreStatusClosing  StoreStatus = "closing"
+	StoreStatusArchived StoreStatus = "archived"
+)
+
+func (s StoreStatus) IsValid() bool {
+	return s == StoreStatusActive || s == StoreStatusClosing || s == StoreStatusArchived
+}
+
+func (s *Store) IsArchived() bool {
+	return s.Status == StoreStatusArchived
+}
+
+func (s *Store) IsClosing() bool {
+	return s.Status == StoreStatusClosing
+}
+
+// IsOpen indica si la sucursal opera con normalidad: ni en cierre ni archivada.
+func (s *Store) IsOpen() bool {
+	return s.Status == StoreStatusActive
+}
+
+// StartClosing pone la sucursal en cierre mientras transferID evacúa su stock.
+func (s *Store) StartClosing(transferID uuid.UUID) error {
+	switch {
+	case s.IsArchived():
+		return ErrStoreArchived
+	case s.IsClosing():
+		return ErrStoreClosing
+	}
+	s.Status = StoreStatusClosing
+	s.ClosingTransferID = &transferID
+	return nil
+}
+
+// Reopen deja sin efecto el cierre, por ejemplo si se cancela el traspaso de evacuación.
+func (s *Store) Reopen() error {
+	if !s.IsClosing() {
+		return ErrStoreNotClosing
+	}
+	s.Status = StoreStatusActive
+	s.ClosingTransferID = nil
+	return nil
+}
+
+// Archive retira la sucursal, esté activa o en cierre: deja de aceptar traspasos
+// y ajustes de stock y sale de los listados por defecto.
+func (s *Store) Archive(at time.Time) error {
+	if s.IsArchived() {
+		return ErrStoreArchived
+	}
+	s.Status = StoreStatusArchived
+	s.ArchivedAt = &at
+	s.ClosingTransferID = nil
+	return nil
+}
+
+func (s *Store) Unarchive() error {
+	if !s.IsArchived() {
+		return ErrStoreNotArchived
+	}
+	s.Status = StoreStatusActive
+	s.ArchivedAt = nil
+	return nil
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestStoreArchive(t *testing.T) {
+	store := &Store{Status: StoreStatusActive}
+	at := time.Now()
+
+	if err := store.Archive(at); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+	if !store.IsArchived() || store.ArchivedAt == nil || !store.ArchivedAt.Equal(at) {
+		t.Fatalf("archive: got status %s", store.Status)
+	}
+	if err := store.Archive(at); err != ErrStoreArchived {
+		t.Fatalf("archive twice: got %v", err)
+	}
+
+	if err := store.Unarchive(); err != nil {
+		t.Fatalf("unarchive: %v", err)
+	}
+	if store.IsArchived() || store.ArchivedAt != nil {
+		t.Fatalf("unarchive: got status %s", store.Status)
+	}
+	if err := store.Unarchive(); err != ErrStoreNotArchived {
+		t.Fatalf("unarchive twice: got %v", err)
+	}
+}
+
+func TestStoreClosing(t *testing.T) {
+	store := &Store{Status: StoreStatusActive}
+	transferID := uuid.New()
+
+	if err := store.StartClosing(transferID); err != nil {
+		t.Fatalf("start closing: %v", err)
+	}
+	if !store.IsClosing() || store.IsOpen() || store.ClosingTransferID == nil || *store.ClosingTransferID != transferID {
+		t.Fatalf("start closing: got status %s transfer %v", store.Status, store.ClosingTransferID)
+	}
+	if err := store.StartClosing(uuid.New()); err != ErrStoreClosing {
+		t.Fatalf("start closing twice: got %v", err)
+	}
+
+	if err := store.Reopen(); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if !store.IsOpen() || store.ClosingTransferID != nil {
+		t.Fatalf("reopen: got status %s transfer %v", store.Status, store.ClosingTransferID)
+	}
+	if err := store.Reopen(); err != ErrStoreNotClosing {
+		t.Fatalf("reopen an open store: got %v", err)
+	}
+
+	if err := store.StartClosing(transferID); err != nil {
+		t.Fatalf("start closing again: %v", err)
+	}
+	if err := store.Archive(time.Now()); err != nil {
+		t.Fatalf("archive a closing store: %v", err)
+	}
+	if !store.IsArchived() || store.ClosingTransferID != nil {
+		t.Fatalf("archive: got status %s transfer %v", store.Status, store.ClosingTransferID)
+	}
+	if err := store.StartClosing(transferID); err != ErrStoreArchived {
+		t.Fatalf("close an archived store: got %v", err)
+	}
+}
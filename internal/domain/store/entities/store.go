@@ -7,10 +7,16 @@ import (
 )
 
 type Store struct {
-	ID        uuid.UUID `json:"id"`
-	TenantID  uuid.UUID `json:"tenant_id"`
-	Name      string    `json:"name"`
-	Address   *string   `json:"address,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID       uuid.UUID   `json:"id"`
+	TenantID uuid.UUID   `json:"tenant_id"`
+	Name     string      `json:"name"`
+	Address  *string     `json:"address,omitempty"`
+	Status   StoreStatus `json:"status"`
+	// ArchivedAt es cuándo se archivó la sucursal; nil mientras está activa
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// ClosingTransferID es el traspaso que evacúa el stock mientras la sucursal está en cierre
+	ClosingTransferID *uuid.UUID `json:"closing_transfer_id,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
 }
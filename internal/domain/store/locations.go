@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"motico-api/internal/domain/store/entities"
+
+	"github.com/google/uuid"
+)
+
+type CreateLocationRequest struct {
+	TenantID uuid.UUID
+	StoreID  uuid.UUID
+	ParentID *uuid.UUID
+	Type     entities.LocationType
+	Code     string
+	Name     *string
+}
+
+type UpdateLocationRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	StoreID  uuid.UUID
+	Name     *string
+}
+
+// CreateLocation agrega una zona, un pasillo o un bin a la sucursal. El código no
+// cambia después de crearse porque forma el path de las ubicaciones hijas.
+func (s *Service) CreateLocation(ctx context.Context, req CreateLocationRequest) (*entities.Location, error) {
+	if _, err := s.repo.GetByID(ctx, req.TenantID, req.StoreID); err != nil {
+		return nil, err
+	}
+
+	var parent *entities.Location
+	if req.ParentID != nil {
+		var err error
+		parent, err = s.repo.GetLocation(ctx, req.TenantID, req.StoreID, *req.ParentID)
+		if err != nil {
+			if errors.Is(err, entities.ErrLocationNotFound) {
+				return nil, entities.ErrInvalidLocationParent
+			}
+			return nil, err
+		}
+	}
+
+	location, err := entities.NewLocation(req.StoreID, parent, req.Type, req.Code, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	location.TenantID = req.TenantID
+
+	if err := s.repo.CreateLocation(ctx, location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (s *Service) GetLocation(ctx context.Context, tenantID, storeID, id uuid.UUID) (*entities.Location, error) {
+	return s.repo.GetLocation(ctx, tenantID, storeID, id)
+}
+
+// ListLocations devuelve el árbol de ubicaciones de la sucursal.
+func (s *Service) ListLocations(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.LocationNode, error) {
+	if _, err := s.repo.GetByID(ctx, tenantID, storeID); err != nil {
+		return nil, err
+	}
+
+	locations, err := s.repo.ListLocations(ctx, tenantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.BuildLocationTree(locations), nil
+}
+
+func (s *Service) UpdateLocation(ctx context.Context, req UpdateLocationRequest) (*entities.Location, error) {
+	location, err := s.repo.GetLocation(ctx, req.TenantID, req.StoreID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		location.Name = req.Name
+	}
+
+	if err := s.repo.UpdateLocation(ctx, location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// DeleteLocation borra una ubicación sin ubicaciones hijas ni stock.
+func (s *Service) DeleteLocation(ctx context.Context, tenantID, storeID, id uuid.UUID) error {
+	if _, err := s.repo.GetLocation(ctx, tenantID, storeID, id); err != nil {
+		return err
+	}
+
+	inUse, err := s.repo.LocationInUse(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return entities.ErrLocationInUse
+	}
+
+	return s.repo.DeleteLocation(ctx, tenantID, id)
+}
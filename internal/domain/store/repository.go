@@ -3,6 +3,8 @@ package store
 import (
 	"context"
 	"motico-api/internal/domain/store/entities"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,9 +12,33 @@ import (
 type Repository interface {
 	Create(ctx context.Context, store *entities.Store) error
 	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Store, error)
-	List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entities.Store, error)
+	// List filtra por estado si status no es nil.
+	List(ctx context.Context, tenantID uuid.UUID, status *entities.StoreStatus, params query.Params, limit, offset int) ([]*entities.Store, error)
 	Update(ctx context.Context, store *entities.Store) error
+	// Delete marca la sucursal como borrada; las consultas dejan de verla.
 	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	// Restore quita la marca de borrado. Devuelve ErrStoreNotFound si la sucursal
+	// no existe o no está borrada.
+	Restore(ctx context.Context, tenantID, id uuid.UUID) error
+	// PurgeDeleted borra definitivamente las sucursales borradas antes de before
+	// y devuelve cuántas borró.
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error)
 	ExistsByName(ctx context.Context, tenantID uuid.UUID, name string) (bool, error)
 	HasProducts(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error)
+	// ArchiveProducts archiva los productos activos de una sucursal ya archivada
+	// con su mismo archived_at; UnarchiveProducts reactiva esos mismos productos y
+	// se llama antes de reactivar la sucursal.
+	ArchiveProducts(ctx context.Context, tenantID, storeID uuid.UUID) error
+	UnarchiveProducts(ctx context.Context, tenantID, storeID uuid.UUID) error
+	HasStock(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error)
+	// HasOpenTransfers indica si la sucursal es origen o destino de un traspaso sin terminar.
+	HasOpenTransfers(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error)
+	CreateLocation(ctx context.Context, location *entities.Location) error
+	GetLocation(ctx context.Context, tenantID, storeID, id uuid.UUID) (*entities.Location, error)
+	// ListLocations devuelve todas las ubicaciones de la sucursal ordenadas por path.
+	ListLocations(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.Location, error)
+	UpdateLocation(ctx context.Context, location *entities.Location) error
+	DeleteLocation(ctx context.Context, tenantID, id uuid.UUID) error
+	// LocationInUse indica si la ubicación tiene ubicaciones hijas o stock en algún bin.
+	LocationInUse(ctx context.Context, tenantID, id uuid.UUID) (bool, error)
 }
@@ -4,22 +4,27 @@ import (
 	"context"
 	"motico-api/config"
 	"motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transaction"
 	"motico-api/pkg/logger"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo   Repository
-	config *config.Config
-	logger logger.Logger
+	repo      Repository
+	txManager transaction.Manager
+	config    *config.Config
+	logger    logger.Logger
 }
 
-func NewService(repo Repository, cfg *config.Config, log logger.Logger) *Service {
+func NewService(repo Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		config: cfg,
-		logger: log,
+		repo:      repo,
+		txManager: txManager,
+		config:    cfg,
+		logger:    log,
 	}
 }
 
@@ -53,6 +58,7 @@ func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Stor
 		TenantID: req.TenantID,
 		Name:     req.Name,
 		Address:  req.Address,
+		Status:   entities.StoreStatusActive,
 	}
 
 	if err := s.repo.Create(ctx, store); err != nil {
@@ -66,7 +72,8 @@ func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entitie
 	return s.repo.GetByID(ctx, tenantID, id)
 }
 
-func (s *Service) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entities.Store, error) {
+// List lista las sucursales con el estado indicado; sin estado lista todas.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, status *entities.StoreStatus, params query.Params, limit, offset int) ([]*entities.Store, error) {
 	if limit <= 0 {
 		limit = s.config.Pagination.DefaultLimit
 	}
@@ -77,7 +84,7 @@ func (s *Service) List(ctx context.Context, tenantID uuid.UUID, limit, offset in
 		offset = 0
 	}
 
-	return s.repo.List(ctx, tenantID, limit, offset)
+	return s.repo.List(ctx, tenantID, status, params, limit, offset)
 }
 
 func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Store, error) {
@@ -126,6 +133,96 @@ func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
 	return s.repo.Delete(ctx, tenantID, id)
 }
 
+// Archive retira la sucursal junto con sus productos activos. La sucursal no puede
+// tener stock ni traspasos sin terminar: para vaciarla primero se la cierra, lo
+// que traspasa el stock restante a otra sucursal.
+func (s *Service) Archive(ctx context.Context, tenantID, id uuid.UUID) (*entities.Store, error) {
+	var store *entities.Store
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		store, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if store.IsArchived() {
+			return entities.ErrStoreArchived
+		}
+
+		hasStock, err := s.repo.HasStock(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if hasStock {
+			return entities.ErrStoreHasStock
+		}
+
+		hasTransfers, err := s.repo.HasOpenTransfers(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if hasTransfers {
+			return entities.ErrStoreHasOpenTransfers
+		}
+
+		if err := store.Archive(time.Now()); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, store); err != nil {
+			return err
+		}
+		return s.repo.ArchiveProducts(ctx, tenantID, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Unarchive reactiva la sucursal y los productos que se archivaron con ella.
+func (s *Service) Unarchive(ctx context.Context, tenantID, id uuid.UUID) (*entities.Store, error) {
+	var store *entities.Store
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		store, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if !store.IsArchived() {
+			return entities.ErrStoreNotArchived
+		}
+
+		// Los productos se reactivan antes de que la sucursal pierda su archived_at
+		if err := s.repo.UnarchiveProducts(ctx, tenantID, id); err != nil {
+			return err
+		}
+		if err := store.Unarchive(); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, store)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Restore recupera una sucursal borrada lógicamente. Falla si mientras tanto
+// otra sucursal tomó su nombre.
+func (s *Service) Restore(ctx context.Context, tenantID, id uuid.UUID) (*entities.Store, error) {
+	if err := s.repo.Restore(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+// PurgeDeleted borra definitivamente las sucursales borradas antes de before.
+func (s *Service) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return s.repo.PurgeDeleted(ctx, before, batchSize)
+}
+
 func (s *Service) validateName(name string) error {
 	if name == "" {
 		return entities.ErrInvalidStoreName
@@ -0,0 +1,10 @@
+package entities
+
+import "motico-api/pkg/apperror"
+
+var (
+	ErrSupplierNotFound          = apperror.New(apperror.KindNotFound, "supplier_not_found", "supplier not found")
+	ErrSupplierNameExists        = apperror.New(apperror.KindConflict, "supplier_name_exists", "supplier name already exists for this tenant")
+	ErrSupplierHasPurchaseOrders = apperror.New(apperror.KindConflict, "supplier_has_purchase_orders", "supplier has purchase orders and cannot be deleted")
+	ErrInvalidSupplierName       = apperror.New(apperror.KindInvalid, "invalid_supplier_name", "supplier name is invalid")
+)
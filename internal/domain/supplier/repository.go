@@ -0,0 +1,19 @@
+package supplier
+
+import (
+	"context"
+	"motico-api/internal/domain/supplier/entities"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, supplier *entities.Supplier) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Supplier, error)
+	List(ctx context.Context, tenantID uuid.UUID, params query.Params, limit, offset int) ([]*entities.Supplier, error)
+	Update(ctx context.Context, supplier *entities.Supplier) error
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	ExistsByName(ctx context.Context, tenantID uuid.UUID, name string) (bool, error)
+	HasPurchaseOrders(ctx context.Context, tenantID, supplierID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,156 @@
+package supplier
+
+import (
+	"context"
+	"motico-api/config"
+	"motico-api/internal/domain/supplier/entities"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo   Repository
+	config *config.Config
+	logger logger.Logger
+}
+
+func NewService(repo Repository, cfg *config.Config, log logger.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		config: cfg,
+		logger: log,
+	}
+}
+
+type CreateRequest struct {
+	TenantID    uuid.UUID
+	Name        string
+	ContactName *string
+	Email       *string
+	Phone       *string
+	Address     *string
+}
+
+type UpdateRequest struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	Name        *string
+	ContactName *string
+	Email       *string
+	Phone       *string
+	Address     *string
+}
+
+func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Supplier, error) {
+	if err := s.validateName(req.Name); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.ExistsByName(ctx, req.TenantID, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, entities.ErrSupplierNameExists
+	}
+
+	supplier := &entities.Supplier{
+		TenantID:    req.TenantID,
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+	}
+
+	if err := s.repo.Create(ctx, supplier); err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Supplier, error) {
+	return s.repo.GetByID(ctx, tenantID, id)
+}
+
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, params query.Params, limit, offset int) ([]*entities.Supplier, error) {
+	if limit <= 0 {
+		limit = s.config.Pagination.DefaultLimit
+	}
+	if limit > s.config.Pagination.MaxLimit {
+		limit = s.config.Pagination.MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.List(ctx, tenantID, params, limit, offset)
+}
+
+func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Supplier, error) {
+	supplier, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if err := s.validateName(*req.Name); err != nil {
+			return nil, err
+		}
+
+		if *req.Name != supplier.Name {
+			exists, err := s.repo.ExistsByName(ctx, req.TenantID, *req.Name)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, entities.ErrSupplierNameExists
+			}
+			supplier.Name = *req.Name
+		}
+	}
+
+	if req.ContactName != nil {
+		supplier.ContactName = req.ContactName
+	}
+	if req.Email != nil {
+		supplier.Email = req.Email
+	}
+	if req.Phone != nil {
+		supplier.Phone = req.Phone
+	}
+	if req.Address != nil {
+		supplier.Address = req.Address
+	}
+
+	if err := s.repo.Update(ctx, supplier); err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	hasOrders, err := s.repo.HasPurchaseOrders(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if hasOrders {
+		return entities.ErrSupplierHasPurchaseOrders
+	}
+
+	return s.repo.Delete(ctx, tenantID, id)
+}
+
+func (s *Service) validateName(name string) error {
+	if name == "" {
+		return entities.ErrInvalidSupplierName
+	}
+	if len(name) > s.config.Validation.MaxNameLength {
+		return entities.ErrInvalidSupplierName
+	}
+	return nil
+}
@@ -1,7 +1,7 @@
 package entities
 
-import "errors"
+import "motico-api/pkg/apperror"
 
 var (
-	ErrTenantNotFound = errors.New("tenant not found")
+	ErrTenantNotFound = apperror.New(apperror.KindNotFound, "tenant_not_found", "tenant not found")
 )
@@ -0,0 +1,10 @@
+package transaction
+
+import "context"
+
+// Manager ejecuta una unidad de trabajo dentro de una transacción de base de datos.
+// Los repositorios que reciben el ctx de fn participan de la misma transacción;
+// si fn devuelve error se revierte todo.
+type Manager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
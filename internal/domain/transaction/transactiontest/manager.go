@@ -0,0 +1,23 @@
+// Package transactiontest ofrece un transaction.Manager para probar servicios sin
+// base de datos.
+package transactiontest
+
+import "context"
+
+type txKey struct{}
+
+// Manager ejecuta fn sin transacción: lo que fn haya escrito antes de fallar no
+// se revierte.
+type Manager struct{}
+
+func (Manager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// InTransaction indica si ctx viene de WithinTransaction. Los repositorios falsos
+// lo usan para comprobar que las lecturas con bloqueo ocurren dentro de la
+// transacción.
+func InTransaction(ctx context.Context) bool {
+	inTx, _ := ctx.Value(txKey{}).(bool)
+	return inTx
+}
@@ -0,0 +1,159 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	stockentities "motico-api/internal/domain/stock/entities"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transfer/entities"
+	"motico-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// CloseStoreRequest pide cerrar una sucursal traspasando su stock restante a ToStoreID.
+type CloseStoreRequest struct {
+	TenantID    uuid.UUID
+	StoreID     uuid.UUID
+	ToStoreID   uuid.UUID
+	Notes       *string
+	RequestedBy string
+}
+
+// CloseStore cierra una sucursal. Sin stock la archiva en el acto. Con stock crea
+// el traspaso de evacuación hacia ToStoreID y deja la sucursal en cierre: no vende
+// ni participa de otros traspasos hasta que el traspaso se recibe y Receive la
+// archiva; si el traspaso se cancela o se borra, la sucursal vuelve a operar.
+// Devuelve el traspaso de evacuación, o nil si la sucursal se archivó en el acto.
+func (s *Service) CloseStore(ctx context.Context, req CloseStoreRequest) (*storeentities.Store, *entities.Transfer, error) {
+	var store *storeentities.Store
+	var evacuation *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		store, err = s.storeRepo.GetByID(ctx, req.TenantID, req.StoreID)
+		if err != nil {
+			return err
+		}
+		if store.IsArchived() {
+			return storeentities.ErrStoreArchived
+		}
+		if store.IsClosing() {
+			return storeentities.ErrStoreClosing
+		}
+
+		evacuation, err = s.evacuate(ctx, req)
+		if errors.Is(err, entities.ErrNothingToTransfer) {
+			store, err = s.storeService.Archive(ctx, req.TenantID, req.StoreID)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := store.StartClosing(evacuation.ID); err != nil {
+			return err
+		}
+		return s.storeRepo.Update(ctx, store)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return store, evacuation, nil
+}
+
+// evacuate arma el traspaso de cierre de una sucursal: una línea por cada
+// producto con stock, con todas sus unidades y, si lleva seguimiento por serie,
+// todos sus números de serie. El stock reservado o en cuarentena no se puede
+// traspasar, así que hay que liberarlo antes de cerrar.
+func (s *Service) evacuate(ctx context.Context, req CloseStoreRequest) (*entities.Transfer, error) {
+	stocks, err := s.stockService.ListByStore(ctx, req.TenantID, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	if len(stocks) == 0 {
+		return nil, entities.ErrNothingToTransfer
+	}
+
+	inStock := stockentities.SerialStatusInStock
+	lines := make([]LineRequest, 0, len(stocks))
+	for _, st := range stocks {
+		if st.HeldQuantity() > 0 {
+			return nil, entities.ErrStoreHasHeldStock
+		}
+
+		line := LineRequest{ProductID: st.ProductID, Quantity: st.Quantity}
+		product, err := s.productRepo.GetByID(ctx, req.TenantID, st.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if product.Tracking == catalogentities.TrackingSerial {
+			units, err := s.stockService.ListSerials(ctx, req.TenantID, st.ProductID, &inStock)
+			if err != nil {
+				return nil, err
+			}
+			for _, unit := range units {
+				line.Serials = append(line.Serials, unit.SerialNumber)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return s.Create(ctx, CreateRequest{
+		TenantID:    req.TenantID,
+		FromStoreID: req.StoreID,
+		ToStoreID:   req.ToStoreID,
+		Lines:       lines,
+		Notes:       req.Notes,
+		RequestedBy: req.RequestedBy,
+	})
+}
+
+// finishClosing archiva la sucursal de origen cuando se recibe su traspaso de
+// evacuación. Si mientras tanto entró stock o quedó otro traspaso abierto, la
+// sucursal sigue en cierre y se archiva a mano una vez vacía.
+func (s *Service) finishClosing(ctx context.Context, transfer *entities.Transfer) error {
+	store, err := s.closingStore(ctx, transfer)
+	if err != nil || store == nil {
+		return err
+	}
+
+	_, err = s.storeService.Archive(ctx, transfer.TenantID, store.ID)
+	if errors.Is(err, storeentities.ErrStoreHasStock) || errors.Is(err, storeentities.ErrStoreHasOpenTransfers) {
+		s.logger.Warn("Closing store not archived after evacuation",
+			logger.Error(err),
+			logger.String("store_id", store.ID.String()),
+			logger.String("transfer_id", transfer.ID.String()),
+		)
+		return nil
+	}
+	return err
+}
+
+// reopenStore devuelve a operar la sucursal de origen si transfer era su
+// traspaso de evacuación y se canceló o se borró.
+func (s *Service) reopenStore(ctx context.Context, transfer *entities.Transfer) error {
+	store, err := s.closingStore(ctx, transfer)
+	if err != nil || store == nil {
+		return err
+	}
+
+	if err := store.Reopen(); err != nil {
+		return err
+	}
+	return s.storeRepo.Update(ctx, store)
+}
+
+// closingStore devuelve la sucursal de origen si está en cierre y transfer es su
+// traspaso de evacuación; si no, nil.
+func (s *Service) closingStore(ctx context.Context, transfer *entities.Transfer) (*storeentities.Store, error) {
+	store, err := s.storeRepo.GetByID(ctx, transfer.TenantID, transfer.FromStoreID)
+	if err != nil {
+		return nil, err
+	}
+	if !store.IsClosing() || store.ClosingTransferID == nil || *store.ClosingTransferID != transfer.ID {
+		return nil, nil
+	}
+	return store, nil
+}
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransferDiscrepancy struct {
+	ID               uuid.UUID `json:"id"`
+	TenantID         uuid.UUID `json:"tenant_id"`
+	TransferID       uuid.UUID `json:"transfer_id"`
+	TransferLineID   uuid.UUID `json:"transfer_line_id"`
+	ProductID        uuid.UUID `json:"product_id"`
+	ExpectedQuantity int       `json:"expected_quantity"`
+	ReceivedQuantity int       `json:"received_quantity"`
+	Variance         int       `json:"variance"`
+	RecordedBy       *string   `json:"recorded_by,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func (d *TransferDiscrepancy) IsShort() bool {
+	return d.Variance < 0
+}
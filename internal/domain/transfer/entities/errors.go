@@ -1,13 +1,28 @@
 package entities
 
-import "errors"
+import "motico-api/pkg/apperror"
 
 var (
-	ErrTransferNotFound         = errors.New("transfer not found")
-	ErrTransferNotPending       = errors.New("transfer is not in pending status")
-	ErrTransferAlreadyCompleted = errors.New("transfer is already completed")
-	ErrTransferAlreadyCancelled = errors.New("transfer is already cancelled")
-	ErrInvalidTransferStores    = errors.New("from_store and to_store must be different")
-	ErrInvalidQuantity          = errors.New("quantity must be greater than zero")
-	ErrInsufficientStock        = errors.New("insufficient stock available for transfer")
+	ErrTransferNotFound           = apperror.New(apperror.KindNotFound, "transfer_not_found", "transfer not found")
+	ErrTransferNotPending         = apperror.New(apperror.KindInvalid, "transfer_not_pending", "transfer is not in pending status")
+	ErrInvalidStatusTransition    = apperror.New(apperror.KindConflict, "invalid_status_transition", "action is not allowed for the current transfer status")
+	ErrInvalidTransferStores      = apperror.New(apperror.KindInvalid, "invalid_transfer_stores", "from_store and to_store must be different")
+	ErrInvalidQuantity            = apperror.New(apperror.KindInvalid, "invalid_quantity", "quantity must be greater than zero")
+	ErrInsufficientStock          = apperror.New(apperror.KindConflict, "insufficient_stock", "insufficient stock available for transfer")
+	ErrTransferHasNoLines         = apperror.New(apperror.KindInvalid, "transfer_has_no_lines", "transfer must have at least one line")
+	ErrDuplicateTransferProduct   = apperror.New(apperror.KindInvalid, "duplicate_transfer_product", "a product can only appear once per transfer")
+	ErrTransferLineNotFound       = apperror.New(apperror.KindInvalid, "transfer_line_not_found", "product is not part of this transfer")
+	ErrDestinationProductNotFound = apperror.New(apperror.KindUnprocessable, "destination_product_not_found", "product is not listed in the destination store")
+	ErrSerialsRequired            = apperror.New(apperror.KindInvalid, "serials_required", "serial-tracked products require one serial per unit")
+	ErrSerialsNotAllowed          = apperror.New(apperror.KindInvalid, "serials_not_allowed", "serials only apply to serial-tracked products")
+	ErrDuplicateSerial            = apperror.New(apperror.KindInvalid, "duplicate_serial", "a serial number can only appear once per transfer")
+	ErrSerialNotAvailable         = apperror.New(apperror.KindConflict, "serial_not_available", "serial is not in stock at the origin store")
+	ErrSerialNotInTransfer        = apperror.New(apperror.KindInvalid, "serial_not_in_transfer", "serial was not sent in this transfer or was already received")
+	ErrPickListUnavailable        = apperror.New(apperror.KindConflict, "pick_list_unavailable", "pick list is only available before the transfer is dispatched")
+	ErrTransferProductNotFound    = apperror.New(apperror.KindUnprocessable, "transfer_product_not_found", "a product of the transfer no longer exists")
+	ErrTransferStoreArchived      = apperror.New(apperror.KindUnprocessable, "transfer_store_archived", "from_store and to_store must not be closing or archived")
+	ErrTransferProductArchived    = apperror.New(apperror.KindUnprocessable, "transfer_product_archived", "a product of the transfer is archived in the origin or destination store")
+	ErrStoreHasHeldStock          = apperror.New(apperror.KindConflict, "store_has_held_stock", "store has reserved or quarantined stock; release it before closing the store")
+	ErrLotSurplus                 = apperror.New(apperror.KindConflict, "lot_surplus", "units received above the shipped quantity of a lot-tracked product must be adjusted by lot")
+	ErrNothingToTransfer          = apperror.New(apperror.KindUnprocessable, "nothing_to_transfer", "store has no stock to transfer")
 )
@@ -0,0 +1,15 @@
+package entities
+
+import (
+	stockentities "motico-api/internal/domain/stock/entities"
+
+	"github.com/google/uuid"
+)
+
+// PickList es la guía para preparar un traspaso: de qué bins de la sucursal de
+// origen sacar cada línea, en orden de recorrido.
+type PickList struct {
+	TransferID  uuid.UUID                 `json:"transfer_id"`
+	FromStoreID uuid.UUID                 `json:"from_store_id"`
+	Lines       []*stockentities.PickLine `json:"lines"`
+}
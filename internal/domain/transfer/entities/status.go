@@ -0,0 +1,67 @@
+package entities
+
+import "time"
+
+type TransferStatus string
+
+const (
+	TransferStatusPending           TransferStatus = "pending"
+	TransferStatusApproved          TransferStatus = "approved"
+	TransferStatusInTransit         TransferStatus = "in_transit"
+	TransferStatusPartiallyReceived TransferStatus = "partially_received"
+	TransferStatusReceived          TransferStatus = "received"
+	TransferStatusCancelled         TransferStatus = "cancelled"
+)
+
+// transferTransitions define el flujo solicitud → aprobación → despacho → recepción.
+// Un traspaso en tránsito ya salió de la sucursal de origen y no puede cancelarse.
+var transferTransitions = map[TransferStatus][]TransferStatus{
+	TransferStatusPending:           {TransferStatusApproved, TransferStatusCancelled},
+	TransferStatusApproved:          {TransferStatusInTransit, TransferStatusCancelled},
+	TransferStatusInTransit:         {TransferStatusPartiallyReceived, TransferStatusReceived},
+	TransferStatusPartiallyReceived: {TransferStatusPartiallyReceived, TransferStatusReceived},
+}
+
+func (s TransferStatus) IsValid() bool {
+	switch s {
+	case TransferStatusPending, TransferStatusApproved, TransferStatusInTransit,
+		TransferStatusPartiallyReceived, TransferStatusReceived, TransferStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (s TransferStatus) CanTransitionTo(next TransferStatus) bool {
+	for _, allowed := range transferTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s TransferStatus) IsFinal() bool {
+	return len(transferTransitions[s]) == 0
+}
+
+// TransitionTo mueve el traspaso al siguiente estado registrando quién y cuándo
+// ejecutó la acción.
+func (t *Transfer) TransitionTo(next TransferStatus, actor string, at time.Time) error {
+	if !t.Status.CanTransitionTo(next) {
+		return ErrInvalidStatusTransition
+	}
+
+	switch next {
+	case TransferStatusApproved:
+		t.ApprovedAt, t.ApprovedBy = &at, &actor
+	case TransferStatusInTransit:
+		t.DispatchedAt, t.DispatchedBy = &at, &actor
+	case TransferStatusReceived:
+		t.ReceivedAt, t.ReceivedBy = &at, &actor
+	case TransferStatusCancelled:
+		t.CancelledAt, t.CancelledBy = &at, &actor
+	}
+
+	t.Status = next
+	return nil
+}
@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransferStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from TransferStatus
+		to   TransferStatus
+		want bool
+	}{
+		{TransferStatusPending, TransferStatusApproved, true},
+		{TransferStatusPending, TransferStatusCancelled, true},
+		{TransferStatusPending, TransferStatusInTransit, false},
+		{TransferStatusApproved, TransferStatusInTransit, true},
+		{TransferStatusApproved, TransferStatusCancelled, true},
+		{TransferStatusApproved, TransferStatusReceived, false},
+		{TransferStatusInTransit, TransferStatusPartiallyReceived, true},
+		{TransferStatusInTransit, TransferStatusReceived, true},
+		{TransferStatusInTransit, TransferStatusCancelled, false},
+		{TransferStatusPartiallyReceived, TransferStatusPartiallyReceived, true},
+		{TransferStatusPartiallyReceived, TransferStatusReceived, true},
+		{TransferStatusReceived, TransferStatusCancelled, false},
+		{TransferStatusCancelled, TransferStatusApproved, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestTransferTransitionToRecordsActor(t *testing.T) {
+	transfer := &Transfer{Status: TransferStatusPending}
+	at := time.Now()
+
+	if err := transfer.TransitionTo(TransferStatusApproved, "manager", at); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if transfer.ApprovedBy == nil || *transfer.ApprovedBy != "manager" || !transfer.ApprovedAt.Equal(at) {
+		t.Fatalf("approval actor not recorded: %+v", transfer)
+	}
+
+	if err := transfer.TransitionTo(TransferStatusReceived, "clerk", at); err != ErrInvalidStatusTransition {
+		t.Fatalf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+	if transfer.Status != TransferStatusApproved {
+		t.Fatalf("status changed on rejected transition: %s", transfer.Status)
+	}
+}
@@ -6,37 +6,89 @@ import (
 	"github.com/google/uuid"
 )
 
-type TransferStatus string
+type Transfer struct {
+	ID           uuid.UUID      `json:"id"`
+	TenantID     uuid.UUID      `json:"tenant_id"`
+	FromStoreID  uuid.UUID      `json:"from_store_id"`
+	ToStoreID    uuid.UUID      `json:"to_store_id"`
+	Status       TransferStatus `json:"status"`
+	Notes        *string        `json:"notes,omitempty"`
+	Lines        []TransferLine `json:"lines"`
+	RequestedBy  *string        `json:"requested_by,omitempty"`
+	ApprovedAt   *time.Time     `json:"approved_at,omitempty"`
+	ApprovedBy   *string        `json:"approved_by,omitempty"`
+	DispatchedAt *time.Time     `json:"dispatched_at,omitempty"`
+	DispatchedBy *string        `json:"dispatched_by,omitempty"`
+	ReceivedAt   *time.Time     `json:"received_at,omitempty"`
+	ReceivedBy   *string        `json:"received_by,omitempty"`
+	CancelledAt  *time.Time     `json:"cancelled_at,omitempty"`
+	CancelledBy  *string        `json:"cancelled_by,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    *time.Time     `json:"deleted_at,omitempty"`
+}
 
-const (
-	TransferStatusPending   TransferStatus = "pending"
-	TransferStatusCompleted TransferStatus = "completed"
-	TransferStatusCancelled TransferStatus = "cancelled"
-)
+// TransferLine es un producto del traspaso. En productos con seguimiento por
+// serie, Serials son las unidades enviadas y ReceivedSerials las que ya llegaron.
+type TransferLine struct {
+	ID               uuid.UUID `json:"id"`
+	TransferID       uuid.UUID `json:"transfer_id"`
+	ProductID        uuid.UUID `json:"product_id"`
+	Quantity         int       `json:"quantity"`
+	ReceivedQuantity int       `json:"received_quantity"`
+	Serials          []string  `json:"serials,omitempty"`
+	ReceivedSerials  []string  `json:"received_serials,omitempty"`
+}
 
-type Transfer struct {
-	ID          uuid.UUID      `json:"id"`
-	TenantID    uuid.UUID      `json:"tenant_id"`
-	ProductID   uuid.UUID      `json:"product_id"`
-	FromStoreID uuid.UUID      `json:"from_store_id"`
-	ToStoreID   uuid.UUID      `json:"to_store_id"`
-	Quantity    int            `json:"quantity"`
-	Status      TransferStatus `json:"status"`
-	Notes       *string        `json:"notes,omitempty"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
+// OutstandingSerials son las unidades enviadas que todavía no llegaron, en el
+// orden en que se enviaron.
+func (l *TransferLine) OutstandingSerials() []string {
+	received := make(map[string]bool, len(l.ReceivedSerials))
+	for _, serial := range l.ReceivedSerials {
+		received[serial] = true
+	}
+
+	var outstanding []string
+	for _, serial := range l.Serials {
+		if !received[serial] {
+			outstanding = append(outstanding, serial)
+		}
+	}
+	return outstanding
 }
 
-func (t *Transfer) IsPending() bool {
-	return t.Status == TransferStatusPending
+// ReceiveSerials registra la llegada de unidades enviadas en la línea.
+func (l *TransferLine) ReceiveSerials(serials []string) error {
+	outstanding := make(map[string]bool, len(l.Serials))
+	for _, serial := range l.OutstandingSerials() {
+		outstanding[serial] = true
+	}
+
+	for _, serial := range serials {
+		if !outstanding[serial] {
+			return ErrSerialNotInTransfer
+		}
+		delete(outstanding, serial)
+		l.ReceivedSerials = append(l.ReceivedSerials, serial)
+	}
+	return nil
 }
 
-func (t *Transfer) IsCompleted() bool {
-	return t.Status == TransferStatusCompleted
+func (l *TransferLine) OutstandingQuantity() int {
+	outstanding := l.Quantity - l.ReceivedQuantity
+	if outstanding < 0 {
+		return 0
+	}
+	return outstanding
 }
 
-func (t *Transfer) IsCancelled() bool {
-	return t.Status == TransferStatusCancelled
+// Variance es negativa cuando llegó menos de lo enviado y positiva cuando llegó de más.
+func (l *TransferLine) Variance() int {
+	return l.ReceivedQuantity - l.Quantity
+}
+
+func (t *Transfer) IsPending() bool {
+	return t.Status == TransferStatusPending
 }
 
 func (t *Transfer) CanUpdate() bool {
@@ -46,3 +98,49 @@ func (t *Transfer) CanUpdate() bool {
 func (t *Transfer) CanDelete() bool {
 	return t.IsPending()
 }
+
+func (t *Transfer) TotalQuantity() int {
+	total := 0
+	for _, line := range t.Lines {
+		total += line.Quantity
+	}
+	return total
+}
+
+func (t *Transfer) Line(productID uuid.UUID) *TransferLine {
+	for i := range t.Lines {
+		if t.Lines[i].ProductID == productID {
+			return &t.Lines[i]
+		}
+	}
+	return nil
+}
+
+func (t *Transfer) IsFullyReceived() bool {
+	for _, line := range t.Lines {
+		if line.OutstandingQuantity() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Discrepancies devuelve las diferencias entre lo enviado y lo recibido por línea.
+func (t *Transfer) Discrepancies() []TransferDiscrepancy {
+	var discrepancies []TransferDiscrepancy
+	for _, line := range t.Lines {
+		if line.Variance() == 0 {
+			continue
+		}
+		discrepancies = append(discrepancies, TransferDiscrepancy{
+			TenantID:         t.TenantID,
+			TransferID:       t.ID,
+			TransferLineID:   line.ID,
+			ProductID:        line.ProductID,
+			ExpectedQuantity: line.Quantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			Variance:         line.Variance(),
+		})
+	}
+	return discrepancies
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransferLineReceiveSerials(t *testing.T) {
+	line := &TransferLine{Quantity: 3, Serials: []string{"E1", "E2", "E3"}}
+
+	if err := line.ReceiveSerials([]string{"E2"}); err != nil {
+		t.Fatalf("receive E2: %v", err)
+	}
+	if got, want := line.OutstandingSerials(), []string{"E1", "E3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("outstanding: got %v, want %v", got, want)
+	}
+
+	if err := line.ReceiveSerials([]string{"E2"}); err != ErrSerialNotInTransfer {
+		t.Errorf("receive E2 twice: got %v, want ErrSerialNotInTransfer", err)
+	}
+	if err := line.ReceiveSerials([]string{"X9"}); err != ErrSerialNotInTransfer {
+		t.Errorf("receive unknown serial: got %v, want ErrSerialNotInTransfer", err)
+	}
+	if err := line.ReceiveSerials([]string{"E1", "E1"}); err != ErrSerialNotInTransfer {
+		t.Errorf("receive duplicate serial: got %v, want ErrSerialNotInTransfer", err)
+	}
+}
+
+func TestTransferLineOutstandingQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int
+		received int
+		want     int
+	}{
+		{"nothing received", 5, 0, 5},
+		{"partially received", 5, 3, 2},
+		{"fully received", 5, 5, 0},
+		{"over received", 5, 7, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := &TransferLine{Quantity: tt.quantity, ReceivedQuantity: tt.received}
+			if got := line.OutstandingQuantity(); got != tt.want {
+				t.Errorf("OutstandingQuantity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransferIsFullyReceived(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []TransferLine
+		want  bool
+	}{
+		{"nothing received", []TransferLine{{Quantity: 2}, {Quantity: 3}}, false},
+		{"one line outstanding", []TransferLine{{Quantity: 2, ReceivedQuantity: 2}, {Quantity: 3, ReceivedQuantity: 1}}, false},
+		{"all lines received", []TransferLine{{Quantity: 2, ReceivedQuantity: 2}, {Quantity: 3, ReceivedQuantity: 3}}, true},
+		{"over received line", []TransferLine{{Quantity: 2, ReceivedQuantity: 4}, {Quantity: 3, ReceivedQuantity: 3}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer := &Transfer{Lines: tt.lines}
+			if got := transfer.IsFullyReceived(); got != tt.want {
+				t.Errorf("IsFullyReceived() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
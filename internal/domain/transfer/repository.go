@@ -3,6 +3,8 @@ package transfer
 import (
 	"context"
 	"motico-api/internal/domain/transfer/entities"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,7 +12,20 @@ import (
 type Repository interface {
 	Create(ctx context.Context, transfer *entities.Transfer) error
 	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error)
-	List(ctx context.Context, tenantID uuid.UUID, status *entities.TransferStatus, storeID *uuid.UUID, limit, offset int) ([]*entities.Transfer, error)
+	// GetByIDForUpdate es GetByID bloqueando el traspaso hasta el fin de la
+	// transacción en curso.
+	GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error)
+	List(ctx context.Context, tenantID uuid.UUID, status *entities.TransferStatus, storeID *uuid.UUID, params query.Params, limit, offset int) ([]*entities.Transfer, error)
+	// Update persiste la cabecera y sincroniza las líneas con las del traspaso recibido.
 	Update(ctx context.Context, transfer *entities.Transfer) error
+	// Delete marca el traspaso como borrado; las consultas dejan de verlo.
 	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+	// Restore quita la marca de borrado. Devuelve ErrTransferNotFound si el
+	// traspaso no existe o no está borrado.
+	Restore(ctx context.Context, tenantID, id uuid.UUID) error
+	// PurgeDeleted borra definitivamente los traspasos borrados antes de before
+	// y devuelve cuántos borró.
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error)
+	CreateDiscrepancy(ctx context.Context, discrepancy *entities.TransferDiscrepancy) error
+	ListDiscrepancies(ctx context.Context, tenantID, transferID uuid.UUID) ([]*entities.TransferDiscrepancy, error)
 }
@@ -2,11 +2,19 @@ package transfer
 
 import (
 	"context"
+	"errors"
 	"motico-api/config"
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
 	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
 	storedomain "motico-api/internal/domain/store"
+	"motico-api/internal/domain/transaction"
 	"motico-api/internal/domain/transfer/entities"
 	"motico-api/pkg/logger"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,40 +22,70 @@ import (
 type Service struct {
 	repo         Repository
 	stockService *stock.Service
+	storeService *storedomain.Service
 	storeRepo    storedomain.Repository
+	productRepo  productdomain.Repository
+	txManager    transaction.Manager
 	config       *config.Config
 	logger       logger.Logger
 }
 
-func NewService(repo Repository, stockService *stock.Service, storeRepo storedomain.Repository, cfg *config.Config, log logger.Logger) *Service {
+func NewService(repo Repository, stockService *stock.Service, storeService *storedomain.Service, storeRepo storedomain.Repository, productRepo productdomain.Repository, txManager transaction.Manager, cfg *config.Config, log logger.Logger) *Service {
 	return &Service{
 		repo:         repo,
 		stockService: stockService,
+		storeService: storeService,
 		storeRepo:    storeRepo,
+		productRepo:  productRepo,
+		txManager:    txManager,
 		config:       cfg,
 		logger:       log,
 	}
 }
 
+// LineRequest es un producto del traspaso. Los productos con seguimiento por serie
+// indican en Serials cada unidad que se envía o se recibe.
+type LineRequest struct {
+	ProductID uuid.UUID
+	Quantity  int
+	Serials   []string
+}
+
 type CreateRequest struct {
 	TenantID    uuid.UUID
-	ProductID   uuid.UUID
 	FromStoreID uuid.UUID
 	ToStoreID   uuid.UUID
-	Quantity    int
+	Lines       []LineRequest
 	Notes       *string
+	RequestedBy string
 }
 
 type UpdateRequest struct {
 	ID          uuid.UUID
 	TenantID    uuid.UUID
-	ProductID   *uuid.UUID
 	FromStoreID *uuid.UUID
 	ToStoreID   *uuid.UUID
-	Quantity    *int
+	Lines       []LineRequest
 	Notes       *string
 }
 
+// ActionRequest identifica un traspaso y al usuario que ejecuta la transición.
+type ActionRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Actor    string
+}
+
+// ReceiveRequest registra lo que efectivamente llegó a destino. Con Close la
+// recepción se da por terminada aunque falten unidades.
+type ReceiveRequest struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Actor    string
+	Lines    []LineRequest
+	Close    bool
+}
+
 func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Transfer, error) {
 	if err := s.validateCreateRequest(req); err != nil {
 		return nil, err
@@ -57,31 +95,41 @@ func (s *Service) Create(ctx context.Context, req CreateRequest) (*entities.Tran
 		return nil, err
 	}
 
-	stock, err := s.stockService.GetByProductID(ctx, req.TenantID, req.ProductID)
-	if err != nil {
+	if err := s.validateStoresActive(ctx, req.TenantID, req.FromStoreID, req.ToStoreID); err != nil {
 		return nil, err
 	}
 
-	available := stock.AvailableQuantity()
-	if available < req.Quantity {
-		return nil, entities.ErrInsufficientStock
+	if err := s.validateAvailableStock(ctx, req.TenantID, req.Lines); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateDestinationProducts(ctx, req.TenantID, req.ToStoreID, req.Lines); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateSerials(ctx, req.TenantID, req.Lines); err != nil {
+		return nil, err
 	}
 
 	transfer := &entities.Transfer{
 		TenantID:    req.TenantID,
-		ProductID:   req.ProductID,
 		FromStoreID: req.FromStoreID,
 		ToStoreID:   req.ToStoreID,
-		Quantity:    req.Quantity,
 		Status:      entities.TransferStatusPending,
 		Notes:       req.Notes,
+		Lines:       newLines(req.Lines),
 	}
-
-	if err := s.repo.Create(ctx, transfer); err != nil {
-		return nil, err
+	if req.RequestedBy != "" {
+		transfer.RequestedBy = &req.RequestedBy
 	}
 
-	if err := s.stockService.Reserve(ctx, req.TenantID, req.ProductID, req.Quantity); err != nil {
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, transfer); err != nil {
+			return err
+		}
+		return s.reserveLines(ctx, transfer, outstandingLines(transfer))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -92,7 +140,7 @@ func (s *Service) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entitie
 	return s.repo.GetByID(ctx, tenantID, id)
 }
 
-func (s *Service) List(ctx context.Context, tenantID uuid.UUID, status *entities.TransferStatus, storeID *uuid.UUID, limit, offset int) ([]*entities.Transfer, error) {
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, status *entities.TransferStatus, storeID *uuid.UUID, params query.Params, limit, offset int) ([]*entities.Transfer, error) {
 	if limit <= 0 {
 		limit = s.config.Pagination.DefaultLimit
 	}
@@ -103,21 +151,32 @@ func (s *Service) List(ctx context.Context, tenantID uuid.UUID, status *entities
 		offset = 0
 	}
 
-	return s.repo.List(ctx, tenantID, status, storeID, limit, offset)
+	return s.repo.List(ctx, tenantID, status, storeID, params, limit, offset)
 }
 
+// Update modifica un traspaso pendiente. La reserva anterior se libera y se vuelve
+// a tomar con las líneas nuevas en la misma transacción que bloquea el traspaso,
+// así un fallo no deja el stock reservado a medias.
 func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Transfer, error) {
-	transfer, err := s.repo.GetByID(ctx, req.TenantID, req.ID)
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		return s.update(ctx, transfer, req)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if !transfer.CanUpdate() {
-		return nil, entities.ErrTransferNotPending
-	}
+	return transfer, nil
+}
 
-	if req.ProductID != nil {
-		transfer.ProductID = *req.ProductID
+func (s *Service) update(ctx context.Context, transfer *entities.Transfer, req UpdateRequest) error {
+	if !transfer.CanUpdate() {
+		return entities.ErrTransferNotPending
 	}
 
 	if req.FromStoreID != nil {
@@ -128,105 +187,556 @@ func (s *Service) Update(ctx context.Context, req UpdateRequest) (*entities.Tran
 		transfer.ToStoreID = *req.ToStoreID
 	}
 
+	if transfer.FromStoreID == transfer.ToStoreID {
+		return entities.ErrInvalidTransferStores
+	}
+
 	if req.FromStoreID != nil || req.ToStoreID != nil {
-		fromStoreID := transfer.FromStoreID
-		toStoreID := transfer.ToStoreID
-		if req.FromStoreID != nil {
-			fromStoreID = *req.FromStoreID
+		if err := s.validateStoresBelongToTenant(ctx, req.TenantID, transfer.FromStoreID, transfer.ToStoreID); err != nil {
+			return err
 		}
-		if req.ToStoreID != nil {
-			toStoreID = *req.ToStoreID
-		}
-		if err := s.validateStoresBelongToTenant(ctx, req.TenantID, fromStoreID, toStoreID); err != nil {
-			return nil, err
+		if err := s.validateStoresActive(ctx, req.TenantID, transfer.FromStoreID, transfer.ToStoreID); err != nil {
+			return err
 		}
 	}
 
-	if req.Quantity != nil {
-		if *req.Quantity <= 0 {
-			return nil, entities.ErrInvalidQuantity
+	previous := outstandingLines(transfer)
+	if req.Lines != nil {
+		if err := validateLines(req.Lines); err != nil {
+			return err
 		}
-		transfer.Quantity = *req.Quantity
+		if err := s.validateSerials(ctx, req.TenantID, req.Lines); err != nil {
+			return err
+		}
+		transfer.Lines = newLines(req.Lines)
 	}
 
 	if req.Notes != nil {
 		transfer.Notes = req.Notes
 	}
 
-	if transfer.FromStoreID == transfer.ToStoreID {
-		return nil, entities.ErrInvalidTransferStores
+	if req.Lines != nil || req.ToStoreID != nil {
+		if err := s.validateDestinationProducts(ctx, req.TenantID, transfer.ToStoreID, outstandingLines(transfer)); err != nil {
+			return err
+		}
 	}
 
-	if err := s.repo.Update(ctx, transfer); err != nil {
+	if req.Lines != nil {
+		if err := s.releaseLines(ctx, transfer, previous); err != nil {
+			return err
+		}
+		if err := s.validateAvailableStock(ctx, req.TenantID, req.Lines); err != nil {
+			return err
+		}
+		if err := s.reserveLines(ctx, transfer, outstandingLines(transfer)); err != nil {
+			return err
+		}
+	}
+	return s.repo.Update(ctx, transfer)
+}
+
+func (s *Service) Approve(ctx context.Context, req ActionRequest) (*entities.Transfer, error) {
+	return s.transition(ctx, req, entities.TransferStatusApproved)
+}
+
+// Dispatch despacha el traspaso. Las unidades salen de los bins de origen según
+// la lista de preparación y las unidades con número de serie reservadas para el
+// traspaso pasan a estar en tránsito.
+func (s *Service) Dispatch(ctx context.Context, req ActionRequest) (*entities.Transfer, error) {
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		return s.dispatch(ctx, transfer, req.Actor, time.Now())
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return transfer, nil
 }
 
-func (s *Service) Complete(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+// dispatch despacha un traspaso ya bloqueado dentro de la transacción en curso.
+func (s *Service) dispatch(ctx context.Context, transfer *entities.Transfer, actor string, at time.Time) error {
+	if err := transfer.TransitionTo(entities.TransferStatusInTransit, actor, at); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, transfer); err != nil {
+		return err
+	}
+	for _, line := range transfer.Lines {
+		if err := s.stockService.PickFromBins(ctx, transfer.TenantID, line.ProductID, line.Quantity, movementReference(transfer), actor); err != nil {
+			return err
+		}
+		if len(line.Serials) == 0 {
+			continue
+		}
+		if err := s.stockService.DispatchSerials(ctx, transfer.TenantID, line.ProductID, reservationOwner(transfer)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PickList indica de qué bins de la sucursal de origen sacar cada línea del
+// traspaso. Solo tiene sentido antes de despacharlo: al despachar las unidades
+// salen de los bins.
+func (s *Service) PickList(ctx context.Context, tenantID, id uuid.UUID) (*entities.PickList, error) {
 	transfer, err := s.repo.GetByID(ctx, tenantID, id)
 	if err != nil {
 		return nil, err
 	}
+	if transfer.Status != entities.TransferStatusPending && transfer.Status != entities.TransferStatusApproved {
+		return nil, entities.ErrPickListUnavailable
+	}
+
+	pickList := &entities.PickList{
+		TransferID:  transfer.ID,
+		FromStoreID: transfer.FromStoreID,
+		Lines:       make([]*stockentities.PickLine, 0, len(transfer.Lines)),
+	}
+	for _, line := range transfer.Lines {
+		pick, err := s.stockService.PlanPick(ctx, tenantID, line.ProductID, line.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		pickList.Lines = append(pickList.Lines, pick)
+	}
+
+	return pickList, nil
+}
+
+// Receive registra una recepción en destino. Cada recepción consume la reserva de
+// lo recibido y pasa esas unidades a la publicación del mismo artículo en destino
+// con su costo de origen; lo recibido por encima de lo enviado entra a destino
+// como sobrante sin tocar el stock de origen. Al cerrar la recepción los
+// faltantes consumen el resto de la reserva y se dan de baja en origen, y si el
+// traspaso evacuaba una sucursal en cierre, la sucursal se archiva. En productos
+// con seguimiento por serie cada recepción indica qué unidades llegaron.
+func (s *Service) Receive(ctx context.Context, req ReceiveRequest) (*entities.Transfer, error) {
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		return s.receive(ctx, transfer, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
 
-	if transfer.IsCompleted() {
-		return nil, entities.ErrTransferAlreadyCompleted
+// receive registra la recepción sobre un traspaso ya bloqueado dentro de la
+// transacción en curso, así lo enviado y lo sobrante se calculan sobre las
+// cantidades recibidas vigentes.
+func (s *Service) receive(ctx context.Context, transfer *entities.Transfer, req ReceiveRequest) error {
+	if len(req.Lines) == 0 && !req.Close {
+		return entities.ErrTransferHasNoLines
 	}
 
-	if transfer.IsCancelled() {
-		return nil, entities.ErrTransferAlreadyCancelled
+	var moved, surplus []LineRequest
+	for _, received := range req.Lines {
+		line := transfer.Line(received.ProductID)
+		if line == nil {
+			return entities.ErrTransferLineNotFound
+		}
+		if received.Quantity <= 0 {
+			return entities.ErrInvalidQuantity
+		}
+		if err := receiveSerials(line, received); err != nil {
+			return err
+		}
+		// De origen sale a lo sumo lo que se envió; lo que llegó de más entra a
+		// destino como sobrante del traspaso.
+		if shipped := min(received.Quantity, line.OutstandingQuantity()); shipped > 0 {
+			moved = append(moved, LineRequest{ProductID: line.ProductID, Quantity: shipped, Serials: received.Serials})
+		}
+		if extra := received.Quantity - line.OutstandingQuantity(); extra > 0 {
+			surplus = append(surplus, LineRequest{ProductID: line.ProductID, Quantity: extra})
+		}
+		line.ReceivedQuantity += received.Quantity
+	}
+
+	next := entities.TransferStatusPartiallyReceived
+	if req.Close || transfer.IsFullyReceived() {
+		next = entities.TransferStatusReceived
+	}
+	if err := transfer.TransitionTo(next, req.Actor, time.Now()); err != nil {
+		return err
+	}
+
+	var discrepancies []entities.TransferDiscrepancy
+	if next == entities.TransferStatusReceived {
+		discrepancies = transfer.Discrepancies()
 	}
 
-	transfer.Status = entities.TransferStatusCompleted
 	if err := s.repo.Update(ctx, transfer); err != nil {
-		return nil, err
+		return err
+	}
+	if err := s.moveLines(ctx, transfer, moved); err != nil {
+		return err
+	}
+	if err := s.receiveSurplus(ctx, transfer, surplus); err != nil {
+		return err
+	}
+	if err := s.settleDiscrepancies(ctx, transfer, discrepancies, req.Actor); err != nil {
+		return err
+	}
+	if next == entities.TransferStatusReceived {
+		return s.finishClosing(ctx, transfer)
 	}
+	return nil
+}
 
-	if err := s.stockService.Release(ctx, tenantID, transfer.ProductID, transfer.Quantity); err != nil {
+// Complete recibe completo todo lo pendiente y cierra el traspaso. Es la acción
+// del flujo de un solo paso: un traspaso pendiente o aprobado se aprueba y se
+// despacha en la misma transacción antes de recibirse, registrando quién y cuándo
+// en cada paso.
+func (s *Service) Complete(ctx context.Context, req ActionRequest) (*entities.Transfer, error) {
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if transfer.Status == entities.TransferStatusPending {
+			if err := transfer.TransitionTo(entities.TransferStatusApproved, req.Actor, now); err != nil {
+				return err
+			}
+		}
+		if transfer.Status == entities.TransferStatusApproved {
+			if err := s.dispatch(ctx, transfer, req.Actor, now); err != nil {
+				return err
+			}
+		}
+		return s.receive(ctx, transfer, ReceiveRequest{
+			ID:       req.ID,
+			TenantID: req.TenantID,
+			Actor:    req.Actor,
+			Lines:    outstandingLines(transfer),
+			Close:    true,
+		})
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return transfer, nil
 }
 
-func (s *Service) Cancel(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
-	transfer, err := s.repo.GetByID(ctx, tenantID, id)
+// Cancel anula el traspaso y libera lo que tenía reservado. Cancelar el traspaso
+// de evacuación de una sucursal en cierre la devuelve a operar.
+func (s *Service) Cancel(ctx context.Context, req ActionRequest) (*entities.Transfer, error) {
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+
+		released := outstandingLines(transfer)
+		if err := transfer.TransitionTo(entities.TransferStatusCancelled, req.Actor, time.Now()); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, transfer); err != nil {
+			return err
+		}
+		if err := s.releaseLines(ctx, transfer, released); err != nil {
+			return err
+		}
+		return s.reopenStore(ctx, transfer)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if transfer.IsCompleted() {
-		return nil, entities.ErrTransferAlreadyCompleted
-	}
+	return transfer, nil
+}
 
-	if transfer.IsCancelled() {
-		return nil, entities.ErrTransferAlreadyCancelled
+func (s *Service) ListDiscrepancies(ctx context.Context, tenantID, id uuid.UUID) ([]*entities.TransferDiscrepancy, error) {
+	if _, err := s.repo.GetByID(ctx, tenantID, id); err != nil {
+		return nil, err
 	}
 
-	transfer.Status = entities.TransferStatusCancelled
-	if err := s.repo.Update(ctx, transfer); err != nil {
+	return s.repo.ListDiscrepancies(ctx, tenantID, id)
+}
+
+func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		transfer, err := s.repo.GetByIDForUpdate(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if !transfer.CanDelete() {
+			return entities.ErrTransferNotPending
+		}
+
+		if err := s.repo.Delete(ctx, tenantID, id); err != nil {
+			return err
+		}
+		if err := s.releaseLines(ctx, transfer, outstandingLines(transfer)); err != nil {
+			return err
+		}
+		return s.reopenStore(ctx, transfer)
+	})
+}
+
+// Restore recupera un traspaso borrado lógicamente y vuelve a reservar su stock
+// en origen. Las sucursales y los productos tienen que seguir vivos y el stock
+// alcanzar, porque al borrarlo se liberaron las reservas.
+func (s *Service) Restore(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Restore(ctx, tenantID, id); err != nil {
+			return err
+		}
+
+		var err error
+		transfer, err = s.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.validateStoresBelongToTenant(ctx, tenantID, transfer.FromStoreID, transfer.ToStoreID); err != nil {
+			return err
+		}
+		// Un traspaso terminado se recupera como historial aunque sus sucursales
+		// ya estén archivadas; uno abierto vuelve a operar y necesita que sigan activas
+		if !transfer.Status.IsFinal() {
+			if err := s.validateStoresActive(ctx, tenantID, transfer.FromStoreID, transfer.ToStoreID); err != nil {
+				return err
+			}
+		}
+
+		lines := outstandingLines(transfer)
+		if err := s.validateDestinationProducts(ctx, tenantID, transfer.ToStoreID, lines); err != nil {
+			if errors.Is(err, productentities.ErrProductNotFound) {
+				return entities.ErrTransferProductNotFound
+			}
+			return err
+		}
+		return s.reserveLines(ctx, transfer, lines)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	if err := s.stockService.Release(ctx, tenantID, transfer.ProductID, transfer.Quantity); err != nil {
+	return transfer, nil
+}
+
+// PurgeDeleted borra definitivamente los traspasos borrados antes de before.
+func (s *Service) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return s.repo.PurgeDeleted(ctx, before, batchSize)
+}
+
+func (s *Service) transition(ctx context.Context, req ActionRequest, next entities.TransferStatus) (*entities.Transfer, error) {
+	var transfer *entities.Transfer
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = s.repo.GetByIDForUpdate(ctx, req.TenantID, req.ID)
+		if err != nil {
+			return err
+		}
+		if err := transfer.TransitionTo(next, req.Actor, time.Now()); err != nil {
+			return err
+		}
+		return s.repo.Update(ctx, transfer)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return transfer, nil
 }
 
-func (s *Service) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
-	transfer, err := s.repo.GetByID(ctx, tenantID, id)
+// settleDiscrepancies deja registro de cada diferencia y da de baja en origen las
+// unidades que no llegaron, consumiendo lo que quedaba de la reserva. Las
+// sobrantes ya entraron a destino con receiveSurplus.
+func (s *Service) settleDiscrepancies(ctx context.Context, transfer *entities.Transfer, discrepancies []entities.TransferDiscrepancy, actor string) error {
+	for i := range discrepancies {
+		discrepancy := &discrepancies[i]
+		if actor != "" {
+			discrepancy.RecordedBy = &actor
+		}
+
+		s.logger.Warn("Transfer receipt discrepancy",
+			logger.String("transfer_id", discrepancy.TransferID.String()),
+			logger.String("product_id", discrepancy.ProductID.String()),
+			logger.Int("expected_quantity", discrepancy.ExpectedQuantity),
+			logger.Int("received_quantity", discrepancy.ReceivedQuantity),
+			logger.Int("variance", discrepancy.Variance),
+		)
+
+		if discrepancy.IsShort() {
+			owner := reservationOwner(transfer)
+			if _, err := s.stockService.Adjust(ctx, stock.AdjustRequest{
+				TenantID:  discrepancy.TenantID,
+				ProductID: discrepancy.ProductID,
+				Amount:    discrepancy.Variance,
+				Reason:    stockentities.MovementReasonTransferLoss,
+				Reference: movementReference(transfer),
+				Serials:   transfer.Line(discrepancy.ProductID).OutstandingSerials(),
+				Owner:     &owner,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := s.repo.CreateDiscrepancy(ctx, discrepancy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) reserveLines(ctx context.Context, transfer *entities.Transfer, lines []LineRequest) error {
+	for _, line := range lines {
+		_, err := s.stockService.Reserve(ctx, stock.ReserveRequest{
+			TenantID:  transfer.TenantID,
+			ProductID: line.ProductID,
+			Owner:     reservationOwner(transfer),
+			Quantity:  line.Quantity,
+			Serials:   line.Serials,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, stockentities.ErrInsufficientStock):
+				return entities.ErrInsufficientStock
+			case errors.Is(err, stockentities.ErrSerialNotFound), errors.Is(err, stockentities.ErrSerialNotAvailable):
+				return entities.ErrSerialNotAvailable
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) releaseLines(ctx context.Context, transfer *entities.Transfer, lines []LineRequest) error {
+	for _, line := range lines {
+		err := s.stockService.Release(ctx, stock.ReleaseRequest{
+			TenantID:  transfer.TenantID,
+			ProductID: line.ProductID,
+			Owner:     reservationOwner(transfer),
+			Quantity:  line.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveLines pasa lo recibido de cada publicación de origen a la del mismo
+// artículo en la sucursal de destino, consumiendo la reserva del traspaso.
+func (s *Service) moveLines(ctx context.Context, transfer *entities.Transfer, lines []LineRequest) error {
+	owner := reservationOwner(transfer)
+	for _, line := range lines {
+		target, err := s.destinationProduct(ctx, transfer.TenantID, transfer.ToStoreID, line.ProductID)
+		if err != nil {
+			return err
+		}
+		err = s.stockService.Move(ctx, stock.MoveRequest{
+			TenantID:      transfer.TenantID,
+			FromProductID: line.ProductID,
+			ToProductID:   target.ID,
+			Quantity:      line.Quantity,
+			Reference:     movementReference(transfer),
+			Serials:       line.Serials,
+			Owner:         &owner,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// receiveSurplus da de alta en la publicación de destino lo que llegó por encima
+// de lo enviado, con la referencia del traspaso y al costo promedio de destino.
+// En productos con seguimiento por lote el sobrante no tiene lote conocido y se
+// rechaza.
+func (s *Service) receiveSurplus(ctx context.Context, transfer *entities.Transfer, lines []LineRequest) error {
+	for _, line := range lines {
+		target, err := s.destinationProduct(ctx, transfer.TenantID, transfer.ToStoreID, line.ProductID)
+		if err != nil {
+			return err
+		}
+		_, err = s.stockService.Adjust(ctx, stock.AdjustRequest{
+			TenantID:  transfer.TenantID,
+			ProductID: target.ID,
+			Amount:    line.Quantity,
+			Reason:    stockentities.MovementReasonTransferSurplus,
+			Reference: movementReference(transfer),
+		})
+		if err != nil {
+			if errors.Is(err, stockentities.ErrLotRequired) {
+				return entities.ErrLotSurplus
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// destinationProduct devuelve la publicación del mismo artículo de catálogo en la
+// sucursal de destino.
+func (s *Service) destinationProduct(ctx context.Context, tenantID, toStoreID, productID uuid.UUID) (*productentities.Product, error) {
+	source, err := s.productRepo.GetByID(ctx, tenantID, productID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !transfer.CanDelete() {
-		return entities.ErrTransferNotPending
+	listings, err := s.productRepo.List(ctx, tenantID, productdomain.ListFilter{
+		StoreID:         &toStoreID,
+		CatalogItemID:   &source.CatalogItemID,
+		IncludeVariants: true,
+	}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(listings) == 0 {
+		return nil, entities.ErrDestinationProductNotFound
 	}
 
-	return s.repo.Delete(ctx, tenantID, id)
+	return listings[0], nil
+}
+
+// validateDestinationProducts comprueba que cada producto de las líneas siga
+// activo y tenga una publicación activa en la sucursal de destino.
+func (s *Service) validateDestinationProducts(ctx context.Context, tenantID, toStoreID uuid.UUID, lines []LineRequest) error {
+	for _, line := range lines {
+		source, err := s.productRepo.GetByID(ctx, tenantID, line.ProductID)
+		if err != nil {
+			return err
+		}
+		target, err := s.destinationProduct(ctx, tenantID, toStoreID, line.ProductID)
+		if err != nil {
+			return err
+		}
+		if source.IsArchived() || target.IsArchived() {
+			return entities.ErrTransferProductArchived
+		}
+	}
+	return nil
+}
+
+func movementReference(transfer *entities.Transfer) *stockentities.MovementReference {
+	return &stockentities.MovementReference{Type: "transfer", ID: transfer.ID}
+}
+
+func reservationOwner(transfer *entities.Transfer) stockentities.ReservationOwner {
+	return stockentities.ReservationOwner{Type: stockentities.ReservationOwnerTransfer, ID: transfer.ID}
 }
 
 func (s *Service) validateCreateRequest(req CreateRequest) error {
@@ -234,10 +744,19 @@ func (s *Service) validateCreateRequest(req CreateRequest) error {
 		return entities.ErrInvalidTransferStores
 	}
 
-	if req.Quantity <= 0 {
-		return entities.ErrInvalidQuantity
-	}
+	return validateLines(req.Lines)
+}
 
+func (s *Service) validateAvailableStock(ctx context.Context, tenantID uuid.UUID, lines []LineRequest) error {
+	for _, line := range lines {
+		stock, err := s.stockService.GetByProductID(ctx, tenantID, line.ProductID)
+		if err != nil {
+			return err
+		}
+		if stock.AvailableQuantity() < line.Quantity {
+			return entities.ErrInsufficientStock
+		}
+	}
 	return nil
 }
 
@@ -260,3 +779,103 @@ func (s *Service) validateStoresBelongToTenant(ctx context.Context, tenantID, fr
 
 	return nil
 }
+
+// validateStoresActive rechaza traspasos desde o hacia sucursales en cierre o archivadas.
+func (s *Service) validateStoresActive(ctx context.Context, tenantID, fromStoreID, toStoreID uuid.UUID) error {
+	for _, storeID := range []uuid.UUID{fromStoreID, toStoreID} {
+		store, err := s.storeRepo.GetByID(ctx, tenantID, storeID)
+		if err != nil {
+			return entities.ErrInvalidTransferStores
+		}
+		if !store.IsOpen() {
+			return entities.ErrTransferStoreArchived
+		}
+	}
+	return nil
+}
+
+func validateLines(lines []LineRequest) error {
+	if len(lines) == 0 {
+		return entities.ErrTransferHasNoLines
+	}
+
+	seen := make(map[uuid.UUID]bool, len(lines))
+	serials := make(map[string]bool)
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return entities.ErrInvalidQuantity
+		}
+		if seen[line.ProductID] {
+			return entities.ErrDuplicateTransferProduct
+		}
+		seen[line.ProductID] = true
+
+		if len(line.Serials) > 0 && len(line.Serials) != line.Quantity {
+			return entities.ErrSerialsRequired
+		}
+		for _, serial := range line.Serials {
+			if serials[serial] {
+				return entities.ErrDuplicateSerial
+			}
+			serials[serial] = true
+		}
+	}
+
+	return nil
+}
+
+// validateSerials exige números de serie en los productos con seguimiento por
+// serie y los rechaza en el resto.
+func (s *Service) validateSerials(ctx context.Context, tenantID uuid.UUID, lines []LineRequest) error {
+	for _, line := range lines {
+		product, err := s.productRepo.GetByID(ctx, tenantID, line.ProductID)
+		if err != nil {
+			return err
+		}
+		serialTracked := product.Tracking == catalogentities.TrackingSerial
+		if serialTracked && len(line.Serials) == 0 {
+			return entities.ErrSerialsRequired
+		}
+		if !serialTracked && len(line.Serials) > 0 {
+			return entities.ErrSerialsNotAllowed
+		}
+	}
+	return nil
+}
+
+// receiveSerials controla que una recepción de un producto con seguimiento por
+// serie indique una unidad enviada y pendiente por cada unidad recibida.
+func receiveSerials(line *entities.TransferLine, received LineRequest) error {
+	if len(line.Serials) == 0 {
+		if len(received.Serials) > 0 {
+			return entities.ErrSerialsNotAllowed
+		}
+		return nil
+	}
+	if len(received.Serials) != received.Quantity {
+		return entities.ErrSerialsRequired
+	}
+	return line.ReceiveSerials(received.Serials)
+}
+
+func newLines(lines []LineRequest) []entities.TransferLine {
+	result := make([]entities.TransferLine, len(lines))
+	for i, line := range lines {
+		result[i] = entities.TransferLine{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			Serials:   line.Serials,
+		}
+	}
+	return result
+}
+
+func outstandingLines(transfer *entities.Transfer) []LineRequest {
+	var lines []LineRequest
+	for _, line := range transfer.Lines {
+		if outstanding := line.OutstandingQuantity(); outstanding > 0 {
+			lines = append(lines, LineRequest{ProductID: line.ProductID, Quantity: outstanding, Serials: line.OutstandingSerials()})
+		}
+	}
+	return lines
+}
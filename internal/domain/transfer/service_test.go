@@ -0,0 +1,534 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"motico-api/config"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	"motico-api/internal/domain/stock/stocktest"
+	storedomain "motico-api/internal/domain/store"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transaction/transactiontest"
+	"motico-api/internal/domain/transfer/entities"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// transferRepo guarda los traspasos en memoria y devuelve copias, como la base.
+type transferRepo struct {
+	Repository
+	transfers     map[uuid.UUID]*entities.Transfer
+	discrepancies []*entities.TransferDiscrepancy
+}
+
+func (r *transferRepo) Create(ctx context.Context, transfer *entities.Transfer) error {
+	transfer.ID = uuid.New()
+	for i := range transfer.Lines {
+		transfer.Lines[i].ID = uuid.New()
+		transfer.Lines[i].TransferID = transfer.ID
+	}
+	r.transfers[transfer.ID] = copyTransfer(transfer)
+	return nil
+}
+
+func (r *transferRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	transfer, ok := r.transfers[id]
+	if !ok || transfer.TenantID != tenantID || transfer.DeletedAt != nil {
+		return nil, entities.ErrTransferNotFound
+	}
+	return copyTransfer(transfer), nil
+}
+
+func (r *transferRepo) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	if !transactiontest.InTransaction(ctx) {
+		return nil, errors.New("transfer locked outside a transaction")
+	}
+	return r.GetByID(ctx, tenantID, id)
+}
+
+func (r *transferRepo) Update(ctx context.Context, transfer *entities.Transfer) error {
+	r.transfers[transfer.ID] = copyTransfer(transfer)
+	return nil
+}
+
+func (r *transferRepo) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	transfer, ok := r.transfers[id]
+	if !ok || transfer.TenantID != tenantID {
+		return entities.ErrTransferNotFound
+	}
+	now := time.Now()
+	transfer.DeletedAt = &now
+	return nil
+}
+
+func (r *transferRepo) CreateDiscrepancy(ctx context.Context, discrepancy *entities.TransferDiscrepancy) error {
+	r.discrepancies = append(r.discrepancies, discrepancy)
+	return nil
+}
+
+func copyTransfer(transfer *entities.Transfer) *entities.Transfer {
+	copied := *transfer
+	copied.Lines = make([]entities.TransferLine, len(transfer.Lines))
+	for i, line := range transfer.Lines {
+		line.Serials = append([]string(nil), line.Serials...)
+		line.ReceivedSerials = append([]string(nil), line.ReceivedSerials...)
+		copied.Lines[i] = line
+	}
+	return &copied
+}
+
+// storeRepo responde por el stock y los traspasos de cada sucursal con los
+// repositorios en memoria del fixture.
+type storeRepo struct {
+	storedomain.Repository
+	stores    map[uuid.UUID]*storeentities.Store
+	stock     *stocktest.Repository
+	transfers *transferRepo
+}
+
+func (r *storeRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*storeentities.Store, error) {
+	store, ok := r.stores[id]
+	if !ok || store.TenantID != tenantID {
+		return nil, storeentities.ErrStoreNotFound
+	}
+	found := *store
+	return &found, nil
+}
+
+func (r *storeRepo) Update(ctx context.Context, store *storeentities.Store) error {
+	updated := *store
+	r.stores[store.ID] = &updated
+	return nil
+}
+
+func (r *storeRepo) HasStock(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error) {
+	stocks, err := r.stock.ListByStore(ctx, tenantID, storeID)
+	return len(stocks) > 0, err
+}
+
+func (r *storeRepo) HasOpenTransfers(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error) {
+	for _, transfer := range r.transfers.transfers {
+		if transfer.TenantID == tenantID && transfer.DeletedAt == nil && !transfer.Status.IsFinal() &&
+			(transfer.FromStoreID == storeID || transfer.ToStoreID == storeID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *storeRepo) ArchiveProducts(ctx context.Context, tenantID, storeID uuid.UUID) error {
+	r.stock.Archived[storeID] = true
+	return nil
+}
+
+type productRepo struct {
+	productdomain.Repository
+	products map[uuid.UUID]*productentities.Product
+}
+
+func (r *productRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*productentities.Product, error) {
+	product, ok := r.products[id]
+	if !ok || product.TenantID != tenantID {
+		return nil, productentities.ErrProductNotFound
+	}
+	found := *product
+	return &found, nil
+}
+
+func (r *productRepo) List(ctx context.Context, tenantID uuid.UUID, filter productdomain.ListFilter, limit, offset int) ([]*productentities.Product, error) {
+	var products []*productentities.Product
+	for _, product := range r.products {
+		if product.TenantID != tenantID ||
+			(filter.StoreID != nil && product.StoreID != *filter.StoreID) ||
+			(filter.CatalogItemID != nil && product.CatalogItemID != *filter.CatalogItemID) {
+			continue
+		}
+		found := *product
+		products = append(products, &found)
+	}
+	return products, nil
+}
+
+// transferFixture arma dos sucursales con el mismo artículo publicado en ambas:
+// source en origen, con 10 unidades a 100 cada una, y target en destino.
+type transferFixture struct {
+	ctx       context.Context
+	tenantID  uuid.UUID
+	fromStore uuid.UUID
+	toStore   uuid.UUID
+	source    uuid.UUID
+	target    uuid.UUID
+	stock     *stocktest.Repository
+	transfers *transferRepo
+	stores    *storeRepo
+	stockSvc  *stock.Service
+	service   *Service
+}
+
+func newTransferFixture(t *testing.T) *transferFixture {
+	t.Helper()
+
+	f := &transferFixture{
+		ctx:       context.Background(),
+		tenantID:  uuid.New(),
+		fromStore: uuid.New(),
+		toStore:   uuid.New(),
+		source:    uuid.New(),
+		target:    uuid.New(),
+		stock:     stocktest.NewRepository(),
+		transfers: &transferRepo{transfers: make(map[uuid.UUID]*entities.Transfer)},
+	}
+
+	f.stores = &storeRepo{
+		stores: map[uuid.UUID]*storeentities.Store{
+			f.fromStore: {ID: f.fromStore, TenantID: f.tenantID, Status: storeentities.StoreStatusActive},
+			f.toStore:   {ID: f.toStore, TenantID: f.tenantID, Status: storeentities.StoreStatusActive},
+		},
+		stock:     f.stock,
+		transfers: f.transfers,
+	}
+	catalogItemID := uuid.New()
+	products := &productRepo{products: map[uuid.UUID]*productentities.Product{
+		f.source: {ID: f.source, TenantID: f.tenantID, StoreID: f.fromStore, CatalogItemID: catalogItemID, Status: productentities.ProductStatusActive},
+		f.target: {ID: f.target, TenantID: f.tenantID, StoreID: f.toStore, CatalogItemID: catalogItemID, Status: productentities.ProductStatusActive},
+	}}
+	f.stock.Stores[f.source] = f.fromStore
+	f.stock.Stores[f.target] = f.toStore
+
+	cfg := &config.Config{}
+	f.stockSvc = stock.NewService(f.stock, transactiontest.Manager{}, cfg, logger.NewNop())
+	storeSvc := storedomain.NewService(f.stores, transactiontest.Manager{}, cfg, logger.NewNop())
+	f.service = NewService(f.transfers, f.stockSvc, storeSvc, f.stores, products, transactiontest.Manager{}, cfg, logger.NewNop())
+
+	unitCost := money.FromInt(100)
+	if _, err := f.stockSvc.Adjust(f.ctx, stock.AdjustRequest{TenantID: f.tenantID, ProductID: f.source, Amount: 10, UnitCost: &unitCost}); err != nil {
+		t.Fatalf("seed stock: %v", err)
+	}
+	return f
+}
+
+// dispatch crea, aprueba y despacha un traspaso de quantity unidades de source.
+func (f *transferFixture) dispatch(t *testing.T, quantity int) *entities.Transfer {
+	t.Helper()
+
+	transfer, err := f.service.Create(f.ctx, CreateRequest{
+		TenantID:    f.tenantID,
+		FromStoreID: f.fromStore,
+		ToStoreID:   f.toStore,
+		Lines:       []LineRequest{{ProductID: f.source, Quantity: quantity}},
+	})
+	if err != nil {
+		t.Fatalf("create transfer: %v", err)
+	}
+	action := ActionRequest{ID: transfer.ID, TenantID: f.tenantID, Actor: "tester"}
+	if _, err := f.service.Approve(f.ctx, action); err != nil {
+		t.Fatalf("approve transfer: %v", err)
+	}
+	if transfer, err = f.service.Dispatch(f.ctx, action); err != nil {
+		t.Fatalf("dispatch transfer: %v", err)
+	}
+	return transfer
+}
+
+func (f *transferFixture) reasons(productID uuid.UUID) []stockentities.MovementReason {
+	var reasons []stockentities.MovementReason
+	for _, movement := range f.stock.Movements(f.tenantID, productID) {
+		reasons = append(reasons, movement.Reason)
+	}
+	return reasons
+}
+
+func TestReceiveShortWritesOffMissingUnitsAtOrigin(t *testing.T) {
+	f := newTransferFixture(t)
+	transfer := f.dispatch(t, 4)
+
+	received, err := f.service.Receive(f.ctx, ReceiveRequest{
+		ID:       transfer.ID,
+		TenantID: f.tenantID,
+		Actor:    "tester",
+		Lines:    []LineRequest{{ProductID: f.source, Quantity: 3}},
+		Close:    true,
+	})
+	if err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+	if received.Status != entities.TransferStatusReceived {
+		t.Errorf("status: got %s, want %s", received.Status, entities.TransferStatusReceived)
+	}
+
+	source := f.stock.Stock(f.tenantID, f.source)
+	if source.Quantity != 6 || source.ReservedQuantity != 0 {
+		t.Errorf("origin stock: got quantity %d reserved %d, want 6 and 0", source.Quantity, source.ReservedQuantity)
+	}
+	if source.InventoryValue != money.FromInt(600) {
+		t.Errorf("origin value: got %s, want 600", source.InventoryValue)
+	}
+	target := f.stock.Stock(f.tenantID, f.target)
+	if target.Quantity != 3 || target.InventoryValue != money.FromInt(300) {
+		t.Errorf("destination stock: got quantity %d value %s, want 3 and 300", target.Quantity, target.InventoryValue)
+	}
+
+	want := []stockentities.MovementReason{stockentities.MovementReasonReceipt, stockentities.MovementReasonTransferOut, stockentities.MovementReasonTransferLoss}
+	if got := f.reasons(f.source); !reflect.DeepEqual(got, want) {
+		t.Errorf("origin movements: got %v, want %v", got, want)
+	}
+
+	if len(f.transfers.discrepancies) != 1 || f.transfers.discrepancies[0].Variance != -1 {
+		t.Fatalf("discrepancies: got %+v, want one with variance -1", f.transfers.discrepancies)
+	}
+}
+
+func TestReceiveOverBooksSurplusAtDestination(t *testing.T) {
+	f := newTransferFixture(t)
+	transfer := f.dispatch(t, 4)
+
+	// El resto del stock de origen queda reservado para otro dueño: el sobrante
+	// no puede salir de ahí.
+	order := stockentities.ReservationOwner{Type: stockentities.ReservationOwnerSalesOrder, ID: uuid.New()}
+	if _, err := f.stockSvc.Reserve(f.ctx, stock.ReserveRequest{TenantID: f.tenantID, ProductID: f.source, Owner: order, Quantity: 6}); err != nil {
+		t.Fatalf("reserve remaining stock: %v", err)
+	}
+
+	received, err := f.service.Receive(f.ctx, ReceiveRequest{
+		ID:       transfer.ID,
+		TenantID: f.tenantID,
+		Actor:    "tester",
+		Lines:    []LineRequest{{ProductID: f.source, Quantity: 6}},
+	})
+	if err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+	if received.Status != entities.TransferStatusReceived {
+		t.Errorf("status: got %s, want %s", received.Status, entities.TransferStatusReceived)
+	}
+
+	source := f.stock.Stock(f.tenantID, f.source)
+	if source.Quantity != 6 || source.ReservedQuantity != 6 {
+		t.Errorf("origin stock: got quantity %d reserved %d, want 6 and 6", source.Quantity, source.ReservedQuantity)
+	}
+	if _, ok := f.stock.Reservation(f.tenantID, f.source, reservationOwner(transfer)); ok {
+		t.Error("transfer reservation should be fully released")
+	}
+	if got, want := f.reasons(f.source), []stockentities.MovementReason{stockentities.MovementReasonReceipt, stockentities.MovementReasonTransferOut}; !reflect.DeepEqual(got, want) {
+		t.Errorf("origin movements: got %v, want %v", got, want)
+	}
+
+	target := f.stock.Stock(f.tenantID, f.target)
+	if target.Quantity != 6 {
+		t.Errorf("destination quantity: got %d, want 6", target.Quantity)
+	}
+	movements := f.stock.Movements(f.tenantID, f.target)
+	if len(movements) != 2 {
+		t.Fatalf("destination movements: got %d, want 2", len(movements))
+	}
+	surplus := movements[1]
+	if surplus.Reason != stockentities.MovementReasonTransferSurplus || surplus.Quantity != 2 {
+		t.Errorf("surplus movement: got %s x%d, want %s x2", surplus.Reason, surplus.Quantity, stockentities.MovementReasonTransferSurplus)
+	}
+	if surplus.Reference == nil || *surplus.Reference != *movementReference(transfer) {
+		t.Errorf("surplus reference: got %v, want the transfer", surplus.Reference)
+	}
+
+	if len(f.transfers.discrepancies) != 1 || f.transfers.discrepancies[0].Variance != 2 {
+		t.Fatalf("discrepancies: got %+v, want one with variance 2", f.transfers.discrepancies)
+	}
+}
+
+func TestCloseStore(t *testing.T) {
+	// closeSource cierra la sucursal de origen, que evacúa sus 10 unidades a destino.
+	closeSource := func(t *testing.T, f *transferFixture) *entities.Transfer {
+		t.Helper()
+		store, evacuation, err := f.service.CloseStore(f.ctx, CloseStoreRequest{TenantID: f.tenantID, StoreID: f.fromStore, ToStoreID: f.toStore})
+		if err != nil {
+			t.Fatalf("close store: %v", err)
+		}
+		if evacuation == nil || len(evacuation.Lines) != 1 || evacuation.Lines[0].Quantity != 10 {
+			t.Fatalf("evacuation: got %+v, want one line with the 10 units", evacuation)
+		}
+		if !store.IsClosing() || store.ClosingTransferID == nil || *store.ClosingTransferID != evacuation.ID {
+			t.Fatalf("store: got status %s transfer %v, want closing with the evacuation", store.Status, store.ClosingTransferID)
+		}
+		return evacuation
+	}
+
+	t.Run("archives the store when the evacuation is received", func(t *testing.T) {
+		f := newTransferFixture(t)
+		evacuation := closeSource(t, f)
+
+		_, err := f.service.Create(f.ctx, CreateRequest{
+			TenantID:    f.tenantID,
+			FromStoreID: f.toStore,
+			ToStoreID:   f.fromStore,
+			Lines:       []LineRequest{{ProductID: f.target, Quantity: 1}},
+		})
+		if !errors.Is(err, entities.ErrTransferStoreArchived) {
+			t.Errorf("transfer into a closing store: got %v, want ErrTransferStoreArchived", err)
+		}
+		if _, _, err := f.service.CloseStore(f.ctx, CloseStoreRequest{TenantID: f.tenantID, StoreID: f.fromStore, ToStoreID: f.toStore}); !errors.Is(err, storeentities.ErrStoreClosing) {
+			t.Errorf("close twice: got %v, want ErrStoreClosing", err)
+		}
+
+		action := ActionRequest{ID: evacuation.ID, TenantID: f.tenantID, Actor: "tester"}
+		if _, err := f.service.Approve(f.ctx, action); err != nil {
+			t.Fatalf("approve: %v", err)
+		}
+		if _, err := f.service.Dispatch(f.ctx, action); err != nil {
+			t.Fatalf("dispatch: %v", err)
+		}
+		if store := f.stores.stores[f.fromStore]; !store.IsClosing() {
+			t.Fatalf("store after dispatch: got %s, want closing", store.Status)
+		}
+		if _, err := f.service.Complete(f.ctx, action); err != nil {
+			t.Fatalf("complete: %v", err)
+		}
+
+		store := f.stores.stores[f.fromStore]
+		if !store.IsArchived() || store.ClosingTransferID != nil {
+			t.Errorf("store after receipt: got status %s transfer %v, want archived", store.Status, store.ClosingTransferID)
+		}
+		if !f.stock.Archived[f.fromStore] {
+			t.Error("store products should be archived with the store")
+		}
+		if target := f.stock.Stock(f.tenantID, f.target); target.Quantity != 10 {
+			t.Errorf("destination quantity: got %d, want 10", target.Quantity)
+		}
+	})
+
+	t.Run("reopens the store when the evacuation is cancelled", func(t *testing.T) {
+		f := newTransferFixture(t)
+		evacuation := closeSource(t, f)
+
+		if _, err := f.service.Cancel(f.ctx, ActionRequest{ID: evacuation.ID, TenantID: f.tenantID, Actor: "tester"}); err != nil {
+			t.Fatalf("cancel: %v", err)
+		}
+		if store := f.stores.stores[f.fromStore]; !store.IsOpen() || store.ClosingTransferID != nil {
+			t.Errorf("store after cancel: got status %s transfer %v, want active", store.Status, store.ClosingTransferID)
+		}
+		if source := f.stock.Stock(f.tenantID, f.source); source.ReservedQuantity != 0 {
+			t.Errorf("origin reserved: got %d, want 0", source.ReservedQuantity)
+		}
+	})
+
+	t.Run("archives a store without stock right away", func(t *testing.T) {
+		f := newTransferFixture(t)
+
+		store, evacuation, err := f.service.CloseStore(f.ctx, CloseStoreRequest{TenantID: f.tenantID, StoreID: f.toStore, ToStoreID: f.fromStore})
+		if err != nil {
+			t.Fatalf("close store: %v", err)
+		}
+		if evacuation != nil {
+			t.Errorf("evacuation: got %+v, want none", evacuation)
+		}
+		if !store.IsArchived() || !f.stores.stores[f.toStore].IsArchived() {
+			t.Errorf("store: got status %s, want archived", store.Status)
+		}
+		if len(f.transfers.transfers) != 0 {
+			t.Errorf("transfers: got %d, want none", len(f.transfers.transfers))
+		}
+	})
+}
+
+func TestOutstandingLines(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name  string
+		lines []entities.TransferLine
+		want  []LineRequest
+	}{
+		{
+			name:  "nothing received",
+			lines: []entities.TransferLine{{ProductID: a, Quantity: 2}, {ProductID: b, Quantity: 3}},
+			want:  []LineRequest{{ProductID: a, Quantity: 2}, {ProductID: b, Quantity: 3}},
+		},
+		{
+			name:  "skips received lines",
+			lines: []entities.TransferLine{{ProductID: a, Quantity: 2, ReceivedQuantity: 2}, {ProductID: b, Quantity: 3, ReceivedQuantity: 1}},
+			want:  []LineRequest{{ProductID: b, Quantity: 2}},
+		},
+		{
+			name: "keeps only outstanding serials",
+			lines: []entities.TransferLine{{
+				ProductID: a, Quantity: 3, ReceivedQuantity: 1,
+				Serials: []string{"E1", "E2", "E3"}, ReceivedSerials: []string{"E2"},
+			}},
+			want: []LineRequest{{ProductID: a, Quantity: 2, Serials: []string{"E1", "E3"}}},
+		},
+		{
+			name:  "over received",
+			lines: []entities.TransferLine{{ProductID: a, Quantity: 2, ReceivedQuantity: 3}},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := outstandingLines(&entities.Transfer{Lines: tt.lines})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("outstandingLines() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateReleasesBeforeReservingNewLines(t *testing.T) {
+	f := newTransferFixture(t)
+	transfer, err := f.service.Create(f.ctx, CreateRequest{
+		TenantID:    f.tenantID,
+		FromStoreID: f.fromStore,
+		ToStoreID:   f.toStore,
+		Lines:       []LineRequest{{ProductID: f.source, Quantity: 4}},
+	})
+	if err != nil {
+		t.Fatalf("create transfer: %v", err)
+	}
+
+	// 7 unidades solo alcanzan si primero se liberan las 4 reservadas
+	if _, err := f.service.Update(f.ctx, UpdateRequest{
+		ID:       transfer.ID,
+		TenantID: f.tenantID,
+		Lines:    []LineRequest{{ProductID: f.source, Quantity: 7}},
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	reservation, ok := f.stock.Reservation(f.tenantID, f.source, reservationOwner(transfer))
+	if !ok || reservation.Quantity != 7 {
+		t.Errorf("reservation: got %+v, want 7 units", reservation)
+	}
+	if source := f.stock.Stock(f.tenantID, f.source); source.ReservedQuantity != 7 {
+		t.Errorf("origin reserved: got %d, want 7", source.ReservedQuantity)
+	}
+}
+
+func TestDeleteReleasesReservation(t *testing.T) {
+	f := newTransferFixture(t)
+	transfer, err := f.service.Create(f.ctx, CreateRequest{
+		TenantID:    f.tenantID,
+		FromStoreID: f.fromStore,
+		ToStoreID:   f.toStore,
+		Lines:       []LineRequest{{ProductID: f.source, Quantity: 4}},
+	})
+	if err != nil {
+		t.Fatalf("create transfer: %v", err)
+	}
+
+	if err := f.service.Delete(f.ctx, f.tenantID, transfer.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	if _, ok := f.stock.Reservation(f.tenantID, f.source, reservationOwner(transfer)); ok {
+		t.Error("transfer reservation should be released")
+	}
+	if source := f.stock.Stock(f.tenantID, f.source); source.ReservedQuantity != 0 || source.Quantity != 10 {
+		t.Errorf("origin stock: got quantity %d reserved %d, want 10 and 0", source.Quantity, source.ReservedQuantity)
+	}
+}
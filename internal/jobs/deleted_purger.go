@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Purger borra definitivamente lo borrado lógicamente antes de before.
+type Purger interface {
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error)
+}
+
+// DeletedPurger purga lo que lleva borrado más que retention. Los purgers corren
+// en el orden recibido, así que van primero los que referencian a los demás
+// (traspasos, productos, categorías y al final sucursales). Lo que sigue
+// referenciado se deja para una corrida posterior.
+func DeletedPurger(retention, interval time.Duration, batchSize int, purgers ...Purger) Job {
+	return Job{
+		Name:     "deleted_purger",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			before := time.Now().Add(-retention)
+			var errs []error
+			for _, purger := range purgers {
+				if _, err := purger.PurgeDeleted(ctx, before, batchSize); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+}
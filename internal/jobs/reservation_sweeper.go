@@ -0,0 +1,19 @@
+package jobs
+
+import (
+	"context"
+	"motico-api/internal/domain/stock"
+	"time"
+)
+
+// ReservationSweeper libera las reservas de stock vencidas.
+func ReservationSweeper(stockService *stock.Service, interval time.Duration, batchSize int) Job {
+	return Job{
+		Name:     "reservation_sweeper",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			_, err := stockService.ReleaseExpired(ctx, batchSize)
+			return err
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"motico-api/pkg/logger"
+	"sync"
+	"time"
+)
+
+// Job es una tarea de mantenimiento que se ejecuta cada Interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler corre los jobs registrados en segundo plano hasta que se cancela el ctx.
+type Scheduler struct {
+	jobs   []Job
+	logger logger.Logger
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(log logger.Logger) *Scheduler {
+	return &Scheduler{logger: log}
+}
+
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.logger.Warn("Job disabled, interval must be positive", logger.String("job", job.Name))
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Wait bloquea hasta que terminan todos los jobs tras cancelar el ctx de Start.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.logger.Info("Job started", logger.String("job", job.Name), logger.String("interval", job.Interval.String()))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("Job failed", logger.String("job", job.Name), logger.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"motico-api/internal/domain/attachment"
+	"motico-api/internal/domain/attachment/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type attachmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAttachmentRepository(pool *pgxpool.Pool) attachment.Repository {
+	return &attachmentRepository{pool: pool}
+}
+
+const attachmentColumns = `id, tenant_id, product_id, kind, file_name, content_type, size_bytes, storage_key, url,
+			thumbnail_key, thumbnail_url, position, created_by, created_at`
+
+func (r *attachmentRepository) Create(ctx context.Context, attachment *entities.Attachment) error {
+	query := `
+		INSERT INTO product_attachments (id, tenant_id, product_id, kind, file_name, content_type, size_bytes,
+			storage_key, url, thumbnail_key, thumbnail_url, position, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+			(SELECT COALESCE(MAX(position) + 1, 0) FROM product_attachments WHERE tenant_id = $2 AND product_id = $3),
+			$12, NOW())
+		RETURNING position, created_at
+	`
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		attachment.ID,
+		attachment.TenantID,
+		attachment.ProductID,
+		attachment.Kind,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.StorageKey,
+		attachment.URL,
+		attachment.ThumbnailKey,
+		attachment.ThumbnailURL,
+		attachment.CreatedBy,
+	).Scan(&attachment.Position, &attachment.CreatedAt)
+}
+
+func (r *attachmentRepository) GetByID(ctx context.Context, tenantID, productID, id uuid.UUID) (*entities.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM product_attachments
+		WHERE id = $1 AND tenant_id = $2 AND product_id = $3
+	`
+
+	attachment, err := scanAttachment(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID, productID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (r *attachmentRepository) ListByProduct(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM product_attachments
+		WHERE tenant_id = $1 AND product_id = $2
+		ORDER BY position, created_at
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []*entities.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, tenantID, productID, id uuid.UUID) error {
+	query := `DELETE FROM product_attachments WHERE id = $1 AND tenant_id = $2 AND product_id = $3`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID, productID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+func scanAttachment(row pgx.Row) (*entities.Attachment, error) {
+	var attachment entities.Attachment
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.TenantID,
+		&attachment.ProductID,
+		&attachment.Kind,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.SizeBytes,
+		&attachment.StorageKey,
+		&attachment.URL,
+		&attachment.ThumbnailKey,
+		&attachment.ThumbnailURL,
+		&attachment.Position,
+		&attachment.CreatedBy,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
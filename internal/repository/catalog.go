@@ -0,0 +1,496 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/catalog/entities"
+	"motico-api/pkg/money"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type catalogRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewCatalogRepository(pool *pgxpool.Pool) catalog.Repository {
+	return &catalogRepository{pool: pool}
+}
+
+const catalogItemColumns = `c.id, c.tenant_id, c.category_id, c.name, c.description, c.sku, c.default_price, c.currency, c.tracking,
+			(SELECT COUNT(*) FROM products p WHERE p.catalog_item_id = c.id AND p.deleted_at IS NULL), c.created_at, c.updated_at`
+
+func (r *catalogRepository) Create(ctx context.Context, item *entities.CatalogItem) error {
+	query := `
+		INSERT INTO catalog_items (id, tenant_id, category_id, name, description, sku, default_price, currency, tracking, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		item.TenantID,
+		item.CategoryID,
+		item.Name,
+		item.Description,
+		item.SKU,
+		item.DefaultPrice,
+		item.Currency,
+		item.Tracking,
+	).Scan(
+		&item.ID,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.ErrCatalogSKUExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *catalogRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items c
+		WHERE c.id = $1 AND c.tenant_id = $2
+	`
+
+	item, err := scanCatalogItem(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrCatalogItemNotFound
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *catalogRepository) GetBySKU(ctx context.Context, tenantID uuid.UUID, sku string) (*entities.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items c
+		WHERE c.tenant_id = $1 AND c.sku = $2
+	`
+
+	item, err := scanCatalogItem(conn(ctx, r.pool).QueryRow(ctx, query, tenantID, sku))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrCatalogItemNotFound
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *catalogRepository) List(ctx context.Context, tenantID uuid.UUID, categoryID *uuid.UUID, limit, offset int) ([]*entities.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items c
+		WHERE c.tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+	argPos := 2
+
+	if categoryID != nil {
+		query += ` AND c.category_id IN ` + categorySubtree(fmt.Sprintf("$%d", argPos))
+		args = append(args, *categoryID)
+		argPos++
+	}
+
+	query += ` ORDER BY c.created_at DESC LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entities.CatalogItem
+	for rows.Next() {
+		item, err := scanCatalogItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+// Update guarda el artículo y propaga los datos maestros a sus publicaciones.
+func (r *catalogRepository) Update(ctx context.Context, item *entities.CatalogItem) error {
+	query := `
+		UPDATE catalog_items
+		SET category_id = $1, name = $2, description = $3, sku = $4, default_price = $5, currency = $6, tracking = $7, updated_at = NOW()
+		WHERE id = $8 AND tenant_id = $9
+		RETURNING updated_at
+	`
+
+	listingsQuery := `
+		UPDATE products
+		SET category_id = $1, name = $2, description = $3, sku = $4, updated_at = NOW()
+		WHERE catalog_item_id = $5 AND tenant_id = $6
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		item.CategoryID,
+		item.Name,
+		item.Description,
+		item.SKU,
+		item.DefaultPrice,
+		item.Currency,
+		item.Tracking,
+		item.ID,
+		item.TenantID,
+	).Scan(&item.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrCatalogItemNotFound
+		}
+		if isUniqueViolation(err) {
+			return entities.ErrCatalogSKUExists
+		}
+		return err
+	}
+
+	_, err = tx.Exec(ctx, listingsQuery,
+		item.CategoryID,
+		item.Name,
+		item.Description,
+		item.SKU,
+		item.ID,
+		item.TenantID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.ErrCatalogSKUExists
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *catalogRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `DELETE FROM catalog_items WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		// Las publicaciones borradas siguen referenciando el artículo hasta que se purgan
+		if isForeignKeyViolationOn(err, "products_catalog_item_id_fkey") {
+			return entities.ErrCatalogItemHasListings
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrCatalogItemNotFound
+	}
+
+	return nil
+}
+
+func (r *catalogRepository) TenantCurrency(ctx context.Context, tenantID uuid.UUID) (money.Currency, error) {
+	query := `SELECT currency FROM tenants WHERE id = $1`
+
+	var currency money.Currency
+	if err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID).Scan(&currency); err != nil {
+		return "", err
+	}
+
+	return currency, nil
+}
+
+func (r *catalogRepository) ExistsBySKU(ctx context.Context, tenantID uuid.UUID, sku string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM catalog_items WHERE tenant_id = $1 AND sku = $2)`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, sku).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *catalogRepository) HasStock(ctx context.Context, tenantID, id uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM stock s
+			JOIN products p ON p.id = s.product_id
+			WHERE p.tenant_id = $1 AND p.catalog_item_id = $2 AND s.quantity > 0
+		)
+	`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, id).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func scanCatalogItem(row pgx.Row) (*entities.CatalogItem, error) {
+	var item entities.CatalogItem
+	err := row.Scan(
+		&item.ID,
+		&item.TenantID,
+		&item.CategoryID,
+		&item.Name,
+		&item.Description,
+		&item.SKU,
+		&item.DefaultPrice,
+		&item.Currency,
+		&item.Tracking,
+		&item.ListingCount,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *catalogRepository) CreateBarcode(ctx context.Context, barcode *entities.Barcode) error {
+	query := `
+		INSERT INTO catalog_barcodes (id, tenant_id, catalog_item_id, code, normalized_code, format, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW())
+		RETURNING id, created_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		barcode.TenantID,
+		barcode.CatalogItemID,
+		barcode.Code,
+		entities.NormalizeBarcode(barcode.Code),
+		barcode.Format,
+	).Scan(&barcode.ID, &barcode.CreatedAt)
+	if err != nil {
+		if isUniqueViolationOn(err, "catalog_barcodes_tenant_code_key") {
+			return entities.ErrBarcodeExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *catalogRepository) GetBarcode(ctx context.Context, tenantID uuid.UUID, code string) (*entities.Barcode, error) {
+	query := `
+		SELECT id, tenant_id, catalog_item_id, code, format, created_at
+		FROM catalog_barcodes
+		WHERE tenant_id = $1 AND normalized_code = $2
+	`
+
+	barcode, err := scanBarcode(conn(ctx, r.pool).QueryRow(ctx, query, tenantID, entities.NormalizeBarcode(code)))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrBarcodeNotFound
+		}
+		return nil, err
+	}
+
+	return barcode, nil
+}
+
+func (r *catalogRepository) ListBarcodes(ctx context.Context, tenantID, catalogItemID uuid.UUID) ([]*entities.Barcode, error) {
+	query := `
+		SELECT id, tenant_id, catalog_item_id, code, format, created_at
+		FROM catalog_barcodes
+		WHERE tenant_id = $1 AND catalog_item_id = $2
+		ORDER BY created_at
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, catalogItemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var barcodes []*entities.Barcode
+	for rows.Next() {
+		barcode, err := scanBarcode(rows)
+		if err != nil {
+			return nil, err
+		}
+		barcodes = append(barcodes, barcode)
+	}
+
+	return barcodes, rows.Err()
+}
+
+func (r *catalogRepository) DeleteBarcode(ctx context.Context, tenantID, catalogItemID uuid.UUID, code string) error {
+	query := `DELETE FROM catalog_barcodes WHERE tenant_id = $1 AND catalog_item_id = $2 AND normalized_code = $3`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, catalogItemID, entities.NormalizeBarcode(code))
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrBarcodeNotFound
+	}
+
+	return nil
+}
+
+func scanBarcode(row pgx.Row) (*entities.Barcode, error) {
+	var barcode entities.Barcode
+	err := row.Scan(
+		&barcode.ID,
+		&barcode.TenantID,
+		&barcode.CatalogItemID,
+		&barcode.Code,
+		&barcode.Format,
+		&barcode.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &barcode, nil
+}
+
+const fitmentColumns = `id, tenant_id, catalog_item_id, make, model, year_from, year_to, notes, created_at`
+
+func (r *catalogRepository) CreateFitment(ctx context.Context, fitment *entities.Fitment) error {
+	query := `
+		INSERT INTO catalog_fitments (id, tenant_id, catalog_item_id, make, model, year_from, year_to, notes, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING id, created_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		fitment.TenantID,
+		fitment.CatalogItemID,
+		fitment.Make,
+		fitment.Model,
+		fitment.YearFrom,
+		fitment.YearTo,
+		fitment.Notes,
+	).Scan(&fitment.ID, &fitment.CreatedAt)
+	if err != nil {
+		if isUniqueViolationOn(err, "idx_catalog_fitments_unique") {
+			return entities.ErrFitmentExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *catalogRepository) ListFitments(ctx context.Context, tenantID, catalogItemID uuid.UUID) ([]*entities.Fitment, error) {
+	query := `
+		SELECT ` + fitmentColumns + `
+		FROM catalog_fitments
+		WHERE tenant_id = $1 AND catalog_item_id = $2
+		ORDER BY lower(make), lower(model), year_from
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, catalogItemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fitments []*entities.Fitment
+	for rows.Next() {
+		fitment, err := scanFitment(rows)
+		if err != nil {
+			return nil, err
+		}
+		fitments = append(fitments, fitment)
+	}
+
+	return fitments, rows.Err()
+}
+
+func (r *catalogRepository) DeleteFitment(ctx context.Context, tenantID, catalogItemID, id uuid.UUID) error {
+	query := `DELETE FROM catalog_fitments WHERE tenant_id = $1 AND catalog_item_id = $2 AND id = $3`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, catalogItemID, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrFitmentNotFound
+	}
+
+	return nil
+}
+
+func (r *catalogRepository) ImportFitments(ctx context.Context, fitments []*entities.Fitment) (int, error) {
+	query := `
+		INSERT INTO catalog_fitments (id, tenant_id, catalog_item_id, make, model, year_from, year_to, notes, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW())
+		ON CONFLICT DO NOTHING
+	`
+
+	created := 0
+	for _, fitment := range fitments {
+		result, err := conn(ctx, r.pool).Exec(ctx, query,
+			fitment.TenantID,
+			fitment.CatalogItemID,
+			fitment.Make,
+			fitment.Model,
+			fitment.YearFrom,
+			fitment.YearTo,
+			fitment.Notes,
+		)
+		if err != nil {
+			return 0, err
+		}
+		created += int(result.RowsAffected())
+	}
+
+	return created, nil
+}
+
+func (r *catalogRepository) DeleteFitmentsByItems(ctx context.Context, tenantID uuid.UUID, catalogItemIDs []uuid.UUID) (int, error) {
+	query := `DELETE FROM catalog_fitments WHERE tenant_id = $1 AND catalog_item_id = ANY($2)`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, catalogItemIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func scanFitment(row pgx.Row) (*entities.Fitment, error) {
+	var fitment entities.Fitment
+	err := row.Scan(
+		&fitment.ID,
+		&fitment.TenantID,
+		&fitment.CatalogItemID,
+		&fitment.Make,
+		&fitment.Model,
+		&fitment.YearFrom,
+		&fitment.YearTo,
+		&fitment.Notes,
+		&fitment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &fitment, nil
+}
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"motico-api/internal/domain/category"
 	"motico-api/internal/domain/category/entities"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -20,12 +23,18 @@ func NewCategoryRepository(pool *pgxpool.Pool) category.Repository {
 
 func (r *categoryRepository) Create(ctx context.Context, category *entities.Category) error {
 	query := `
-		INSERT INTO categories (id, tenant_id, name, description, created_at, updated_at)
-		VALUES (gen_random_uuid(), $1, $2, $3, NOW(), NOW())
+		INSERT INTO categories (id, tenant_id, parent_id, name, description, attribute_schema, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query, category.TenantID, category.Name, category.Description).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		category.TenantID,
+		category.ParentID,
+		category.Name,
+		category.Description,
+		attributeSchemaParam(category.Attributes),
+	).Scan(
 		&category.ID,
 		&category.CreatedAt,
 		&category.UpdatedAt,
@@ -40,22 +49,46 @@ func (r *categoryRepository) Create(ctx context.Context, category *entities.Cate
 	return nil
 }
 
-func (r *categoryRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Category, error) {
-	query := `
-		SELECT id, tenant_id, name, description, created_at, updated_at
+// categoryTree arma con un CTE recursivo el breadcrumb de cada categoría del
+// tenant ($1), bajando desde las categorías raíz. Incluye las borradas: una
+// categoría viva nunca cuelga de una borrada.
+const categoryTree = `
+	WITH RECURSIVE tree AS (
+		SELECT id, ARRAY[id] AS path_ids, ARRAY[name::TEXT] AS path_names
 		FROM categories
-		WHERE id = $1 AND tenant_id = $2
+		WHERE tenant_id = $1 AND parent_id IS NULL
+		UNION ALL
+		SELECT c.id, t.path_ids || c.id, t.path_names || c.name::TEXT
+		FROM categories c
+		JOIN tree t ON c.parent_id = t.id
+	)
+`
+
+const categoryColumns = `c.id, c.tenant_id, c.parent_id, c.name, c.description, c.attribute_schema, t.path_ids, t.path_names,
+	c.created_at, c.updated_at, c.deleted_at`
+
+// categorySubtree es una subconsulta con la categoría param y todas sus
+// descendientes, para filtrar por categoría incluyendo sus subcategorías.
+func categorySubtree(param string) string {
+	return `(
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM categories WHERE id = ` + param + `
+			UNION ALL
+			SELECT child.id FROM categories child JOIN subtree ON child.parent_id = subtree.id
+		)
+		SELECT id FROM subtree
+	)`
+}
+
+func (r *categoryRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Category, error) {
+	query := categoryTree + `
+		SELECT ` + categoryColumns + `
+		FROM categories c
+		JOIN tree t ON t.id = c.id
+		WHERE c.tenant_id = $1 AND c.id = $2 AND c.deleted_at IS NULL
 	`
 
-	var category entities.Category
-	err := r.pool.QueryRow(ctx, query, id, tenantID).Scan(
-		&category.ID,
-		&category.TenantID,
-		&category.Name,
-		&category.Description,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-	)
+	category, err := scanCategory(conn(ctx, r.pool).QueryRow(ctx, query, tenantID, id))
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, entities.ErrCategoryNotFound
@@ -63,19 +96,44 @@ func (r *categoryRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID
 		return nil, err
 	}
 
-	return &category, nil
+	return category, nil
 }
 
-func (r *categoryRepository) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entities.Category, error) {
-	query := `
-		SELECT id, tenant_id, name, description, created_at, updated_at
-		FROM categories
-		WHERE tenant_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
+// categoryQuerySpec define el orden y los filtros genéricos del listado de categorías.
+var categoryQuerySpec = querySpec{
+	sorts: map[string]string{
+		"name":       "c.name",
+		"created_at": "c.created_at",
+		"updated_at": "c.updated_at",
+	},
+	filters: map[string]filterSpec{
+		"name_contains":  {column: "c.name", op: "ILIKE", kind: kindText},
+		"created_after":  {column: "c.created_at", op: ">", kind: kindTime},
+		"created_before": {column: "c.created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "c.updated_at", op: ">=", kind: kindTime},
+	},
+	defaultOrder: "c.created_at DESC",
+	tieBreaker:   "c.id",
+	deletedAt:    "c.deleted_at",
+}
+
+func (r *categoryRepository) List(ctx context.Context, tenantID uuid.UUID, params query.Params, limit, offset int) ([]*entities.Category, error) {
+	query := categoryTree + `
+		SELECT ` + categoryColumns + `
+		FROM categories c
+		JOIN tree t ON t.id = c.id
+		WHERE c.tenant_id = $1
 	`
+	args := []interface{}{tenantID}
 
-	rows, err := r.pool.Query(ctx, query, tenantID, limit, offset)
+	query, args, err := categoryQuerySpec.apply(query, args, params)
+	if err != nil {
+		return nil, err
+	}
+	query += fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,18 +141,11 @@ func (r *categoryRepository) List(ctx context.Context, tenantID uuid.UUID, limit
 
 	var categories []*entities.Category
 	for rows.Next() {
-		var category entities.Category
-		if err := rows.Scan(
-			&category.ID,
-			&category.TenantID,
-			&category.Name,
-			&category.Description,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		); err != nil {
+		category, err := scanCategory(rows)
+		if err != nil {
 			return nil, err
 		}
-		categories = append(categories, &category)
+		categories = append(categories, category)
 	}
 
 	return categories, rows.Err()
@@ -103,12 +154,19 @@ func (r *categoryRepository) List(ctx context.Context, tenantID uuid.UUID, limit
 func (r *categoryRepository) Update(ctx context.Context, category *entities.Category) error {
 	query := `
 		UPDATE categories
-		SET name = $1, description = $2, updated_at = NOW()
-		WHERE id = $3 AND tenant_id = $4
+		SET parent_id = $1, name = $2, description = $3, attribute_schema = $4, updated_at = NOW()
+		WHERE id = $5 AND tenant_id = $6 AND deleted_at IS NULL
 		RETURNING updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query, category.Name, category.Description, category.ID, category.TenantID).Scan(&category.UpdatedAt)
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		category.ParentID,
+		category.Name,
+		category.Description,
+		attributeSchemaParam(category.Attributes),
+		category.ID,
+		category.TenantID,
+	).Scan(&category.UpdatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return entities.ErrCategoryNotFound
@@ -123,9 +181,9 @@ func (r *categoryRepository) Update(ctx context.Context, category *entities.Cate
 }
 
 func (r *categoryRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
-	query := `DELETE FROM categories WHERE id = $1 AND tenant_id = $2`
+	query := `UPDATE categories SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 
-	result, err := r.pool.Exec(ctx, query, id, tenantID)
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
 	if err != nil {
 		return err
 	}
@@ -137,11 +195,38 @@ func (r *categoryRepository) Delete(ctx context.Context, tenantID, id uuid.UUID)
 	return nil
 }
 
-func (r *categoryRepository) ExistsByName(ctx context.Context, tenantID uuid.UUID, name string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM categories WHERE tenant_id = $1 AND name = $2)`
+func (r *categoryRepository) Restore(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `UPDATE categories SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.ErrCategoryNameExists
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return purgeDeleted(ctx, r.pool, "categories", before, batchSize)
+}
+
+func (r *categoryRepository) ExistsByName(ctx context.Context, tenantID uuid.UUID, parentID *uuid.UUID, name string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM categories
+			WHERE tenant_id = $1 AND parent_id IS NOT DISTINCT FROM $2 AND name = $3 AND deleted_at IS NULL
+		)
+	`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, name).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, parentID, name).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -149,14 +234,110 @@ func (r *categoryRepository) ExistsByName(ctx context.Context, tenantID uuid.UUI
 	return exists, nil
 }
 
+// HasProducts también cuenta los artículos de catálogo de la categoría: no tienen
+// borrado lógico y la restricción de clave foránea impediría purgarla.
 func (r *categoryRepository) HasProducts(ctx context.Context, tenantID, categoryID uuid.UUID) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM products WHERE tenant_id = $1 AND category_id = $2)`
+	query := `
+		SELECT EXISTS(SELECT 1 FROM products WHERE tenant_id = $1 AND category_id = $2 AND deleted_at IS NULL)
+			OR EXISTS(SELECT 1 FROM catalog_items WHERE tenant_id = $1 AND category_id = $2)
+	`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, categoryID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *categoryRepository) HasChildren(ctx context.Context, tenantID, categoryID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM categories WHERE tenant_id = $1 AND parent_id = $2 AND deleted_at IS NULL)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, categoryID).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, categoryID).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 
 	return exists, nil
 }
+
+func (r *categoryRepository) AttributeSchemas(ctx context.Context, tenantID, categoryID uuid.UUID) ([]entities.AttributeSchema, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, attribute_schema, 0 AS depth
+			FROM categories
+			WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, c.parent_id, c.attribute_schema, a.depth + 1
+			FROM categories c
+			JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT attribute_schema FROM ancestors ORDER BY depth DESC
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schemas []entities.AttributeSchema
+	for rows.Next() {
+		var schema entities.AttributeSchema
+		if err := rows.Scan(&schema); err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(schemas) == 0 {
+		return nil, entities.ErrCategoryNotFound
+	}
+
+	return schemas, nil
+}
+
+func (r *categoryRepository) LockTree(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `SELECT id FROM categories WHERE tenant_id = $1 FOR UPDATE`, tenantID)
+	return err
+}
+
+func scanCategory(row pgx.Row) (*entities.Category, error) {
+	var category entities.Category
+	var pathIDs []uuid.UUID
+	var pathNames []string
+	err := row.Scan(
+		&category.ID,
+		&category.TenantID,
+		&category.ParentID,
+		&category.Name,
+		&category.Description,
+		&category.Attributes,
+		&pathIDs,
+		&pathNames,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	category.Path = make([]entities.CategoryRef, len(pathIDs))
+	for i, id := range pathIDs {
+		category.Path[i] = entities.CategoryRef{ID: id, Name: pathNames[i]}
+	}
+	return &category, nil
+}
+
+// attributeSchemaParam guarda un esquema vacío como [] y no como null.
+func attributeSchemaParam(schema entities.AttributeSchema) entities.AttributeSchema {
+	if schema == nil {
+		return entities.AttributeSchema{}
+	}
+	return schema
+}
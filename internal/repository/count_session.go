@@ -0,0 +1,360 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/cyclecount"
+	"motico-api/internal/domain/cyclecount/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type countSessionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewCountSessionRepository(pool *pgxpool.Pool) cyclecount.Repository {
+	return &countSessionRepository{pool: pool}
+}
+
+const countSessionColumns = `id, tenant_id, store_id, category_id, status, notes, created_by, submitted_at, submitted_by,
+			approved_at, approved_by, cancelled_at, cancelled_by, created_at, updated_at`
+
+// Create guarda la sesión y en la misma transacción toma la foto del stock: una
+// línea por producto vendible de la sucursal (los padres con variantes no tienen
+// stock propio) con su cantidad y su costo promedio.
+func (r *countSessionRepository) Create(ctx context.Context, session *entities.CountSession) error {
+	query := `
+		INSERT INTO count_sessions (id, tenant_id, store_id, category_id, status, notes, created_by, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	snapshot := `
+		INSERT INTO count_session_lines (id, tenant_id, session_id, product_id, expected_quantity, unit_cost)
+		SELECT gen_random_uuid(), p.tenant_id, $1, p.id, COALESCE(s.quantity, 0),
+			CASE WHEN COALESCE(s.quantity, 0) > 0 THEN ROUND(s.inventory_value / s.quantity, 4) ELSE 0 END
+		FROM products p
+		LEFT JOIN stock s ON s.tenant_id = p.tenant_id AND s.product_id = p.id
+		WHERE p.tenant_id = $2 AND p.store_id = $3 AND p.deleted_at IS NULL
+			AND ($4::uuid IS NULL OR p.category_id IN ` + categorySubtree("$4") + `)
+			AND NOT EXISTS (SELECT 1 FROM products v WHERE v.parent_id = p.id AND v.deleted_at IS NULL)
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		session.TenantID,
+		session.StoreID,
+		session.CategoryID,
+		session.Status,
+		session.Notes,
+		session.CreatedBy,
+	).Scan(
+		&session.ID,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, snapshot, session.ID, session.TenantID, session.StoreID, session.CategoryID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return r.loadLines(ctx, session.TenantID, []*entities.CountSession{session})
+}
+
+func (r *countSessionRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.CountSession, error) {
+	query := `
+		SELECT ` + countSessionColumns + `
+		FROM count_sessions
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	session, err := scanCountSession(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrCountSessionNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, []*entities.CountSession{session}); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// countSessionQuerySpec define el orden y los filtros genéricos del listado de sesiones de conteo.
+var countSessionQuerySpec = querySpec{
+	sorts: map[string]string{
+		"status":      "status",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+		"approved_at": "approved_at",
+	},
+	filters: map[string]filterSpec{
+		"created_after":  {column: "created_at", op: ">", kind: kindTime},
+		"created_before": {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "updated_at", op: ">=", kind: kindTime},
+		"approved_after": {column: "approved_at", op: ">", kind: kindTime},
+	},
+	defaultOrder: "created_at DESC",
+	tieBreaker:   "id",
+}
+
+func (r *countSessionRepository) List(ctx context.Context, tenantID uuid.UUID, filter cyclecount.ListFilter, limit, offset int) ([]*entities.CountSession, error) {
+	query := `
+		SELECT ` + countSessionColumns + `
+		FROM count_sessions
+		WHERE tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+	argPos := 2
+
+	if filter.Status != nil {
+		query += ` AND status = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	if filter.StoreID != nil {
+		query += ` AND store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.StoreID)
+		argPos++
+	}
+
+	query, args, err := countSessionQuerySpec.apply(query, args, filter.Query)
+	if err != nil {
+		return nil, err
+	}
+	argPos = len(args) + 1
+
+	query += ` LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*entities.CountSession
+	for rows.Next() {
+		session, err := scanCountSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Update persiste el estado de la sesión. Las líneas quedan fijas desde la
+// apertura y los conteos se guardan con SaveCounts.
+func (r *countSessionRepository) Update(ctx context.Context, session *entities.CountSession) error {
+	query := `
+		UPDATE count_sessions
+		SET status = $1, notes = $2, submitted_at = $3, submitted_by = $4, approved_at = $5, approved_by = $6,
+			cancelled_at = $7, cancelled_by = $8, updated_at = NOW()
+		WHERE id = $9 AND tenant_id = $10
+		RETURNING updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		session.Status,
+		session.Notes,
+		session.SubmittedAt,
+		session.SubmittedBy,
+		session.ApprovedAt,
+		session.ApprovedBy,
+		session.CancelledAt,
+		session.CancelledBy,
+		session.ID,
+		session.TenantID,
+	).Scan(&session.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrCountSessionNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// HasActiveSession: dos sesiones se superponen si son de la misma sucursal y
+// alguna no está acotada a una categoría o una categoría contiene a la otra.
+func (r *countSessionRepository) HasActiveSession(ctx context.Context, tenantID, storeID uuid.UUID, categoryID *uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM count_sessions cs
+			WHERE cs.tenant_id = $1 AND cs.store_id = $2 AND cs.status IN ('open', 'submitted')
+				AND ($3::uuid IS NULL OR cs.category_id IS NULL
+					OR cs.category_id IN ` + categorySubtree("$3") + `
+					OR $3 IN ` + categorySubtree("cs.category_id") + `)
+		)
+	`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, storeID, categoryID).Scan(&exists)
+	return exists, err
+}
+
+func (r *countSessionRepository) SaveCounts(ctx context.Context, tenantID, sessionID uuid.UUID, counts []entities.DeviceCount) error {
+	query := `
+		INSERT INTO count_session_counts (id, tenant_id, session_id, product_id, device_id, quantity, counted_by, counted_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (session_id, product_id, device_id)
+		DO UPDATE SET quantity = EXCLUDED.quantity, counted_by = EXCLUDED.counted_by, counted_at = EXCLUDED.counted_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for _, c := range counts {
+		if _, err := tx.Exec(ctx, query, tenantID, sessionID, c.ProductID, c.DeviceID, c.Quantity, c.CountedBy, c.CountedAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func scanCountSession(row pgx.Row) (*entities.CountSession, error) {
+	var session entities.CountSession
+	err := row.Scan(
+		&session.ID,
+		&session.TenantID,
+		&session.StoreID,
+		&session.CategoryID,
+		&session.Status,
+		&session.Notes,
+		&session.CreatedBy,
+		&session.SubmittedAt,
+		&session.SubmittedBy,
+		&session.ApprovedAt,
+		&session.ApprovedBy,
+		&session.CancelledAt,
+		&session.CancelledBy,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// loadLines carga las líneas de las sesiones y, en una segunda consulta, los
+// conteos de cada dispositivo.
+func (r *countSessionRepository) loadLines(ctx context.Context, tenantID uuid.UUID, sessions []*entities.CountSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.CountSession, len(sessions))
+	ids := make([]uuid.UUID, len(sessions))
+	for i, s := range sessions {
+		byID[s.ID] = s
+		ids[i] = s.ID
+		s.Lines = []entities.CountLine{}
+	}
+
+	query := `
+		SELECT l.id, l.session_id, l.product_id, l.expected_quantity, l.unit_cost
+		FROM count_session_lines l
+		JOIN products p ON p.id = l.product_id
+		WHERE l.tenant_id = $1 AND l.session_id = ANY($2)
+		ORDER BY l.session_id, p.name, l.product_id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line entities.CountLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.SessionID,
+			&line.ProductID,
+			&line.ExpectedQuantity,
+			&line.UnitCost,
+		); err != nil {
+			return err
+		}
+		line.Counts = []entities.DeviceCount{}
+		if s, ok := byID[line.SessionID]; ok {
+			s.Lines = append(s.Lines, line)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	countsQuery := `
+		SELECT session_id, product_id, device_id, quantity, counted_by, counted_at
+		FROM count_session_counts
+		WHERE tenant_id = $1 AND session_id = ANY($2)
+		ORDER BY session_id, product_id, counted_at
+	`
+
+	countRows, err := conn(ctx, r.pool).Query(ctx, countsQuery, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer countRows.Close()
+
+	for countRows.Next() {
+		var sessionID uuid.UUID
+		var c entities.DeviceCount
+		if err := countRows.Scan(
+			&sessionID,
+			&c.ProductID,
+			&c.DeviceID,
+			&c.Quantity,
+			&c.CountedBy,
+			&c.CountedAt,
+		); err != nil {
+			return err
+		}
+		if s, ok := byID[sessionID]; ok {
+			if line := s.Line(c.ProductID); line != nil {
+				line.Counts = append(line.Counts, c)
+			}
+		}
+	}
+
+	return countRows.Err()
+}
@@ -12,3 +12,29 @@ func isUniqueViolation(err error) bool {
 	}
 	return false
 }
+
+// isUniqueViolationOn distingue qué restricción única se violó cuando una tabla tiene varias.
+func isUniqueViolationOn(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+	}
+	return false
+}
+
+// isForeignKeyViolation indica que la fila sigue referenciada por otra tabla.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503"
+	}
+	return false
+}
+
+func isForeignKeyViolationOn(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503" && pgErr.ConstraintName == constraint
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/pricelist"
+	"motico-api/internal/domain/pricelist/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type priceListRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPriceListRepository(pool *pgxpool.Pool) pricelist.Repository {
+	return &priceListRepository{pool: pool}
+}
+
+func (r *priceListRepository) Create(ctx context.Context, priceList *entities.PriceList) error {
+	query := `
+		INSERT INTO price_lists (id, tenant_id, store_id, name, currency, valid_from, valid_to, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		priceList.TenantID,
+		priceList.StoreID,
+		priceList.Name,
+		priceList.Currency,
+		priceList.ValidFrom,
+		priceList.ValidTo,
+	).Scan(
+		&priceList.ID,
+		&priceList.CreatedAt,
+		&priceList.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := r.saveItems(ctx, tx, priceList); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *priceListRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PriceList, error) {
+	query := `
+		SELECT id, tenant_id, store_id, name, currency, valid_from, valid_to, created_at, updated_at
+		FROM price_lists
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	priceList, err := scanPriceList(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrPriceListNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadItems(ctx, tenantID, []*entities.PriceList{priceList}); err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+func (r *priceListRepository) List(ctx context.Context, tenantID uuid.UUID, storeID *uuid.UUID, limit, offset int) ([]*entities.PriceList, error) {
+	query := `
+		SELECT id, tenant_id, store_id, name, currency, valid_from, valid_to, created_at, updated_at
+		FROM price_lists
+		WHERE tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+	argPos := 2
+
+	if storeID != nil {
+		query += ` AND store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *storeID)
+		argPos++
+	}
+
+	query += ` ORDER BY valid_from DESC LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var priceLists []*entities.PriceList
+	for rows.Next() {
+		priceList, err := scanPriceList(rows)
+		if err != nil {
+			return nil, err
+		}
+		priceLists = append(priceLists, priceList)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadItems(ctx, tenantID, priceLists); err != nil {
+		return nil, err
+	}
+
+	return priceLists, nil
+}
+
+func (r *priceListRepository) Update(ctx context.Context, priceList *entities.PriceList) error {
+	query := `
+		UPDATE price_lists
+		SET name = $1, valid_from = $2, valid_to = $3, updated_at = NOW()
+		WHERE id = $4 AND tenant_id = $5
+		RETURNING updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		priceList.Name,
+		priceList.ValidFrom,
+		priceList.ValidTo,
+		priceList.ID,
+		priceList.TenantID,
+	).Scan(&priceList.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrPriceListNotFound
+		}
+		return err
+	}
+
+	if err := r.saveItems(ctx, tx, priceList); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *priceListRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `DELETE FROM price_lists WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrPriceListNotFound
+	}
+
+	return nil
+}
+
+func scanPriceList(row pgx.Row) (*entities.PriceList, error) {
+	var priceList entities.PriceList
+	err := row.Scan(
+		&priceList.ID,
+		&priceList.TenantID,
+		&priceList.StoreID,
+		&priceList.Name,
+		&priceList.Currency,
+		&priceList.ValidFrom,
+		&priceList.ValidTo,
+		&priceList.CreatedAt,
+		&priceList.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &priceList, nil
+}
+
+// saveItems reemplaza los artículos de la lista por los actuales.
+func (r *priceListRepository) saveItems(ctx context.Context, tx pgx.Tx, priceList *entities.PriceList) error {
+	deleteQuery := `DELETE FROM price_list_items WHERE price_list_id = $1 AND tenant_id = $2`
+	if _, err := tx.Exec(ctx, deleteQuery, priceList.ID, priceList.TenantID); err != nil {
+		return err
+	}
+
+	insertQuery := `
+		INSERT INTO price_list_items (id, tenant_id, price_list_id, catalog_item_id, price)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4)
+		RETURNING id
+	`
+
+	for i := range priceList.Items {
+		item := &priceList.Items[i]
+		err := tx.QueryRow(ctx, insertQuery,
+			priceList.TenantID,
+			priceList.ID,
+			item.CatalogItemID,
+			item.Price,
+		).Scan(&item.ID)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return entities.ErrDuplicatePriceListItem
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) loadItems(ctx context.Context, tenantID uuid.UUID, priceLists []*entities.PriceList) error {
+	if len(priceLists) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.PriceList, len(priceLists))
+	ids := make([]uuid.UUID, len(priceLists))
+	for i, l := range priceLists {
+		byID[l.ID] = l
+		ids[i] = l.ID
+		l.Items = []entities.PriceListItem{}
+	}
+
+	query := `
+		SELECT pli.id, pli.price_list_id, pli.catalog_item_id, pli.price
+		FROM price_list_items pli
+		JOIN catalog_items c ON c.id = pli.catalog_item_id
+		WHERE pli.tenant_id = $1 AND pli.price_list_id = ANY($2)
+		ORDER BY pli.price_list_id, c.name
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item entities.PriceListItem
+		var priceListID uuid.UUID
+		if err := rows.Scan(
+			&item.ID,
+			&priceListID,
+			&item.CatalogItemID,
+			&item.Price,
+		); err != nil {
+			return err
+		}
+		if l, ok := byID[priceListID]; ok {
+			l.Items = append(l.Items, item)
+		}
+	}
+
+	return rows.Err()
+}
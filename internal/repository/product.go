@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"motico-api/internal/domain/product"
 	"motico-api/internal/domain/product/entities"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -19,27 +20,99 @@ func NewProductRepository(pool *pgxpool.Pool) product.Repository {
 	return &productRepository{pool: pool}
 }
 
+const productColumns = `p.id, p.tenant_id, p.store_id, p.category_id, p.catalog_item_id, p.parent_id, p.name, p.description, p.sku,
+			p.price, c.default_price, ` + productListPrice + `, c.currency, c.tracking, p.active, p.status, p.archived_at, p.option_values, p.attributes,
+			(SELECT COUNT(*) FROM products v WHERE v.parent_id = p.id AND v.deleted_at IS NULL), ` + productImages + `, p.created_at, p.updated_at,
+			p.deleted_at`
+
+// productImages son las imágenes del producto en orden, con las URLs que guardó
+// el almacenamiento al subirlas.
+const productImages = `COALESCE((
+				SELECT jsonb_agg(jsonb_build_object('id', a.id, 'url', a.url, 'thumbnail_url', a.thumbnail_url)
+					ORDER BY a.position, a.created_at)
+				FROM product_attachments a
+				WHERE a.product_id = p.id AND a.kind = 'image'
+			), '[]'::jsonb)`
+
+// productListPrice es el precio del artículo en la lista de precios vigente de la
+// sucursal; si hay varias vigentes manda la que empezó más recientemente.
+const productListPrice = `(SELECT pli.price FROM price_list_items pli JOIN price_lists pl ON pl.id = pli.price_list_id
+			WHERE pl.store_id = p.store_id AND pli.catalog_item_id = p.catalog_item_id
+				AND pl.valid_from <= NOW() AND (pl.valid_to IS NULL OR pl.valid_to > NOW())
+			ORDER BY pl.valid_from DESC LIMIT 1)`
+
+// productEffectivePrice replica Product.EffectivePrice en SQL para filtrar y ordenar.
+const productEffectivePrice = `COALESCE(` + productListPrice + `, p.price, c.default_price)`
+
+// productQuerySpec define el orden y los filtros genéricos del listado de productos.
+var productQuerySpec = querySpec{
+	sorts: map[string]string{
+		"name":       "p.name",
+		"sku":        "p.sku",
+		"price":      productEffectivePrice,
+		"created_at": "p.created_at",
+		"updated_at": "p.updated_at",
+	},
+	filters: map[string]filterSpec{
+		"name_contains":  {column: "p.name", op: "ILIKE", kind: kindText},
+		"sku_contains":   {column: "p.sku", op: "ILIKE", kind: kindText},
+		"price_gte":      {column: productEffectivePrice, op: ">=", kind: kindDecimal},
+		"price_lte":      {column: productEffectivePrice, op: "<=", kind: kindDecimal},
+		"created_after":  {column: "p.created_at", op: ">", kind: kindTime},
+		"created_before": {column: "p.created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "p.updated_at", op: ">=", kind: kindTime},
+	},
+	defaultOrder: "p.created_at DESC",
+	tieBreaker:   "p.id",
+	attributes:   "p.attributes",
+	deletedAt:    "p.deleted_at",
+}
+
+// productInStock deja los productos con stock disponible. Un producto con
+// variantes está en stock si alguna de sus variantes no borradas lo está.
+const productInStock = `
+			AND EXISTS (
+				SELECT 1 FROM stock s JOIN products sp ON sp.id = s.product_id
+				WHERE (sp.id = p.id OR sp.parent_id = p.id) AND sp.deleted_at IS NULL
+					AND s.quantity - s.reserved_quantity - s.quarantined_quantity > 0
+			)`
+
+// productFrom une cada publicación con su artículo de catálogo para resolver el precio por defecto.
+const productFrom = `products p JOIN catalog_items c ON c.id = p.catalog_item_id`
+
 func (r *productRepository) Create(ctx context.Context, product *entities.Product) error {
 	query := `
-		INSERT INTO products (id, tenant_id, store_id, category_id, name, description, sku, price, created_at, updated_at)
-		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id, created_at, updated_at
+		INSERT INTO products (id, tenant_id, store_id, category_id, catalog_item_id, parent_id, name, description, sku, price, active, option_values, attributes, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING id, status, created_at, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query,
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
 		product.TenantID,
 		product.StoreID,
 		product.CategoryID,
+		product.CatalogItemID,
+		product.ParentID,
 		product.Name,
 		product.Description,
 		product.SKU,
 		product.Price,
+		product.Active,
+		optionValuesParam(product.OptionValues),
+		attributesParam(product.Attributes),
 	).Scan(
 		&product.ID,
+		&product.Status,
 		&product.CreatedAt,
 		&product.UpdatedAt,
 	)
 	if err != nil {
+		if isUniqueViolationOn(err, "idx_products_variant_options") {
+			return entities.ErrVariantExists
+		}
+		if isUniqueViolationOn(err, "idx_products_store_catalog_item") {
+			return entities.ErrProductAlreadyListed
+		}
 		if isUniqueViolation(err) {
 			return entities.ErrProductSKUExists
 		}
@@ -51,24 +124,12 @@ func (r *productRepository) Create(ctx context.Context, product *entities.Produc
 
 func (r *productRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Product, error) {
 	query := `
-		SELECT id, tenant_id, store_id, category_id, name, description, sku, price, created_at, updated_at
-		FROM products
-		WHERE id = $1 AND tenant_id = $2
+		SELECT ` + productColumns + `
+		FROM ` + productFrom + `
+		WHERE p.id = $1 AND p.tenant_id = $2 AND p.deleted_at IS NULL
 	`
 
-	var product entities.Product
-	err := r.pool.QueryRow(ctx, query, id, tenantID).Scan(
-		&product.ID,
-		&product.TenantID,
-		&product.StoreID,
-		&product.CategoryID,
-		&product.Name,
-		&product.Description,
-		&product.SKU,
-		&product.Price,
-		&product.CreatedAt,
-		&product.UpdatedAt,
-	)
+	product, err := scanProduct(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, entities.ErrProductNotFound
@@ -76,34 +137,165 @@ func (r *productRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID)
 		return nil, err
 	}
 
-	return &product, nil
+	return product, nil
 }
 
-func (r *productRepository) List(ctx context.Context, tenantID uuid.UUID, storeID, categoryID *uuid.UUID, limit, offset int) ([]*entities.Product, error) {
+func (r *productRepository) List(ctx context.Context, tenantID uuid.UUID, filter product.ListFilter, limit, offset int) ([]*entities.Product, error) {
 	query := `
-		SELECT id, tenant_id, store_id, category_id, name, description, sku, price, created_at, updated_at
-		FROM products
-		WHERE tenant_id = $1
+		SELECT ` + productColumns + `
+		FROM ` + productFrom + `
+		WHERE p.tenant_id = $1
 	`
 	args := []interface{}{tenantID}
 	argPos := 2
 
-	if storeID != nil {
-		query += ` AND store_id = $` + fmt.Sprintf("%d", argPos)
-		args = append(args, *storeID)
+	if filter.StoreID != nil {
+		query += ` AND p.store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.StoreID)
 		argPos++
 	}
 
-	if categoryID != nil {
-		query += ` AND category_id = $` + fmt.Sprintf("%d", argPos)
-		args = append(args, *categoryID)
+	if filter.CategoryID != nil {
+		query += ` AND p.category_id IN ` + categorySubtree(fmt.Sprintf("$%d", argPos))
+		args = append(args, *filter.CategoryID)
 		argPos++
 	}
 
-	query += ` ORDER BY created_at DESC LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	if filter.CatalogItemID != nil {
+		query += ` AND p.catalog_item_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.CatalogItemID)
+		argPos++
+	}
+
+	if filter.Active != nil {
+		query += ` AND p.active = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Active)
+		argPos++
+	}
+
+	if filter.Status != nil {
+		query += ` AND p.status = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	if filter.ParentID != nil {
+		query += ` AND p.parent_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.ParentID)
+		argPos++
+	} else if !filter.IncludeVariants {
+		query += ` AND p.parent_id IS NULL`
+	}
+
+	if filter.Fits != nil {
+		// Las variantes usan la compatibilidad del artículo de su producto padre
+		query += fmt.Sprintf(`
+			AND EXISTS (
+				SELECT 1 FROM catalog_fitments f
+				WHERE f.tenant_id = p.tenant_id
+					AND (f.catalog_item_id = p.catalog_item_id
+						OR f.catalog_item_id = (SELECT pp.catalog_item_id FROM products pp WHERE pp.id = p.parent_id))
+					AND lower(f.make) = lower($%d) AND lower(f.model) = lower($%d)
+					AND $%d BETWEEN f.year_from AND f.year_to
+			)`, argPos, argPos+1, argPos+2)
+		args = append(args, filter.Fits.Make, filter.Fits.Model, filter.Fits.Year)
+		argPos += 3
+	}
+
+	if filter.InStock {
+		query += productInStock
+	}
+
+	query, args, err := productQuerySpec.apply(query, args, filter.Query)
+	if err != nil {
+		return nil, err
+	}
+	argPos = len(args) + 1
+
+	query += ` LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
 	args = append(args, limit, offset)
 
-	rows, err := r.pool.Query(ctx, query, args...)
+	return r.queryProducts(ctx, query, args...)
+}
+
+func (r *productRepository) Search(ctx context.Context, tenantID uuid.UUID, filter product.SearchFilter, limit, offset int) ([]*entities.SearchResult, error) {
+	// Coincide por texto completo (con la última palabra como prefijo) o por
+	// similitud de trigramas, que tolera errores de tipeo en nombre y SKU
+	query := `
+		WITH q AS (SELECT to_tsquery('simple', $2) AS tsq, $3::text AS raw)
+		SELECT ` + productColumns + `,
+			ts_rank(p.search_vector, q.tsq) * 2 +
+			GREATEST(similarity(p.name, q.raw), word_similarity(q.raw, p.name), similarity(coalesce(p.sku, ''), q.raw)) AS rank
+		FROM ` + productFrom + ` CROSS JOIN q
+		WHERE p.tenant_id = $1 AND p.deleted_at IS NULL AND p.status = 'active'
+			AND (p.search_vector @@ q.tsq OR p.name % q.raw OR q.raw <% p.name OR p.sku % q.raw)
+	`
+	args := []interface{}{tenantID, filter.TSQuery, filter.Query}
+	argPos := 4
+
+	if filter.StoreID != nil {
+		query += ` AND p.store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.StoreID)
+		argPos++
+	}
+
+	if filter.CategoryID != nil {
+		query += ` AND p.category_id IN ` + categorySubtree(fmt.Sprintf("$%d", argPos))
+		args = append(args, *filter.CategoryID)
+		argPos++
+	}
+
+	if filter.MinPrice != nil {
+		query += ` AND ` + productEffectivePrice + ` >= $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.MinPrice)
+		argPos++
+	}
+
+	if filter.MaxPrice != nil {
+		query += ` AND ` + productEffectivePrice + ` <= $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.MaxPrice)
+		argPos++
+	}
+
+	if filter.InStock {
+		query += productInStock
+	}
+
+	query += ` ORDER BY rank DESC, p.name LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*entities.SearchResult
+	for rows.Next() {
+		var rank float64
+		product, err := scanProduct(rows, &rank)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &entities.SearchResult{Product: product, Rank: rank})
+	}
+
+	return results, rows.Err()
+}
+
+func (r *productRepository) ListVariants(ctx context.Context, tenantID, parentID uuid.UUID) ([]*entities.Product, error) {
+	query := `
+		SELECT ` + productColumns + `
+		FROM ` + productFrom + `
+		WHERE p.tenant_id = $1 AND p.parent_id = $2 AND p.deleted_at IS NULL
+		ORDER BY p.created_at
+	`
+
+	return r.queryProducts(ctx, query, tenantID, parentID)
+}
+
+func (r *productRepository) queryProducts(ctx context.Context, query string, args ...interface{}) ([]*entities.Product, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,22 +303,11 @@ func (r *productRepository) List(ctx context.Context, tenantID uuid.UUID, storeI
 
 	var products []*entities.Product
 	for rows.Next() {
-		var product entities.Product
-		if err := rows.Scan(
-			&product.ID,
-			&product.TenantID,
-			&product.StoreID,
-			&product.CategoryID,
-			&product.Name,
-			&product.Description,
-			&product.SKU,
-			&product.Price,
-			&product.CreatedAt,
-			&product.UpdatedAt,
-		); err != nil {
+		product, err := scanProduct(rows)
+		if err != nil {
 			return nil, err
 		}
-		products = append(products, &product)
+		products = append(products, product)
 	}
 
 	return products, rows.Err()
@@ -135,18 +316,24 @@ func (r *productRepository) List(ctx context.Context, tenantID uuid.UUID, storeI
 func (r *productRepository) Update(ctx context.Context, product *entities.Product) error {
 	query := `
 		UPDATE products
-		SET store_id = $1, category_id = $2, name = $3, description = $4, sku = $5, price = $6, updated_at = NOW()
-		WHERE id = $7 AND tenant_id = $8
+		SET store_id = $1, category_id = $2, name = $3, description = $4, sku = $5, price = $6, active = $7, option_values = $8, attributes = $9,
+			status = $10, archived_at = $11, updated_at = NOW()
+		WHERE id = $12 AND tenant_id = $13 AND deleted_at IS NULL
 		RETURNING updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query,
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
 		product.StoreID,
 		product.CategoryID,
 		product.Name,
 		product.Description,
 		product.SKU,
 		product.Price,
+		product.Active,
+		optionValuesParam(product.OptionValues),
+		attributesParam(product.Attributes),
+		product.Status,
+		product.ArchivedAt,
 		product.ID,
 		product.TenantID,
 	).Scan(&product.UpdatedAt)
@@ -154,6 +341,12 @@ func (r *productRepository) Update(ctx context.Context, product *entities.Produc
 		if err == pgx.ErrNoRows {
 			return entities.ErrProductNotFound
 		}
+		if isUniqueViolationOn(err, "idx_products_variant_options") {
+			return entities.ErrVariantExists
+		}
+		if isUniqueViolationOn(err, "idx_products_store_catalog_item") {
+			return entities.ErrProductAlreadyListed
+		}
 		if isUniqueViolation(err) {
 			return entities.ErrProductSKUExists
 		}
@@ -164,9 +357,9 @@ func (r *productRepository) Update(ctx context.Context, product *entities.Produc
 }
 
 func (r *productRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
-	query := `DELETE FROM products WHERE id = $1 AND tenant_id = $2`
+	query := `UPDATE products SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 
-	result, err := r.pool.Exec(ctx, query, id, tenantID)
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
 	if err != nil {
 		return err
 	}
@@ -178,11 +371,39 @@ func (r *productRepository) Delete(ctx context.Context, tenantID, id uuid.UUID)
 	return nil
 }
 
+func (r *productRepository) Restore(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `UPDATE products SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		if isUniqueViolationOn(err, "idx_products_variant_options") {
+			return entities.ErrVariantExists
+		}
+		if isUniqueViolationOn(err, "idx_products_store_catalog_item") {
+			return entities.ErrProductAlreadyListed
+		}
+		if isUniqueViolation(err) {
+			return entities.ErrProductSKUExists
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrProductNotFound
+	}
+
+	return nil
+}
+
+func (r *productRepository) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return purgeDeleted(ctx, r.pool, "products", before, batchSize)
+}
+
 func (r *productRepository) ExistsBySKU(ctx context.Context, tenantID, storeID uuid.UUID, sku string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM products WHERE tenant_id = $1 AND store_id = $2 AND sku = $3)`
+	query := `SELECT EXISTS(SELECT 1 FROM products WHERE tenant_id = $1 AND store_id = $2 AND sku = $3 AND deleted_at IS NULL)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, storeID, sku).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, storeID, sku).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -194,7 +415,7 @@ func (r *productRepository) HasStock(ctx context.Context, tenantID, productID uu
 	query := `SELECT EXISTS(SELECT 1 FROM stock WHERE tenant_id = $1 AND product_id = $2 AND quantity > 0)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, productID).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -203,13 +424,184 @@ func (r *productRepository) HasStock(ctx context.Context, tenantID, productID uu
 }
 
 func (r *productRepository) HasTransfers(ctx context.Context, tenantID, productID uuid.UUID) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM transfers WHERE tenant_id = $1 AND product_id = $2)`
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM transfer_lines l JOIN transfers t ON t.id = l.transfer_id
+			WHERE l.tenant_id = $1 AND l.product_id = $2 AND t.deleted_at IS NULL
+		)
+	`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *productRepository) ListOptions(ctx context.Context, tenantID, productID uuid.UUID) ([]entities.ProductOption, error) {
+	query := `
+		SELECT id, tenant_id, product_id, name, position, allowed_values
+		FROM product_options
+		WHERE tenant_id = $1 AND product_id = $2
+		ORDER BY position
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := []entities.ProductOption{}
+	for rows.Next() {
+		var option entities.ProductOption
+		if err := rows.Scan(
+			&option.ID,
+			&option.TenantID,
+			&option.ProductID,
+			&option.Name,
+			&option.Position,
+			&option.Values,
+		); err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+
+	return options, rows.Err()
+}
+
+// ReplaceOptions deja como ejes del producto exactamente los recibidos, en ese orden.
+func (r *productRepository) ReplaceOptions(ctx context.Context, tenantID, productID uuid.UUID, options []entities.ProductOption) error {
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM product_options WHERE tenant_id = $1 AND product_id = $2`, tenantID, productID); err != nil {
+		return err
+	}
+
+	insertQuery := `
+		INSERT INTO product_options (id, tenant_id, product_id, name, position, allowed_values, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING id
+	`
+
+	for i := range options {
+		option := &options[i]
+		option.TenantID = tenantID
+		option.ProductID = productID
+		option.Position = i
+		if err := tx.QueryRow(ctx, insertQuery, tenantID, productID, option.Name, i, option.Values).Scan(&option.ID); err != nil {
+			if isUniqueViolation(err) {
+				return entities.ErrDuplicateProductOption
+			}
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// HasOpenTransfers indica si el producto está en un traspaso sin terminar.
+func (r *productRepository) HasOpenTransfers(ctx context.Context, tenantID, productID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM transfer_lines l JOIN transfers t ON t.id = l.transfer_id
+			WHERE l.tenant_id = $1 AND l.product_id = $2 AND t.deleted_at IS NULL
+				AND t.status IN ('pending', 'approved', 'in_transit', 'partially_received')
+		)
+	`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, productID).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 
 	return exists, nil
 }
+
+func (r *productRepository) ArchiveVariants(ctx context.Context, tenantID, parentID uuid.UUID) error {
+	query := `
+		UPDATE products v
+		SET status = 'archived', archived_at = p.archived_at, updated_at = NOW()
+		FROM products p
+		WHERE p.id = v.parent_id AND v.tenant_id = $1 AND v.parent_id = $2
+			AND v.status = 'active' AND v.deleted_at IS NULL AND p.archived_at IS NOT NULL
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, parentID)
+	return err
+}
+
+func (r *productRepository) UnarchiveVariants(ctx context.Context, tenantID, parentID uuid.UUID) error {
+	query := `
+		UPDATE products v
+		SET status = 'active', archived_at = NULL, updated_at = NOW()
+		FROM products p
+		WHERE p.id = v.parent_id AND v.tenant_id = $1 AND v.parent_id = $2
+			AND v.status = 'archived' AND v.archived_at = p.archived_at
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, parentID)
+	return err
+}
+
+// scanProduct lee las columnas de productColumns; extra recibe las columnas
+// calculadas que la consulta agregue al final.
+func scanProduct(row pgx.Row, extra ...interface{}) (*entities.Product, error) {
+	var product entities.Product
+	dest := []interface{}{
+		&product.ID,
+		&product.TenantID,
+		&product.StoreID,
+		&product.CategoryID,
+		&product.CatalogItemID,
+		&product.ParentID,
+		&product.Name,
+		&product.Description,
+		&product.SKU,
+		&product.Price,
+		&product.CatalogPrice,
+		&product.ListPrice,
+		&product.Currency,
+		&product.Tracking,
+		&product.Active,
+		&product.Status,
+		&product.ArchivedAt,
+		&product.OptionValues,
+		&product.Attributes,
+		&product.VariantCount,
+		&product.Images,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+		&product.DeletedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// optionValuesParam guarda NULL en lugar de un objeto vacío para productos sin variantes.
+func optionValuesParam(values map[string]string) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// attributesParam guarda un producto sin atributos como {} y no como null.
+func attributesParam(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return map[string]interface{}{}
+	}
+	return values
+}
@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/purchaseorder"
+	"motico-api/internal/domain/purchaseorder/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type purchaseOrderRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPurchaseOrderRepository(pool *pgxpool.Pool) purchaseorder.Repository {
+	return &purchaseOrderRepository{pool: pool}
+}
+
+const purchaseOrderColumns = `id, tenant_id, supplier_id, store_id, status, currency, notes, expected_at, created_by,
+			sent_at, sent_by, received_at, received_by, closed_at, closed_by, cancelled_at, cancelled_by, created_at, updated_at`
+
+func (r *purchaseOrderRepository) Create(ctx context.Context, order *entities.PurchaseOrder) error {
+	query := `
+		INSERT INTO purchase_orders (id, tenant_id, supplier_id, store_id, status, currency, notes, expected_at, created_by, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		order.TenantID,
+		order.SupplierID,
+		order.StoreID,
+		order.Status,
+		order.Currency,
+		order.Notes,
+		order.ExpectedAt,
+		order.CreatedBy,
+	).Scan(
+		&order.ID,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := r.saveLines(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *purchaseOrderRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	return r.get(ctx, tenantID, id, false)
+}
+
+// GetByIDForUpdate bloquea la fila de la orden hasta el fin de la transacción,
+// así dos recepciones concurrentes no parten de las mismas cantidades recibidas.
+func (r *purchaseOrderRepository) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	return r.get(ctx, tenantID, id, true)
+}
+
+func (r *purchaseOrderRepository) get(ctx context.Context, tenantID, id uuid.UUID, lock bool) (*entities.PurchaseOrder, error) {
+	query := `
+		SELECT ` + purchaseOrderColumns + `
+		FROM purchase_orders
+		WHERE id = $1 AND tenant_id = $2
+	`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	order, err := scanPurchaseOrder(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrPurchaseOrderNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, []*entities.PurchaseOrder{order}); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// purchaseOrderQuerySpec define el orden y los filtros genéricos del listado de órdenes de compra.
+var purchaseOrderQuerySpec = querySpec{
+	sorts: map[string]string{
+		"status":      "status",
+		"expected_at": "expected_at",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+		"received_at": "received_at",
+	},
+	filters: map[string]filterSpec{
+		"created_after":   {column: "created_at", op: ">", kind: kindTime},
+		"created_before":  {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":   {column: "updated_at", op: ">=", kind: kindTime},
+		"expected_before": {column: "expected_at", op: "<", kind: kindTime},
+	},
+	defaultOrder: "created_at DESC",
+	tieBreaker:   "id",
+}
+
+func (r *purchaseOrderRepository) List(ctx context.Context, tenantID uuid.UUID, filter purchaseorder.ListFilter, limit, offset int) ([]*entities.PurchaseOrder, error) {
+	query := `
+		SELECT ` + purchaseOrderColumns + `
+		FROM purchase_orders
+		WHERE tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+	argPos := 2
+
+	if filter.Status != nil {
+		query += ` AND status = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	if filter.SupplierID != nil {
+		query += ` AND supplier_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.SupplierID)
+		argPos++
+	}
+
+	if filter.StoreID != nil {
+		query += ` AND store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.StoreID)
+		argPos++
+	}
+
+	query, args, err := purchaseOrderQuerySpec.apply(query, args, filter.Query)
+	if err != nil {
+		return nil, err
+	}
+	argPos = len(args) + 1
+
+	query += ` LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orders []*entities.PurchaseOrder
+	for rows.Next() {
+		order, err := scanPurchaseOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *purchaseOrderRepository) Update(ctx context.Context, order *entities.PurchaseOrder) error {
+	query := `
+		UPDATE purchase_orders
+		SET supplier_id = $1, store_id = $2, status = $3, notes = $4, expected_at = $5,
+			sent_at = $6, sent_by = $7, received_at = $8, received_by = $9,
+			closed_at = $10, closed_by = $11, cancelled_at = $12, cancelled_by = $13, updated_at = NOW()
+		WHERE id = $14 AND tenant_id = $15
+		RETURNING updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		order.SupplierID,
+		order.StoreID,
+		order.Status,
+		order.Notes,
+		order.ExpectedAt,
+		order.SentAt,
+		order.SentBy,
+		order.ReceivedAt,
+		order.ReceivedBy,
+		order.ClosedAt,
+		order.ClosedBy,
+		order.CancelledAt,
+		order.CancelledBy,
+		order.ID,
+		order.TenantID,
+	).Scan(&order.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrPurchaseOrderNotFound
+		}
+		return err
+	}
+
+	if err := r.saveLines(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *purchaseOrderRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `DELETE FROM purchase_orders WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrPurchaseOrderNotFound
+	}
+
+	return nil
+}
+
+func scanPurchaseOrder(row pgx.Row) (*entities.PurchaseOrder, error) {
+	var order entities.PurchaseOrder
+	err := row.Scan(
+		&order.ID,
+		&order.TenantID,
+		&order.SupplierID,
+		&order.StoreID,
+		&order.Status,
+		&order.Currency,
+		&order.Notes,
+		&order.ExpectedAt,
+		&order.CreatedBy,
+		&order.SentAt,
+		&order.SentBy,
+		&order.ReceivedAt,
+		&order.ReceivedBy,
+		&order.ClosedAt,
+		&order.ClosedBy,
+		&order.CancelledAt,
+		&order.CancelledBy,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// saveLines deja en la base exactamente las líneas de la orden: elimina las que
+// ya no están y hace upsert del resto por (purchase_order_id, product_id).
+func (r *purchaseOrderRepository) saveLines(ctx context.Context, tx pgx.Tx, order *entities.PurchaseOrder) error {
+	productIDs := make([]uuid.UUID, len(order.Lines))
+	for i, line := range order.Lines {
+		productIDs[i] = line.ProductID
+	}
+
+	deleteQuery := `DELETE FROM purchase_order_lines WHERE purchase_order_id = $1 AND tenant_id = $2 AND NOT (product_id = ANY($3))`
+	if _, err := tx.Exec(ctx, deleteQuery, order.ID, order.TenantID, productIDs); err != nil {
+		return err
+	}
+
+	upsertQuery := `
+		INSERT INTO purchase_order_lines (id, tenant_id, purchase_order_id, product_id, position, quantity, received_quantity, unit_cost, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		ON CONFLICT (purchase_order_id, product_id) DO UPDATE
+		SET position = EXCLUDED.position, quantity = EXCLUDED.quantity,
+			received_quantity = EXCLUDED.received_quantity, unit_cost = EXCLUDED.unit_cost, updated_at = NOW()
+		RETURNING id
+	`
+
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		err := tx.QueryRow(ctx, upsertQuery,
+			order.TenantID,
+			order.ID,
+			line.ProductID,
+			i,
+			line.Quantity,
+			line.ReceivedQuantity,
+			line.UnitCost,
+		).Scan(&line.ID)
+		if err != nil {
+			return err
+		}
+		line.PurchaseOrderID = order.ID
+	}
+
+	return nil
+}
+
+func (r *purchaseOrderRepository) loadLines(ctx context.Context, tenantID uuid.UUID, orders []*entities.PurchaseOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.PurchaseOrder, len(orders))
+	ids := make([]uuid.UUID, len(orders))
+	for i, o := range orders {
+		byID[o.ID] = o
+		ids[i] = o.ID
+		o.Lines = []entities.PurchaseOrderLine{}
+	}
+
+	query := `
+		SELECT id, purchase_order_id, product_id, quantity, received_quantity, unit_cost
+		FROM purchase_order_lines
+		WHERE tenant_id = $1 AND purchase_order_id = ANY($2)
+		ORDER BY purchase_order_id, position
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line entities.PurchaseOrderLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.PurchaseOrderID,
+			&line.ProductID,
+			&line.Quantity,
+			&line.ReceivedQuantity,
+			&line.UnitCost,
+		); err != nil {
+			return err
+		}
+		if o, ok := byID[line.PurchaseOrderID]; ok {
+			o.Lines = append(o.Lines, line)
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"fmt"
+	categoryentities "motico-api/internal/domain/category/entities"
+	"motico-api/pkg/money"
+	"motico-api/pkg/query"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type fieldKind int
+
+const (
+	kindText fieldKind = iota
+	kindNumber
+	kindDecimal
+	kindTime
+)
+
+// filterSpec traduce un parámetro de la URL (p. ej. price_gte) a una condición SQL.
+type filterSpec struct {
+	column string
+	op     string
+	kind   fieldKind
+}
+
+// querySpec es la lista blanca de orden y filtros de una entidad. Las columnas
+// vienen siempre de aquí, nunca de la URL, así que es seguro concatenarlas.
+type querySpec struct {
+	sorts        map[string]string
+	filters      map[string]filterSpec
+	defaultOrder string
+	tieBreaker   string
+	// attributes es una columna JSONB filtrable por clave con attr.<clave>=valor
+	// (igualdad) y attr.<clave>_gte / attr.<clave>_lte (rango numérico).
+	attributes string
+	// deletedAt es la columna del borrado lógico: sin include_deleted el listado
+	// solo devuelve las filas con deletedAt nulo.
+	deletedAt string
+}
+
+const attributeFilterPrefix = "attr."
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// apply agrega a sql las condiciones de los filtros y la cláusula ORDER BY,
+// numerando los parámetros a continuación de args.
+func (s querySpec) apply(sql string, args []interface{}, params query.Params) (string, []interface{}, error) {
+	if params.IncludeDeleted && s.deletedAt == "" {
+		return "", nil, &query.ValidationError{Param: query.IncludeDeletedParam, Message: "not supported for this resource"}
+	}
+	if s.deletedAt != "" && !params.IncludeDeleted {
+		sql += ` AND ` + s.deletedAt + ` IS NULL`
+	}
+
+	names := make([]string, 0, len(params.Filters))
+	for name := range params.Filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if s.attributes != "" && strings.HasPrefix(name, attributeFilterPrefix) {
+			var err error
+			if sql, args, err = s.applyAttribute(sql, args, name, params.Filters[name]); err != nil {
+				return "", nil, err
+			}
+			continue
+		}
+
+		spec, ok := s.filters[name]
+		if !ok {
+			return "", nil, &query.ValidationError{Param: name, Message: "unknown filter"}
+		}
+
+		value, err := parseFilterValue(spec.kind, params.Filters[name])
+		if err != nil {
+			return "", nil, &query.ValidationError{Param: name, Message: err.Error()}
+		}
+
+		argPos := len(args) + 1
+		if spec.op == "ILIKE" {
+			sql += fmt.Sprintf(` AND %s ILIKE '%%' || $%d || '%%'`, spec.column, argPos)
+			value = likeEscaper.Replace(value.(string))
+		} else {
+			sql += fmt.Sprintf(` AND %s %s $%d`, spec.column, spec.op, argPos)
+		}
+		args = append(args, value)
+	}
+
+	if len(params.Sort) == 0 {
+		return sql + ` ORDER BY ` + s.defaultOrder, args, nil
+	}
+
+	order := make([]string, 0, len(params.Sort)+1)
+	for _, field := range params.Sort {
+		column, ok := s.sorts[field.Field]
+		if !ok {
+			return "", nil, &query.ValidationError{Param: "sort", Message: fmt.Sprintf("unknown sort field %q", field.Field)}
+		}
+		if field.Desc {
+			column += " DESC"
+		}
+		order = append(order, column)
+	}
+	// Desempate estable para que la paginación no repita ni salte filas
+	order = append(order, s.tieBreaker)
+
+	return sql + ` ORDER BY ` + strings.Join(order, ", "), args, nil
+}
+
+// applyAttribute agrega la condición de un filtro attr.*. La clave va como
+// parámetro; los rangos solo comparan valores numéricos.
+func (s querySpec) applyAttribute(sql string, args []interface{}, name, raw string) (string, []interface{}, error) {
+	key, op := strings.TrimPrefix(name, attributeFilterPrefix), "="
+	switch {
+	case strings.HasSuffix(key, "_gte"):
+		key, op = strings.TrimSuffix(key, "_gte"), ">="
+	case strings.HasSuffix(key, "_lte"):
+		key, op = strings.TrimSuffix(key, "_lte"), "<="
+	}
+	if !categoryentities.ValidAttributeKey(key) {
+		return "", nil, &query.ValidationError{Param: name, Message: "invalid attribute key"}
+	}
+
+	keyPos := len(args) + 1
+	if op == "=" {
+		sql += fmt.Sprintf(` AND %s ->> $%d::text = $%d`, s.attributes, keyPos, keyPos+1)
+		return sql, append(args, key, raw), nil
+	}
+
+	value, err := parseFilterValue(kindNumber, raw)
+	if err != nil {
+		return "", nil, &query.ValidationError{Param: name, Message: err.Error()}
+	}
+	sql += fmt.Sprintf(` AND CASE WHEN jsonb_typeof(%[1]s -> $%[2]d::text) = 'number' THEN (%[1]s ->> $%[2]d::text)::numeric END %[3]s $%[4]d`,
+		s.attributes, keyPos, op, keyPos+1)
+	return sql, append(args, key, value), nil
+}
+
+func parseFilterValue(kind fieldKind, raw string) (interface{}, error) {
+	switch kind {
+	case kindNumber:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return value, nil
+	case kindDecimal:
+		value, err := money.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("must be a decimal number")
+		}
+		return value, nil
+	case kindTime:
+		if value, err := time.Parse(time.RFC3339, raw); err == nil {
+			return value, nil
+		}
+		value, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return nil, fmt.Errorf("must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+		}
+		return value, nil
+	default:
+		return raw, nil
+	}
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"motico-api/pkg/money"
+	"motico-api/pkg/query"
+	"net/url"
+	"testing"
+)
+
+func TestQuerySpecApply(t *testing.T) {
+	values := url.Values{
+		"sort":          {"name,-price"},
+		"price_gte":     {"10.5"},
+		"name_contains": {"50%_off"},
+		"page":          {"2"},
+	}
+	params := query.Parse(values, "page", "limit")
+
+	sql, args, err := productQuerySpec.apply("WHERE p.tenant_id = $1", []interface{}{"tenant"}, params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `WHERE p.tenant_id = $1 AND p.deleted_at IS NULL AND p.name ILIKE '%' || $2 || '%' AND ` + productEffectivePrice + ` >= $3` +
+		` ORDER BY p.name, ` + productEffectivePrice + ` DESC, p.id`
+	if sql != want {
+		t.Errorf("unexpected sql:\n got: %s\nwant: %s", sql, want)
+	}
+	if len(args) != 3 || args[1] != `50\%\_off` || args[2] != money.Amount(105000) {
+		t.Errorf("unexpected args %v", args)
+	}
+}
+
+func TestQuerySpecApplyDefaultOrder(t *testing.T) {
+	sql, _, err := storeQuerySpec.apply("WHERE tenant_id = $1", nil, query.Params{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql != "WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC" {
+		t.Errorf("unexpected sql %q", sql)
+	}
+}
+
+func TestQuerySpecApplyAttributes(t *testing.T) {
+	values := url.Values{
+		"attr.thread_size":      {"M10"},
+		"attr.displacement_gte": {"125"},
+	}
+
+	sql, args, err := productQuerySpec.apply("WHERE p.tenant_id = $1", []interface{}{"tenant"}, query.Parse(values))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `WHERE p.tenant_id = $1 AND p.deleted_at IS NULL` +
+		` AND CASE WHEN jsonb_typeof(p.attributes -> $2::text) = 'number' THEN (p.attributes ->> $2::text)::numeric END >= $3` +
+		` AND p.attributes ->> $4::text = $5 ORDER BY p.created_at DESC`
+	if sql != want {
+		t.Errorf("unexpected sql:\n got: %s\nwant: %s", sql, want)
+	}
+	if len(args) != 5 || args[1] != "displacement" || args[2] != 125.0 || args[3] != "thread_size" || args[4] != "M10" {
+		t.Errorf("unexpected args %v", args)
+	}
+}
+
+func TestQuerySpecApplyIncludeDeleted(t *testing.T) {
+	params := query.Parse(url.Values{"include_deleted": {"true"}})
+	if !params.IncludeDeleted || len(params.Filters) != 0 {
+		t.Fatalf("unexpected params %+v", params)
+	}
+
+	sql, _, err := storeQuerySpec.apply("WHERE tenant_id = $1", nil, params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql != "WHERE tenant_id = $1 ORDER BY created_at DESC" {
+		t.Errorf("unexpected sql %q", sql)
+	}
+
+	_, _, err = supplierQuerySpec.apply("WHERE tenant_id = $1", nil, params)
+	if validationErr, ok := query.AsValidationError(err); !ok || validationErr.Param != "include_deleted" {
+		t.Errorf("expected include_deleted validation error, got %v", err)
+	}
+}
+
+func TestQuerySpecApplyValidation(t *testing.T) {
+	tests := []struct {
+		values url.Values
+		param  string
+	}{
+		{url.Values{"sort": {"password"}}, "sort"},
+		{url.Values{"color": {"red"}}, "color"},
+		{url.Values{"price_gte": {"cheap"}}, "price_gte"},
+		{url.Values{"created_after": {"yesterday"}}, "created_after"},
+		{url.Values{"attr.Bore Size": {"10"}}, "attr.Bore Size"},
+		{url.Values{"attr.displacement_gte": {"big"}}, "attr.displacement_gte"},
+	}
+
+	for _, tt := range tests {
+		_, _, err := productQuerySpec.apply("", nil, query.Parse(tt.values))
+		validationErr, ok := query.AsValidationError(err)
+		if !ok {
+			t.Errorf("%v: expected validation error, got %v", tt.values, err)
+			continue
+		}
+		if validationErr.Param != tt.param {
+			t.Errorf("%v: expected param %s, got %s", tt.values, tt.param, validationErr.Param)
+		}
+	}
+}
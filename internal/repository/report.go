@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"motico-api/internal/domain/report"
+	"motico-api/internal/domain/report/entities"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type reportRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewReportRepository(pool *pgxpool.Pool) report.Repository {
+	return &reportRepository{pool: pool}
+}
+
+// InventoryValuation toma el último movimiento de cada producto hasta asOf y suma
+// sus saldos por sucursal y categoría.
+func (r *reportRepository) InventoryValuation(ctx context.Context, tenantID uuid.UUID, asOf time.Time, storeID *uuid.UUID) ([]*entities.ValuationRow, error) {
+	query := `
+		WITH balances AS (
+			SELECT DISTINCT ON (m.product_id) m.product_id, m.quantity_after, m.value_after
+			FROM stock_movements m
+			WHERE m.tenant_id = $1 AND m.created_at <= $2
+			ORDER BY m.product_id, m.created_at DESC, m.seq DESC
+		)
+		SELECT s.id, s.name, c.id, c.name, SUM(b.quantity_after)::INTEGER, SUM(b.value_after)
+		FROM balances b
+		JOIN products p ON p.id = b.product_id
+		JOIN stores s ON s.id = p.store_id
+		JOIN categories c ON c.id = p.category_id
+		WHERE b.quantity_after <> 0 AND ($3::UUID IS NULL OR p.store_id = $3)
+		GROUP BY s.id, s.name, c.id, c.name
+		ORDER BY s.name, s.id, c.name
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, asOf, storeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.ValuationRow
+	for rows.Next() {
+		var row entities.ValuationRow
+		if err := rows.Scan(
+			&row.StoreID,
+			&row.StoreName,
+			&row.CategoryID,
+			&row.CategoryName,
+			&row.Quantity,
+			&row.Value,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	return result, rows.Err()
+}
+
+// DailySales agrupa por día de cumplimiento las órdenes de venta de la sucursal. El
+// costo sale de los movimientos de stock que generó cada orden.
+func (r *reportRepository) DailySales(ctx context.Context, tenantID, storeID uuid.UUID, from, to time.Time) ([]*entities.DailySalesRow, error) {
+	query := `
+		SELECT so.fulfilled_at::DATE AS day, COUNT(*)::INTEGER,
+			COALESCE(SUM(l.units), 0)::INTEGER, COALESCE(SUM(l.revenue), 0), COALESCE(SUM(m.cost), 0)
+		FROM sales_orders so
+		CROSS JOIN LATERAL (
+			SELECT SUM(quantity) AS units, SUM(quantity * unit_price) AS revenue
+			FROM sales_order_lines
+			WHERE sales_order_id = so.id
+		) l
+		CROSS JOIN LATERAL (
+			SELECT SUM(total_cost) AS cost
+			FROM stock_movements
+			WHERE tenant_id = so.tenant_id AND reference_type = 'sales_order' AND reference_id = so.id AND reason = 'sale'
+		) m
+		WHERE so.tenant_id = $1 AND so.store_id = $2 AND so.status = 'fulfilled'
+			AND so.fulfilled_at >= $3 AND so.fulfilled_at < $4
+		GROUP BY day
+		ORDER BY day
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.DailySalesRow
+	for rows.Next() {
+		var row entities.DailySalesRow
+		if err := rows.Scan(
+			&row.Date,
+			&row.Orders,
+			&row.Units,
+			&row.Revenue,
+			&row.Cost,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	return result, rows.Err()
+}
+
+// ExpiringLots valoriza cada lote al costo promedio del stock de su producto.
+func (r *reportRepository) ExpiringLots(ctx context.Context, tenantID uuid.UUID, until time.Time, storeID *uuid.UUID) ([]*entities.ExpiringLotRow, error) {
+	query := `
+		SELECT s.id, s.name, p.id, p.name, p.sku, l.lot_number, l.expires_at, l.quantity,
+			COALESCE(ROUND(l.quantity * st.inventory_value / NULLIF(st.quantity, 0), 4), 0)
+		FROM stock_lots l
+		JOIN products p ON p.id = l.product_id
+		JOIN stores s ON s.id = p.store_id
+		LEFT JOIN stock st ON st.product_id = l.product_id
+		WHERE l.tenant_id = $1 AND l.quantity > 0 AND l.expires_at IS NOT NULL AND l.expires_at < $2
+			AND ($3::UUID IS NULL OR p.store_id = $3)
+		ORDER BY l.expires_at, s.name, p.name, l.lot_number
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, until, storeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.ExpiringLotRow
+	for rows.Next() {
+		var row entities.ExpiringLotRow
+		if err := rows.Scan(
+			&row.StoreID,
+			&row.StoreName,
+			&row.ProductID,
+			&row.ProductName,
+			&row.SKU,
+			&row.LotNumber,
+			&row.ExpiresAt,
+			&row.Quantity,
+			&row.Value,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+
+	return result, rows.Err()
+}
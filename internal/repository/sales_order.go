@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/salesorder"
+	"motico-api/internal/domain/salesorder/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type salesOrderRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSalesOrderRepository(pool *pgxpool.Pool) salesorder.Repository {
+	return &salesOrderRepository{pool: pool}
+}
+
+const salesOrderColumns = `id, tenant_id, store_id, status, currency, customer_name, notes, created_by,
+			fulfilled_at, fulfilled_by, cancelled_at, cancelled_by, created_at, updated_at`
+
+func (r *salesOrderRepository) Create(ctx context.Context, order *entities.SalesOrder) error {
+	query := `
+		INSERT INTO sales_orders (id, tenant_id, store_id, status, currency, customer_name, notes, created_by, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		order.TenantID,
+		order.StoreID,
+		order.Status,
+		order.Currency,
+		order.CustomerName,
+		order.Notes,
+		order.CreatedBy,
+	).Scan(
+		&order.ID,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := r.saveLines(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *salesOrderRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error) {
+	return r.get(ctx, tenantID, id, false)
+}
+
+// GetByIDForUpdate bloquea la fila de la orden hasta el fin de la transacción,
+// así una entrega y una cancelación concurrentes no parten del mismo estado.
+func (r *salesOrderRepository) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.SalesOrder, error) {
+	return r.get(ctx, tenantID, id, true)
+}
+
+func (r *salesOrderRepository) get(ctx context.Context, tenantID, id uuid.UUID, lock bool) (*entities.SalesOrder, error) {
+	query := `
+		SELECT ` + salesOrderColumns + `
+		FROM sales_orders
+		WHERE id = $1 AND tenant_id = $2
+	`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	order, err := scanSalesOrder(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrSalesOrderNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, []*entities.SalesOrder{order}); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// salesOrderQuerySpec define el orden y los filtros genéricos del listado de órdenes de venta.
+var salesOrderQuerySpec = querySpec{
+	sorts: map[string]string{
+		"status":       "status",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+		"fulfilled_at": "fulfilled_at",
+	},
+	filters: map[string]filterSpec{
+		"created_after":   {column: "created_at", op: ">", kind: kindTime},
+		"created_before":  {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":   {column: "updated_at", op: ">=", kind: kindTime},
+		"fulfilled_after": {column: "fulfilled_at", op: ">", kind: kindTime},
+	},
+	defaultOrder: "created_at DESC",
+	tieBreaker:   "id",
+}
+
+func (r *salesOrderRepository) List(ctx context.Context, tenantID uuid.UUID, filter salesorder.ListFilter, limit, offset int) ([]*entities.SalesOrder, error) {
+	query := `
+		SELECT ` + salesOrderColumns + `
+		FROM sales_orders
+		WHERE tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+	argPos := 2
+
+	if filter.Status != nil {
+		query += ` AND status = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	if filter.StoreID != nil {
+		query += ` AND store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.StoreID)
+		argPos++
+	}
+
+	query, args, err := salesOrderQuerySpec.apply(query, args, filter.Query)
+	if err != nil {
+		return nil, err
+	}
+	argPos = len(args) + 1
+
+	query += ` LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orders []*entities.SalesOrder
+	for rows.Next() {
+		order, err := scanSalesOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// Update persiste el estado de la orden. Las líneas y sus precios quedan fijos
+// desde la creación.
+func (r *salesOrderRepository) Update(ctx context.Context, order *entities.SalesOrder) error {
+	query := `
+		UPDATE sales_orders
+		SET status = $1, customer_name = $2, notes = $3, fulfilled_at = $4, fulfilled_by = $5,
+			cancelled_at = $6, cancelled_by = $7, updated_at = NOW()
+		WHERE id = $8 AND tenant_id = $9
+		RETURNING updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		order.Status,
+		order.CustomerName,
+		order.Notes,
+		order.FulfilledAt,
+		order.FulfilledBy,
+		order.CancelledAt,
+		order.CancelledBy,
+		order.ID,
+		order.TenantID,
+	).Scan(&order.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrSalesOrderNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func scanSalesOrder(row pgx.Row) (*entities.SalesOrder, error) {
+	var order entities.SalesOrder
+	err := row.Scan(
+		&order.ID,
+		&order.TenantID,
+		&order.StoreID,
+		&order.Status,
+		&order.Currency,
+		&order.CustomerName,
+		&order.Notes,
+		&order.CreatedBy,
+		&order.FulfilledAt,
+		&order.FulfilledBy,
+		&order.CancelledAt,
+		&order.CancelledBy,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *salesOrderRepository) saveLines(ctx context.Context, tx pgx.Tx, order *entities.SalesOrder) error {
+	query := `
+		INSERT INTO sales_order_lines (id, tenant_id, sales_order_id, product_id, position, quantity, unit_price, serials, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, COALESCE($7::TEXT[], '{}'), NOW(), NOW())
+		RETURNING id
+	`
+
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		err := tx.QueryRow(ctx, query,
+			order.TenantID,
+			order.ID,
+			line.ProductID,
+			i,
+			line.Quantity,
+			line.UnitPrice,
+			line.Serials,
+		).Scan(&line.ID)
+		if err != nil {
+			return err
+		}
+		line.SalesOrderID = order.ID
+	}
+
+	return nil
+}
+
+func (r *salesOrderRepository) loadLines(ctx context.Context, tenantID uuid.UUID, orders []*entities.SalesOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.SalesOrder, len(orders))
+	ids := make([]uuid.UUID, len(orders))
+	for i, o := range orders {
+		byID[o.ID] = o
+		ids[i] = o.ID
+		o.Lines = []entities.SalesOrderLine{}
+	}
+
+	query := `
+		SELECT id, sales_order_id, product_id, quantity, unit_price, serials
+		FROM sales_order_lines
+		WHERE tenant_id = $1 AND sales_order_id = ANY($2)
+		ORDER BY sales_order_id, position
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line entities.SalesOrderLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.SalesOrderID,
+			&line.ProductID,
+			&line.Quantity,
+			&line.UnitPrice,
+			&line.Serials,
+		); err != nil {
+			return err
+		}
+		if o, ok := byID[line.SalesOrderID]; ok {
+			o.Lines = append(o.Lines, line)
+		}
+	}
+
+	return rows.Err()
+}
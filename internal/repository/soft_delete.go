@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// purgeDeleted borra definitivamente, en lotes de batchSize, las filas de table
+// borradas lógicamente antes de before. Recorre por id para no volver sobre las
+// filas que no se pudieron borrar: las que siguen referenciadas (p. ej. un
+// producto con ventas) se saltan y quedan borradas lógicamente. table viene
+// siempre del repositorio, nunca de la entrada.
+func purgeDeleted(ctx context.Context, pool *pgxpool.Pool, table string, before time.Time, batchSize int) (int, error) {
+	selectQuery := `SELECT id FROM ` + table + ` WHERE deleted_at < $1 AND id > $2 ORDER BY id LIMIT $3`
+	deleteQuery := `DELETE FROM ` + table + ` WHERE id = $1 AND deleted_at < $2`
+
+	purged := 0
+	lastID := uuid.Nil
+	for {
+		rows, err := pool.Query(ctx, selectQuery, before, lastID, batchSize)
+		if err != nil {
+			return purged, err
+		}
+		var ids []uuid.UUID
+		for rows.Next() {
+			var id uuid.UUID
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return purged, err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return purged, err
+		}
+
+		for _, id := range ids {
+			// Sin transacción: cada borrado se confirma solo y una violación de
+			// clave foránea no aborta el resto del lote
+			result, err := pool.Exec(ctx, deleteQuery, id, before)
+			if err != nil {
+				if isForeignKeyViolation(err) {
+					continue
+				}
+				return purged, err
+			}
+			purged += int(result.RowsAffected())
+		}
+
+		if len(ids) < batchSize {
+			return purged, nil
+		}
+		lastID = ids[len(ids)-1]
+	}
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	catalogentities "motico-api/internal/domain/catalog/entities"
 	"motico-api/internal/domain/stock"
 	"motico-api/internal/domain/stock/entities"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -18,20 +20,25 @@ func NewStockRepository(pool *pgxpool.Pool) stock.Repository {
 	return &stockRepository{pool: pool}
 }
 
+// GetByProductID bloquea la fila hasta el fin de la transacción: quien la lee para
+// modificarla la guarda con Update sin que otra transacción la cambie en el medio.
 func (r *stockRepository) GetByProductID(ctx context.Context, tenantID, productID uuid.UUID) (*entities.Stock, error) {
 	query := `
-		SELECT id, tenant_id, product_id, quantity, reserved_quantity, created_at, updated_at
+		SELECT id, tenant_id, product_id, quantity, reserved_quantity, quarantined_quantity, inventory_value, created_at, updated_at
 		FROM stock
 		WHERE tenant_id = $1 AND product_id = $2
+		FOR UPDATE
 	`
 
 	var stock entities.Stock
-	err := r.pool.QueryRow(ctx, query, tenantID, productID).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(
 		&stock.ID,
 		&stock.TenantID,
 		&stock.ProductID,
 		&stock.Quantity,
 		&stock.ReservedQuantity,
+		&stock.QuarantinedQuantity,
+		&stock.InventoryValue,
 		&stock.CreatedAt,
 		&stock.UpdatedAt,
 	)
@@ -45,18 +52,44 @@ func (r *stockRepository) GetByProductID(ctx context.Context, tenantID, productI
 	return &stock, nil
 }
 
+func (r *stockRepository) SumByParentProduct(ctx context.Context, tenantID, parentID uuid.UUID) (*entities.Stock, error) {
+	query := `
+		SELECT COALESCE(SUM(s.quantity), 0)::INTEGER, COALESCE(SUM(s.reserved_quantity), 0)::INTEGER,
+			COALESCE(SUM(s.quarantined_quantity), 0)::INTEGER, COALESCE(SUM(s.inventory_value), 0), COALESCE(MAX(s.updated_at), NOW())
+		FROM stock s
+		JOIN products p ON p.id = s.product_id
+		WHERE s.tenant_id = $1 AND p.parent_id = $2 AND p.deleted_at IS NULL
+	`
+
+	stock := entities.Stock{TenantID: tenantID, ProductID: parentID}
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, parentID).Scan(
+		&stock.Quantity,
+		&stock.ReservedQuantity,
+		&stock.QuarantinedQuantity,
+		&stock.InventoryValue,
+		&stock.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stock, nil
+}
+
 func (r *stockRepository) Create(ctx context.Context, stock *entities.Stock) error {
 	query := `
-		INSERT INTO stock (id, tenant_id, product_id, quantity, reserved_quantity, created_at, updated_at)
-		VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW(), NOW())
+		INSERT INTO stock (id, tenant_id, product_id, quantity, reserved_quantity, quarantined_quantity, inventory_value, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query,
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
 		stock.TenantID,
 		stock.ProductID,
 		stock.Quantity,
 		stock.ReservedQuantity,
+		stock.QuarantinedQuantity,
+		stock.InventoryValue,
 	).Scan(
 		&stock.ID,
 		&stock.CreatedAt,
@@ -69,20 +102,23 @@ func (r *stockRepository) Create(ctx context.Context, stock *entities.Stock) err
 	return nil
 }
 
+// Update no escribe reserved_quantity: solo lo cambian Reserve, Release y
+// SetReservedQuantity. Devuelve en stock el valor vigente.
 func (r *stockRepository) Update(ctx context.Context, stock *entities.Stock) error {
 	query := `
 		UPDATE stock
-		SET quantity = $1, reserved_quantity = $2, updated_at = NOW()
-		WHERE tenant_id = $3 AND product_id = $4
-		RETURNING updated_at
+		SET quantity = $1, quarantined_quantity = $2, inventory_value = $3, updated_at = NOW()
+		WHERE tenant_id = $4 AND product_id = $5
+		RETURNING reserved_quantity, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query,
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
 		stock.Quantity,
-		stock.ReservedQuantity,
+		stock.QuarantinedQuantity,
+		stock.InventoryValue,
 		stock.TenantID,
 		stock.ProductID,
-	).Scan(&stock.UpdatedAt)
+	).Scan(&stock.ReservedQuantity, &stock.UpdatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return entities.ErrStockNotFound
@@ -93,17 +129,38 @@ func (r *stockRepository) Update(ctx context.Context, stock *entities.Stock) err
 	return nil
 }
 
-func (r *stockRepository) Reserve(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error {
-	query := `
+// Reserve registra la reserva y suma su cantidad a stock.reserved_quantity en la
+// misma transacción. Si el dueño ya tenía una reserva del producto se acumula y
+// conserva el vencimiento más tardío; GREATEST ignora los NULL, así que una
+// reserva sin vencimiento no borra el que ya tenía.
+func (r *stockRepository) Reserve(ctx context.Context, reservation *entities.Reservation) error {
+	stockQuery := `
 		UPDATE stock
 		SET reserved_quantity = reserved_quantity + $1, updated_at = NOW()
 		WHERE tenant_id = $2 AND product_id = $3
-			AND (quantity - reserved_quantity) >= $1
+			AND (quantity - reserved_quantity - quarantined_quantity) >= $1
 		RETURNING id
 	`
 
+	reservationQuery := `
+		INSERT INTO stock_reservations (id, tenant_id, product_id, owner_type, owner_id, quantity, expires_at, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (tenant_id, product_id, owner_type, owner_id) DO UPDATE
+		SET quantity = stock_reservations.quantity + EXCLUDED.quantity,
+			expires_at = GREATEST(stock_reservations.expires_at, EXCLUDED.expires_at), updated_at = NOW()
+		RETURNING id, quantity, expires_at, created_at, updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
 	var id uuid.UUID
-	err := r.pool.QueryRow(ctx, query, quantity, tenantID, productID).Scan(&id)
+	err = tx.QueryRow(ctx, stockQuery, reservation.Quantity, reservation.TenantID, reservation.ProductID).Scan(&id)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return entities.ErrInsufficientStock
@@ -111,11 +168,69 @@ func (r *stockRepository) Reserve(ctx context.Context, tenantID, productID uuid.
 		return err
 	}
 
-	return nil
+	err = tx.QueryRow(ctx, reservationQuery,
+		reservation.TenantID,
+		reservation.ProductID,
+		reservation.Owner.Type,
+		reservation.Owner.ID,
+		reservation.Quantity,
+		reservation.ExpiresAt,
+	).Scan(
+		&reservation.ID,
+		&reservation.Quantity,
+		&reservation.ExpiresAt,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
-func (r *stockRepository) Release(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error {
-	query := `
+// Release descuenta cantidad de la reserva del dueño (la elimina si llega a cero)
+// y de stock.reserved_quantity en la misma transacción.
+func (r *stockRepository) Release(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, quantity int) error {
+	selectQuery := `
+		SELECT id, quantity
+		FROM stock_reservations
+		WHERE tenant_id = $1 AND product_id = $2 AND owner_type = $3 AND owner_id = $4
+		FOR UPDATE
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var reservationID uuid.UUID
+	var reserved int
+	err = tx.QueryRow(ctx, selectQuery, tenantID, productID, owner.Type, owner.ID).Scan(&reservationID, &reserved)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrReservationNotFound
+		}
+		return err
+	}
+
+	if quantity > reserved {
+		return entities.ErrReleaseExceedsReservation
+	}
+
+	if quantity == reserved {
+		_, err = tx.Exec(ctx, `DELETE FROM stock_reservations WHERE id = $1`, reservationID)
+	} else {
+		_, err = tx.Exec(ctx, `UPDATE stock_reservations SET quantity = quantity - $1, updated_at = NOW() WHERE id = $2`, quantity, reservationID)
+	}
+	if err != nil {
+		return err
+	}
+
+	stockQuery := `
 		UPDATE stock
 		SET reserved_quantity = GREATEST(0, reserved_quantity - $1), updated_at = NOW()
 		WHERE tenant_id = $2 AND product_id = $3
@@ -123,7 +238,7 @@ func (r *stockRepository) Release(ctx context.Context, tenantID, productID uuid.
 	`
 
 	var id uuid.UUID
-	err := r.pool.QueryRow(ctx, query, quantity, tenantID, productID).Scan(&id)
+	err = tx.QueryRow(ctx, stockQuery, quantity, tenantID, productID).Scan(&id)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return entities.ErrStockNotFound
@@ -131,5 +246,919 @@ func (r *stockRepository) Release(ctx context.Context, tenantID, productID uuid.
 		return err
 	}
 
+	return tx.Commit(ctx)
+}
+
+func (r *stockRepository) ListReservations(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Reservation, error) {
+	query := `
+		SELECT id, tenant_id, product_id, owner_type, owner_id, quantity, expires_at, created_at, updated_at
+		FROM stock_reservations
+		WHERE tenant_id = $1 AND product_id = $2
+		ORDER BY created_at
+	`
+
+	return r.queryReservations(ctx, query, tenantID, productID)
+}
+
+func (r *stockRepository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]*entities.Reservation, error) {
+	query := `
+		SELECT id, tenant_id, product_id, owner_type, owner_id, quantity, expires_at, created_at, updated_at
+		FROM stock_reservations
+		WHERE expires_at IS NOT NULL AND expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2
+	`
+
+	return r.queryReservations(ctx, query, now, limit)
+}
+
+func (r *stockRepository) queryReservations(ctx context.Context, query string, args ...interface{}) ([]*entities.Reservation, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reservations []*entities.Reservation
+	for rows.Next() {
+		var reservation entities.Reservation
+		if err := rows.Scan(
+			&reservation.ID,
+			&reservation.TenantID,
+			&reservation.ProductID,
+			&reservation.Owner.Type,
+			&reservation.Owner.ID,
+			&reservation.Quantity,
+			&reservation.ExpiresAt,
+			&reservation.CreatedAt,
+			&reservation.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reservations = append(reservations, &reservation)
+	}
+
+	return reservations, rows.Err()
+}
+
+func (r *stockRepository) ListReservationDrift(ctx context.Context, tenantID *uuid.UUID) ([]*entities.ReservationDrift, error) {
+	query := `
+		WITH expected AS (
+			SELECT tenant_id, product_id, SUM(quantity) AS reserved
+			FROM stock_reservations
+			GROUP BY tenant_id, product_id
+		)
+		SELECT s.tenant_id, s.product_id, s.quantity, s.reserved_quantity, COALESCE(e.reserved, 0)::INTEGER
+		FROM stock s
+		LEFT JOIN expected e ON e.tenant_id = s.tenant_id AND e.product_id = s.product_id
+		WHERE s.reserved_quantity <> COALESCE(e.reserved, 0)
+			AND ($1::UUID IS NULL OR s.tenant_id = $1)
+		ORDER BY s.tenant_id, s.product_id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var drifts []*entities.ReservationDrift
+	for rows.Next() {
+		var drift entities.ReservationDrift
+		if err := rows.Scan(
+			&drift.TenantID,
+			&drift.ProductID,
+			&drift.Quantity,
+			&drift.ReservedQuantity,
+			&drift.ExpectedReserved,
+		); err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, &drift)
+	}
+
+	return drifts, rows.Err()
+}
+
+func (r *stockRepository) SetReservedQuantity(ctx context.Context, tenantID, productID uuid.UUID, quantity int) error {
+	query := `
+		UPDATE stock
+		SET reserved_quantity = $1, updated_at = NOW()
+		WHERE tenant_id = $2 AND product_id = $3
+	`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, quantity, tenantID, productID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrStockNotFound
+	}
+
 	return nil
 }
+
+func (r *stockRepository) CostingMethod(ctx context.Context, tenantID uuid.UUID) (entities.CostingMethod, error) {
+	var method entities.CostingMethod
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT costing_method FROM tenants WHERE id = $1`, tenantID).Scan(&method)
+	if err != nil {
+		return "", err
+	}
+	return method, nil
+}
+
+// ListOpenCostLayers devuelve las capas con unidades pendientes, las más antiguas
+// primero, bloqueadas hasta el fin de la transacción.
+func (r *stockRepository) ListOpenCostLayers(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.CostLayer, error) {
+	query := `
+		SELECT id, tenant_id, product_id, unit_cost, quantity, remaining_quantity, received_at
+		FROM stock_cost_layers
+		WHERE tenant_id = $1 AND product_id = $2 AND remaining_quantity > 0
+		ORDER BY received_at, id
+		FOR UPDATE
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var layers []*entities.CostLayer
+	for rows.Next() {
+		var layer entities.CostLayer
+		if err := rows.Scan(
+			&layer.ID,
+			&layer.TenantID,
+			&layer.ProductID,
+			&layer.UnitCost,
+			&layer.Quantity,
+			&layer.RemainingQuantity,
+			&layer.ReceivedAt,
+		); err != nil {
+			return nil, err
+		}
+		layers = append(layers, &layer)
+	}
+
+	return layers, rows.Err()
+}
+
+func (r *stockRepository) CreateCostLayer(ctx context.Context, layer *entities.CostLayer) error {
+	query := `
+		INSERT INTO stock_cost_layers (id, tenant_id, product_id, unit_cost, quantity, remaining_quantity, received_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, clock_timestamp())
+		RETURNING id, received_at
+	`
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		layer.TenantID,
+		layer.ProductID,
+		layer.UnitCost,
+		layer.Quantity,
+		layer.RemainingQuantity,
+	).Scan(&layer.ID, &layer.ReceivedAt)
+}
+
+func (r *stockRepository) UpdateCostLayer(ctx context.Context, layer *entities.CostLayer) error {
+	query := `UPDATE stock_cost_layers SET remaining_quantity = $1 WHERE tenant_id = $2 AND id = $3`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query, layer.RemainingQuantity, layer.TenantID, layer.ID)
+	return err
+}
+
+func (r *stockRepository) CreateMovement(ctx context.Context, movement *entities.Movement) error {
+	query := `
+		INSERT INTO stock_movements (id, tenant_id, product_id, reason, quantity, unit_cost, total_cost,
+			quantity_after, value_after, reference_type, reference_id, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+		RETURNING id, created_at
+	`
+
+	var referenceType *string
+	var referenceID *uuid.UUID
+	if movement.Reference != nil {
+		referenceType = &movement.Reference.Type
+		referenceID = &movement.Reference.ID
+	}
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		movement.TenantID,
+		movement.ProductID,
+		movement.Reason,
+		movement.Quantity,
+		movement.UnitCost,
+		movement.TotalCost,
+		movement.QuantityAfter,
+		movement.ValueAfter,
+		referenceType,
+		referenceID,
+	).Scan(&movement.ID, &movement.CreatedAt)
+}
+
+func (r *stockRepository) ListMovementsByReference(ctx context.Context, tenantID uuid.UUID, reference entities.MovementReference) ([]*entities.Movement, error) {
+	query := `
+		SELECT id, tenant_id, product_id, reason, quantity, unit_cost, total_cost, quantity_after, value_after, created_at
+		FROM stock_movements
+		WHERE tenant_id = $1 AND reference_type = $2 AND reference_id = $3
+		ORDER BY seq
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, reference.Type, reference.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []*entities.Movement
+	for rows.Next() {
+		movement := entities.Movement{Reference: &entities.MovementReference{Type: reference.Type, ID: reference.ID}}
+		if err := rows.Scan(
+			&movement.ID,
+			&movement.TenantID,
+			&movement.ProductID,
+			&movement.Reason,
+			&movement.Quantity,
+			&movement.UnitCost,
+			&movement.TotalCost,
+			&movement.QuantityAfter,
+			&movement.ValueAfter,
+			&movement.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		movements = append(movements, &movement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLotMovements(ctx, tenantID, movements); err != nil {
+		return nil, err
+	}
+	if err := r.loadSerialMovements(ctx, tenantID, movements); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+// loadLotMovements agrega a cada movimiento su desglose por lote.
+func (r *stockRepository) loadLotMovements(ctx context.Context, tenantID uuid.UUID, movements []*entities.Movement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.Movement, len(movements))
+	ids := make([]uuid.UUID, len(movements))
+	for i, m := range movements {
+		byID[m.ID] = m
+		ids[i] = m.ID
+	}
+
+	query := `
+		SELECT lm.movement_id, l.id, l.lot_number, l.expires_at, lm.quantity
+		FROM stock_lot_movements lm
+		JOIN stock_lots l ON l.id = lm.lot_id
+		WHERE lm.tenant_id = $1 AND lm.movement_id = ANY($2)
+		ORDER BY lm.movement_id, l.expires_at NULLS LAST, l.lot_number
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movementID uuid.UUID
+		var lot entities.LotMovement
+		if err := rows.Scan(&movementID, &lot.LotID, &lot.LotNumber, &lot.ExpiresAt, &lot.Quantity); err != nil {
+			return err
+		}
+		if m, ok := byID[movementID]; ok {
+			m.Lots = append(m.Lots, lot)
+		}
+	}
+
+	return rows.Err()
+}
+
+// loadSerialMovements agrega a cada movimiento los números de serie que movió.
+func (r *stockRepository) loadSerialMovements(ctx context.Context, tenantID uuid.UUID, movements []*entities.Movement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.Movement, len(movements))
+	ids := make([]uuid.UUID, len(movements))
+	for i, m := range movements {
+		byID[m.ID] = m
+		ids[i] = m.ID
+	}
+
+	query := `
+		SELECT e.movement_id, u.serial_number
+		FROM serial_unit_events e
+		JOIN serial_units u ON u.id = e.unit_id
+		WHERE e.tenant_id = $1 AND e.movement_id = ANY($2)
+		ORDER BY e.movement_id, e.seq
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movementID uuid.UUID
+		var serial string
+		if err := rows.Scan(&movementID, &serial); err != nil {
+			return err
+		}
+		if m, ok := byID[movementID]; ok {
+			m.Serials = append(m.Serials, serial)
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *stockRepository) ProductTracking(ctx context.Context, tenantID, productID uuid.UUID) (catalogentities.Tracking, error) {
+	query := `
+		SELECT c.tracking
+		FROM products p
+		JOIN catalog_items c ON c.id = p.catalog_item_id
+		WHERE p.tenant_id = $1 AND p.id = $2
+	`
+
+	var tracking catalogentities.Tracking
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(&tracking)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return catalogentities.TrackingNone, nil
+		}
+		return "", err
+	}
+	return tracking, nil
+}
+
+const lotColumns = `id, tenant_id, product_id, lot_number, expires_at, quantity, reserved_quantity, received_at, updated_at`
+
+// ListLots devuelve los lotes con unidades bloqueados hasta el fin de la transacción.
+func (r *stockRepository) ListLots(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.Lot, error) {
+	query := `
+		SELECT ` + lotColumns + `
+		FROM stock_lots
+		WHERE tenant_id = $1 AND product_id = $2 AND quantity > 0
+		ORDER BY expires_at NULLS LAST, received_at, id
+		FOR UPDATE
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lots []*entities.Lot
+	for rows.Next() {
+		lot, err := scanLot(rows)
+		if err != nil {
+			return nil, err
+		}
+		lots = append(lots, lot)
+	}
+
+	return lots, rows.Err()
+}
+
+func (r *stockRepository) GetLot(ctx context.Context, tenantID, productID uuid.UUID, lotNumber string) (*entities.Lot, error) {
+	query := `
+		SELECT ` + lotColumns + `
+		FROM stock_lots
+		WHERE tenant_id = $1 AND product_id = $2 AND lot_number = $3
+		FOR UPDATE
+	`
+
+	lot, err := scanLot(conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID, lotNumber))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrLotNotFound
+		}
+		return nil, err
+	}
+	return lot, nil
+}
+
+func (r *stockRepository) CreateLot(ctx context.Context, lot *entities.Lot) error {
+	query := `
+		INSERT INTO stock_lots (id, tenant_id, product_id, lot_number, expires_at, quantity, reserved_quantity, received_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, clock_timestamp(), NOW())
+		RETURNING id, received_at, updated_at
+	`
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		lot.TenantID,
+		lot.ProductID,
+		lot.LotNumber,
+		lot.ExpiresAt,
+		lot.Quantity,
+		lot.ReservedQuantity,
+	).Scan(&lot.ID, &lot.ReceivedAt, &lot.UpdatedAt)
+}
+
+func (r *stockRepository) UpdateLot(ctx context.Context, lot *entities.Lot) error {
+	query := `
+		UPDATE stock_lots
+		SET quantity = $1, reserved_quantity = $2, expires_at = $3, updated_at = NOW()
+		WHERE tenant_id = $4 AND id = $5
+		RETURNING updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		lot.Quantity,
+		lot.ReservedQuantity,
+		lot.ExpiresAt,
+		lot.TenantID,
+		lot.ID,
+	).Scan(&lot.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return entities.ErrLotNotFound
+	}
+	return err
+}
+
+func (r *stockRepository) CreateLotMovements(ctx context.Context, tenantID, movementID uuid.UUID, lots []entities.LotMovement) error {
+	query := `
+		INSERT INTO stock_lot_movements (id, tenant_id, movement_id, lot_id, quantity)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4)
+	`
+
+	for _, lot := range lots {
+		if _, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, movementID, lot.LotID, lot.Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *stockRepository) ListLotReservations(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner) ([]*entities.LotReservation, error) {
+	query := `
+		SELECT lr.tenant_id, lr.lot_id, lr.owner_type, lr.owner_id, lr.quantity, l.expires_at
+		FROM stock_lot_reservations lr
+		JOIN stock_lots l ON l.id = lr.lot_id
+		WHERE lr.tenant_id = $1 AND l.product_id = $2 AND lr.owner_type = $3 AND lr.owner_id = $4
+		ORDER BY l.expires_at DESC NULLS FIRST, l.received_at DESC
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, productID, owner.Type, owner.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reservations []*entities.LotReservation
+	for rows.Next() {
+		var reservation entities.LotReservation
+		if err := rows.Scan(
+			&reservation.TenantID,
+			&reservation.LotID,
+			&reservation.Owner.Type,
+			&reservation.Owner.ID,
+			&reservation.Quantity,
+			&reservation.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		reservations = append(reservations, &reservation)
+	}
+
+	return reservations, rows.Err()
+}
+
+func (r *stockRepository) SaveLotReservation(ctx context.Context, reservation *entities.LotReservation) error {
+	if reservation.Quantity <= 0 {
+		query := `DELETE FROM stock_lot_reservations WHERE tenant_id = $1 AND lot_id = $2 AND owner_type = $3 AND owner_id = $4`
+		_, err := conn(ctx, r.pool).Exec(ctx, query,
+			reservation.TenantID,
+			reservation.LotID,
+			reservation.Owner.Type,
+			reservation.Owner.ID,
+		)
+		return err
+	}
+
+	query := `
+		INSERT INTO stock_lot_reservations (id, tenant_id, lot_id, owner_type, owner_id, quantity)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5)
+		ON CONFLICT (lot_id, owner_type, owner_id) DO UPDATE SET quantity = EXCLUDED.quantity
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
+		reservation.TenantID,
+		reservation.LotID,
+		reservation.Owner.Type,
+		reservation.Owner.ID,
+		reservation.Quantity,
+	)
+	return err
+}
+
+func scanLot(row pgx.Row) (*entities.Lot, error) {
+	var lot entities.Lot
+	err := row.Scan(
+		&lot.ID,
+		&lot.TenantID,
+		&lot.ProductID,
+		&lot.LotNumber,
+		&lot.ExpiresAt,
+		&lot.Quantity,
+		&lot.ReservedQuantity,
+		&lot.ReceivedAt,
+		&lot.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &lot, nil
+}
+
+const serialUnitColumns = `id, tenant_id, serial_number, product_id, store_id, status, owner_type, owner_id, created_at, updated_at`
+
+func (r *stockRepository) GetSerialUnit(ctx context.Context, tenantID uuid.UUID, serialNumber string) (*entities.SerialUnit, error) {
+	query := `
+		SELECT ` + serialUnitColumns + `
+		FROM serial_units
+		WHERE tenant_id = $1 AND serial_number = $2
+		FOR UPDATE
+	`
+
+	unit, err := scanSerialUnit(conn(ctx, r.pool).QueryRow(ctx, query, tenantID, serialNumber))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrSerialNotFound
+		}
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (r *stockRepository) ListSerialUnits(ctx context.Context, tenantID, productID uuid.UUID, status *entities.SerialStatus) ([]*entities.SerialUnit, error) {
+	query := `
+		SELECT ` + serialUnitColumns + `
+		FROM serial_units
+		WHERE tenant_id = $1 AND product_id = $2 AND ($3::VARCHAR IS NULL OR status = $3)
+		ORDER BY serial_number
+	`
+
+	return r.querySerialUnits(ctx, query, tenantID, productID, status)
+}
+
+// ListOwnerSerialUnits bloquea las unidades hasta el fin de la transacción.
+func (r *stockRepository) ListOwnerSerialUnits(ctx context.Context, tenantID, productID uuid.UUID, owner entities.ReservationOwner, status entities.SerialStatus) ([]*entities.SerialUnit, error) {
+	query := `
+		SELECT ` + serialUnitColumns + `
+		FROM serial_units
+		WHERE tenant_id = $1 AND product_id = $2 AND owner_type = $3 AND owner_id = $4 AND status = $5
+		ORDER BY serial_number
+		FOR UPDATE
+	`
+
+	return r.querySerialUnits(ctx, query, tenantID, productID, owner.Type, owner.ID, status)
+}
+
+func (r *stockRepository) querySerialUnits(ctx context.Context, query string, args ...interface{}) ([]*entities.SerialUnit, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var units []*entities.SerialUnit
+	for rows.Next() {
+		unit, err := scanSerialUnit(rows)
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, unit)
+	}
+
+	return units, rows.Err()
+}
+
+func (r *stockRepository) CreateSerialUnit(ctx context.Context, unit *entities.SerialUnit) error {
+	query := `
+		INSERT INTO serial_units (id, tenant_id, serial_number, product_id, store_id, status, owner_type, owner_id, created_at, updated_at)
+		SELECT gen_random_uuid(), $1, $2, p.id, p.store_id, $4, $5, $6, NOW(), NOW()
+		FROM products p
+		WHERE p.tenant_id = $1 AND p.id = $3
+		RETURNING id, store_id, created_at, updated_at
+	`
+
+	ownerType, ownerID := serialOwnerArgs(unit.Owner)
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		unit.TenantID,
+		unit.SerialNumber,
+		unit.ProductID,
+		unit.Status,
+		ownerType,
+		ownerID,
+	).Scan(&unit.ID, &unit.StoreID, &unit.CreatedAt, &unit.UpdatedAt)
+}
+
+func (r *stockRepository) UpdateSerialUnit(ctx context.Context, unit *entities.SerialUnit) error {
+	query := `
+		UPDATE serial_units u
+		SET product_id = p.id, store_id = p.store_id, status = $1, owner_type = $2, owner_id = $3, updated_at = NOW()
+		FROM products p
+		WHERE u.tenant_id = $4 AND u.id = $5 AND p.tenant_id = $4 AND p.id = $6
+		RETURNING u.store_id, u.updated_at
+	`
+
+	ownerType, ownerID := serialOwnerArgs(unit.Owner)
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		unit.Status,
+		ownerType,
+		ownerID,
+		unit.TenantID,
+		unit.ID,
+		unit.ProductID,
+	).Scan(&unit.StoreID, &unit.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return entities.ErrSerialNotFound
+	}
+	return err
+}
+
+func (r *stockRepository) CreateSerialEvent(ctx context.Context, event *entities.SerialEvent) error {
+	query := `
+		INSERT INTO serial_unit_events (id, tenant_id, unit_id, status, product_id, store_id, movement_id, reason,
+			reference_type, reference_id, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, clock_timestamp())
+		RETURNING id, created_at
+	`
+
+	var referenceType *string
+	var referenceID *uuid.UUID
+	if event.Reference != nil {
+		referenceType, referenceID = &event.Reference.Type, &event.Reference.ID
+	}
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		event.TenantID,
+		event.UnitID,
+		event.Status,
+		event.ProductID,
+		event.StoreID,
+		event.MovementID,
+		event.Reason,
+		referenceType,
+		referenceID,
+	).Scan(&event.ID, &event.CreatedAt)
+}
+
+func (r *stockRepository) ListSerialEvents(ctx context.Context, tenantID, unitID uuid.UUID) ([]*entities.SerialEvent, error) {
+	query := `
+		SELECT id, tenant_id, unit_id, status, product_id, store_id, movement_id, reason, reference_type, reference_id, created_at
+		FROM serial_unit_events
+		WHERE tenant_id = $1 AND unit_id = $2
+		ORDER BY created_at, seq
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, unitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*entities.SerialEvent{}
+	for rows.Next() {
+		var event entities.SerialEvent
+		var referenceType *string
+		var referenceID *uuid.UUID
+		if err := rows.Scan(
+			&event.ID,
+			&event.TenantID,
+			&event.UnitID,
+			&event.Status,
+			&event.ProductID,
+			&event.StoreID,
+			&event.MovementID,
+			&event.Reason,
+			&referenceType,
+			&referenceID,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if referenceType != nil && referenceID != nil {
+			event.Reference = &entities.MovementReference{Type: *referenceType, ID: *referenceID}
+		}
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+func serialOwnerArgs(owner *entities.ReservationOwner) (*entities.ReservationOwnerType, *uuid.UUID) {
+	if owner == nil {
+		return nil, nil
+	}
+	return &owner.Type, &owner.ID
+}
+
+func scanSerialUnit(row pgx.Row) (*entities.SerialUnit, error) {
+	var unit entities.SerialUnit
+	var ownerType *entities.ReservationOwnerType
+	var ownerID *uuid.UUID
+	err := row.Scan(
+		&unit.ID,
+		&unit.TenantID,
+		&unit.SerialNumber,
+		&unit.ProductID,
+		&unit.StoreID,
+		&unit.Status,
+		&ownerType,
+		&ownerID,
+		&unit.CreatedAt,
+		&unit.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ownerType != nil && ownerID != nil {
+		unit.Owner = &entities.ReservationOwner{Type: *ownerType, ID: *ownerID}
+	}
+	return &unit, nil
+}
+
+func (r *stockRepository) GetBin(ctx context.Context, tenantID, locationID uuid.UUID) (*entities.Bin, error) {
+	query := `
+		SELECT id, store_id, path
+		FROM store_locations
+		WHERE tenant_id = $1 AND id = $2 AND type = 'bin'
+	`
+
+	var bin entities.Bin
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, locationID).Scan(&bin.ID, &bin.StoreID, &bin.Path)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrBinNotFound
+		}
+		return nil, err
+	}
+	return &bin, nil
+}
+
+func (r *stockRepository) ProductStoreID(ctx context.Context, tenantID, productID uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT store_id FROM products WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NULL`
+
+	var storeID uuid.UUID
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(&storeID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, entities.ErrStockNotFound
+		}
+		return uuid.Nil, err
+	}
+	return storeID, nil
+}
+
+func (r *stockRepository) ProductArchived(ctx context.Context, tenantID, productID uuid.UUID) (bool, error) {
+	query := `
+		SELECT p.status = 'archived' OR s.status = 'archived'
+		FROM products p
+		JOIN stores s ON s.id = p.store_id
+		WHERE p.tenant_id = $1 AND p.id = $2
+	`
+
+	var archived bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, productID).Scan(&archived)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return archived, nil
+}
+
+func (r *stockRepository) ListByStore(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.Stock, error) {
+	query := `
+		SELECT s.id, s.tenant_id, s.product_id, s.quantity, s.reserved_quantity, s.quarantined_quantity, s.inventory_value, s.created_at, s.updated_at
+		FROM stock s
+		JOIN products p ON p.id = s.product_id
+		WHERE s.tenant_id = $1 AND p.store_id = $2 AND p.deleted_at IS NULL AND s.quantity > 0
+		ORDER BY p.name, p.id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stocks []*entities.Stock
+	for rows.Next() {
+		var stock entities.Stock
+		if err := rows.Scan(
+			&stock.ID,
+			&stock.TenantID,
+			&stock.ProductID,
+			&stock.Quantity,
+			&stock.ReservedQuantity,
+			&stock.QuarantinedQuantity,
+			&stock.InventoryValue,
+			&stock.CreatedAt,
+			&stock.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		stocks = append(stocks, &stock)
+	}
+
+	return stocks, rows.Err()
+}
+
+func (r *stockRepository) ListBinStock(ctx context.Context, tenantID, productID uuid.UUID) ([]*entities.BinStock, error) {
+	query := `
+		SELECT b.id, b.tenant_id, b.product_id, b.location_id, l.path, b.quantity, b.updated_at
+		FROM bin_stock b
+		JOIN store_locations l ON l.id = b.location_id
+		WHERE b.tenant_id = $1 AND b.product_id = $2 AND b.quantity > 0
+		ORDER BY l.path
+		FOR UPDATE OF b
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bins := []*entities.BinStock{}
+	for rows.Next() {
+		var bin entities.BinStock
+		if err := rows.Scan(
+			&bin.ID,
+			&bin.TenantID,
+			&bin.ProductID,
+			&bin.LocationID,
+			&bin.Path,
+			&bin.Quantity,
+			&bin.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		bins = append(bins, &bin)
+	}
+
+	return bins, rows.Err()
+}
+
+func (r *stockRepository) SaveBinStock(ctx context.Context, bin *entities.BinStock) error {
+	query := `
+		INSERT INTO bin_stock (id, tenant_id, product_id, location_id, quantity, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW())
+		ON CONFLICT (product_id, location_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity, updated_at = NOW()
+		RETURNING id, updated_at
+	`
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		bin.TenantID,
+		bin.ProductID,
+		bin.LocationID,
+		bin.Quantity,
+	).Scan(&bin.ID, &bin.UpdatedAt)
+}
+
+func (r *stockRepository) CreateBinMovement(ctx context.Context, movement *entities.BinMovement) error {
+	query := `
+		INSERT INTO bin_movements (id, tenant_id, product_id, from_location_id, to_location_id, quantity, movement_id,
+			reference_type, reference_id, created_by, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+		RETURNING id, created_at
+	`
+
+	var referenceType *string
+	var referenceID *uuid.UUID
+	if movement.Reference != nil {
+		referenceType, referenceID = &movement.Reference.Type, &movement.Reference.ID
+	}
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		movement.TenantID,
+		movement.ProductID,
+		movement.FromLocationID,
+		movement.ToLocationID,
+		movement.Quantity,
+		movement.MovementID,
+		referenceType,
+		referenceID,
+		movement.CreatedBy,
+	).Scan(&movement.ID, &movement.CreatedAt)
+}
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/stockreturn"
+	"motico-api/internal/domain/stockreturn/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type stockReturnRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewStockReturnRepository(pool *pgxpool.Pool) stockreturn.Repository {
+	return &stockReturnRepository{pool: pool}
+}
+
+const stockReturnColumns = `id, tenant_id, store_id, type, reason, status, sales_order_id, supplier_id, notes,
+			created_by, completed_at, completed_by, created_at, updated_at`
+
+func (r *stockReturnRepository) Create(ctx context.Context, ret *entities.StockReturn) error {
+	query := `
+		INSERT INTO stock_returns (id, tenant_id, store_id, type, reason, status, sales_order_id, supplier_id, notes,
+			created_by, completed_at, completed_by, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		ret.TenantID,
+		ret.StoreID,
+		ret.Type,
+		ret.Reason,
+		ret.Status,
+		ret.SalesOrderID,
+		ret.SupplierID,
+		ret.Notes,
+		ret.CreatedBy,
+		ret.CompletedAt,
+		ret.CompletedBy,
+	).Scan(
+		&ret.ID,
+		&ret.CreatedAt,
+		&ret.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := r.saveLines(ctx, tx, ret); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *stockReturnRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.StockReturn, error) {
+	query := `
+		SELECT ` + stockReturnColumns + `
+		FROM stock_returns
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	ret, err := scanStockReturn(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrReturnNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, []*entities.StockReturn{ret}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// stockReturnQuerySpec define el orden y los filtros genéricos del listado de devoluciones.
+var stockReturnQuerySpec = querySpec{
+	sorts: map[string]string{
+		"type":         "type",
+		"reason":       "reason",
+		"status":       "status",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+		"completed_at": "completed_at",
+	},
+	filters: map[string]filterSpec{
+		"created_after":  {column: "created_at", op: ">", kind: kindTime},
+		"created_before": {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "updated_at", op: ">=", kind: kindTime},
+	},
+	defaultOrder: "created_at DESC",
+	tieBreaker:   "id",
+}
+
+func (r *stockReturnRepository) List(ctx context.Context, tenantID uuid.UUID, filter stockreturn.ListFilter, limit, offset int) ([]*entities.StockReturn, error) {
+	query := `
+		SELECT ` + stockReturnColumns + `
+		FROM stock_returns
+		WHERE tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+	argPos := 2
+
+	if filter.Type != nil {
+		query += ` AND type = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Type)
+		argPos++
+	}
+
+	if filter.Reason != nil {
+		query += ` AND reason = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Reason)
+		argPos++
+	}
+
+	if filter.Status != nil {
+		query += ` AND status = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	if filter.StoreID != nil {
+		query += ` AND store_id = $` + fmt.Sprintf("%d", argPos)
+		args = append(args, *filter.StoreID)
+		argPos++
+	}
+
+	query, args, err := stockReturnQuerySpec.apply(query, args, filter.Query)
+	if err != nil {
+		return nil, err
+	}
+	argPos = len(args) + 1
+
+	query += ` LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var returns []*entities.StockReturn
+	for rows.Next() {
+		ret, err := scanStockReturn(rows)
+		if err != nil {
+			return nil, err
+		}
+		returns = append(returns, ret)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLines(ctx, tenantID, returns); err != nil {
+		return nil, err
+	}
+
+	return returns, nil
+}
+
+func (r *stockReturnRepository) Update(ctx context.Context, ret *entities.StockReturn) error {
+	query := `
+		UPDATE stock_returns
+		SET status = $1, notes = $2, completed_at = $3, completed_by = $4, updated_at = NOW()
+		WHERE id = $5 AND tenant_id = $6
+		RETURNING updated_at
+	`
+
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
+		ret.Status,
+		ret.Notes,
+		ret.CompletedAt,
+		ret.CompletedBy,
+		ret.ID,
+		ret.TenantID,
+	).Scan(&ret.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrReturnNotFound
+		}
+		return err
+	}
+
+	if err := r.saveLines(ctx, tx, ret); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func scanStockReturn(row pgx.Row) (*entities.StockReturn, error) {
+	var ret entities.StockReturn
+	err := row.Scan(
+		&ret.ID,
+		&ret.TenantID,
+		&ret.StoreID,
+		&ret.Type,
+		&ret.Reason,
+		&ret.Status,
+		&ret.SalesOrderID,
+		&ret.SupplierID,
+		&ret.Notes,
+		&ret.CreatedBy,
+		&ret.CompletedAt,
+		&ret.CompletedBy,
+		&ret.CreatedAt,
+		&ret.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// saveLines hace upsert de las líneas por (return_id, product_id). Las líneas no
+// cambian después de crearse; solo avanzan sus cantidades resueltas.
+func (r *stockReturnRepository) saveLines(ctx context.Context, tx pgx.Tx, ret *entities.StockReturn) error {
+	query := `
+		INSERT INTO stock_return_lines (id, tenant_id, return_id, product_id, position, quantity, unit_cost,
+			lot_number, lot_expires_at, serials, restocked_quantity, scrapped_quantity, shipped_quantity, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9::TEXT[], '{}'), $10, $11, $12, NOW(), NOW())
+		ON CONFLICT (return_id, product_id) DO UPDATE
+		SET restocked_quantity = EXCLUDED.restocked_quantity, scrapped_quantity = EXCLUDED.scrapped_quantity,
+			shipped_quantity = EXCLUDED.shipped_quantity, updated_at = NOW()
+		RETURNING id
+	`
+
+	for i := range ret.Lines {
+		line := &ret.Lines[i]
+		err := tx.QueryRow(ctx, query,
+			ret.TenantID,
+			ret.ID,
+			line.ProductID,
+			i,
+			line.Quantity,
+			line.UnitCost,
+			line.LotNumber,
+			line.LotExpiresAt,
+			line.Serials,
+			line.RestockedQuantity,
+			line.ScrappedQuantity,
+			line.ShippedQuantity,
+		).Scan(&line.ID)
+		if err != nil {
+			return err
+		}
+		line.ReturnID = ret.ID
+	}
+
+	return nil
+}
+
+func (r *stockReturnRepository) loadLines(ctx context.Context, tenantID uuid.UUID, returns []*entities.StockReturn) error {
+	if len(returns) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.StockReturn, len(returns))
+	ids := make([]uuid.UUID, len(returns))
+	for i, ret := range returns {
+		byID[ret.ID] = ret
+		ids[i] = ret.ID
+		ret.Lines = []entities.StockReturnLine{}
+	}
+
+	query := `
+		SELECT id, return_id, product_id, quantity, unit_cost, lot_number, lot_expires_at, serials, restocked_quantity, scrapped_quantity, shipped_quantity
+		FROM stock_return_lines
+		WHERE tenant_id = $1 AND return_id = ANY($2)
+		ORDER BY return_id, position
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line entities.StockReturnLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.ReturnID,
+			&line.ProductID,
+			&line.Quantity,
+			&line.UnitCost,
+			&line.LotNumber,
+			&line.LotExpiresAt,
+			&line.Serials,
+			&line.RestockedQuantity,
+			&line.ScrappedQuantity,
+			&line.ShippedQuantity,
+		); err != nil {
+			return err
+		}
+		if ret, ok := byID[line.ReturnID]; ok {
+			ret.Lines = append(ret.Lines, line)
+		}
+	}
+
+	return rows.Err()
+}
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"motico-api/internal/domain/store"
 	"motico-api/internal/domain/store/entities"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -20,12 +23,12 @@ func NewStoreRepository(pool *pgxpool.Pool) store.Repository {
 
 func (r *storeRepository) Create(ctx context.Context, store *entities.Store) error {
 	query := `
-		INSERT INTO stores (id, tenant_id, name, address, created_at, updated_at)
-		VALUES (gen_random_uuid(), $1, $2, $3, NOW(), NOW())
+		INSERT INTO stores (id, tenant_id, name, address, status, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query, store.TenantID, store.Name, store.Address).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, query, store.TenantID, store.Name, store.Address, store.Status).Scan(
 		&store.ID,
 		&store.CreatedAt,
 		&store.UpdatedAt,
@@ -42,19 +45,23 @@ func (r *storeRepository) Create(ctx context.Context, store *entities.Store) err
 
 func (r *storeRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Store, error) {
 	query := `
-		SELECT id, tenant_id, name, address, created_at, updated_at
+		SELECT id, tenant_id, name, address, status, archived_at, closing_transfer_id, created_at, updated_at, deleted_at
 		FROM stores
-		WHERE id = $1 AND tenant_id = $2
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
 	`
 
 	var store entities.Store
-	err := r.pool.QueryRow(ctx, query, id, tenantID).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID).Scan(
 		&store.ID,
 		&store.TenantID,
 		&store.Name,
 		&store.Address,
+		&store.Status,
+		&store.ArchivedAt,
+		&store.ClosingTransferID,
 		&store.CreatedAt,
 		&store.UpdatedAt,
+		&store.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -66,16 +73,45 @@ func (r *storeRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (
 	return &store, nil
 }
 
-func (r *storeRepository) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entities.Store, error) {
+// storeQuerySpec define el orden y los filtros genéricos del listado de sucursales.
+var storeQuerySpec = querySpec{
+	sorts: map[string]string{
+		"name":       "name",
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+	},
+	filters: map[string]filterSpec{
+		"name_contains":  {column: "name", op: "ILIKE", kind: kindText},
+		"created_after":  {column: "created_at", op: ">", kind: kindTime},
+		"created_before": {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "updated_at", op: ">=", kind: kindTime},
+	},
+	defaultOrder: "created_at DESC",
+	tieBreaker:   "id",
+	deletedAt:    "deleted_at",
+}
+
+func (r *storeRepository) List(ctx context.Context, tenantID uuid.UUID, status *entities.StoreStatus, params query.Params, limit, offset int) ([]*entities.Store, error) {
 	query := `
-		SELECT id, tenant_id, name, address, created_at, updated_at
+		SELECT id, tenant_id, name, address, status, archived_at, closing_transfer_id, created_at, updated_at, deleted_at
 		FROM stores
 		WHERE tenant_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
 	`
+	args := []interface{}{tenantID}
 
-	rows, err := r.pool.Query(ctx, query, tenantID, limit, offset)
+	if status != nil {
+		args = append(args, *status)
+		query += fmt.Sprintf(` AND status = $%d`, len(args))
+	}
+
+	query, args, err := storeQuerySpec.apply(query, args, params)
+	if err != nil {
+		return nil, err
+	}
+	query += fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +125,12 @@ func (r *storeRepository) List(ctx context.Context, tenantID uuid.UUID, limit, o
 			&store.TenantID,
 			&store.Name,
 			&store.Address,
+			&store.Status,
+			&store.ArchivedAt,
+			&store.ClosingTransferID,
 			&store.CreatedAt,
 			&store.UpdatedAt,
+			&store.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -103,12 +143,20 @@ func (r *storeRepository) List(ctx context.Context, tenantID uuid.UUID, limit, o
 func (r *storeRepository) Update(ctx context.Context, store *entities.Store) error {
 	query := `
 		UPDATE stores
-		SET name = $1, address = $2, updated_at = NOW()
-		WHERE id = $3 AND tenant_id = $4
+		SET name = $1, address = $2, status = $3, archived_at = $4, closing_transfer_id = $5, updated_at = NOW()
+		WHERE id = $6 AND tenant_id = $7 AND deleted_at IS NULL
 		RETURNING updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query, store.Name, store.Address, store.ID, store.TenantID).Scan(&store.UpdatedAt)
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		store.Name,
+		store.Address,
+		store.Status,
+		store.ArchivedAt,
+		store.ClosingTransferID,
+		store.ID,
+		store.TenantID,
+	).Scan(&store.UpdatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return entities.ErrStoreNotFound
@@ -123,10 +171,28 @@ func (r *storeRepository) Update(ctx context.Context, store *entities.Store) err
 }
 
 func (r *storeRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
-	query := `DELETE FROM stores WHERE id = $1 AND tenant_id = $2`
+	query := `UPDATE stores SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrStoreNotFound
+	}
+
+	return nil
+}
+
+func (r *storeRepository) Restore(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `UPDATE stores SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
 
-	result, err := r.pool.Exec(ctx, query, id, tenantID)
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.ErrStoreNameExists
+		}
 		return err
 	}
 
@@ -137,11 +203,15 @@ func (r *storeRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) er
 	return nil
 }
 
+func (r *storeRepository) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return purgeDeleted(ctx, r.pool, "stores", before, batchSize)
+}
+
 func (r *storeRepository) ExistsByName(ctx context.Context, tenantID uuid.UUID, name string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM stores WHERE tenant_id = $1 AND name = $2)`
+	query := `SELECT EXISTS(SELECT 1 FROM stores WHERE tenant_id = $1 AND name = $2 AND deleted_at IS NULL)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, name).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, name).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -150,13 +220,218 @@ func (r *storeRepository) ExistsByName(ctx context.Context, tenantID uuid.UUID,
 }
 
 func (r *storeRepository) HasProducts(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM products WHERE tenant_id = $1 AND store_id = $2)`
+	query := `SELECT EXISTS(SELECT 1 FROM products WHERE tenant_id = $1 AND store_id = $2 AND deleted_at IS NULL)`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, storeID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// ArchiveProducts archiva los productos activos de la sucursal con el mismo
+// archived_at que la sucursal, que ya tiene que estar archivada.
+func (r *storeRepository) ArchiveProducts(ctx context.Context, tenantID, storeID uuid.UUID) error {
+	query := `
+		UPDATE products p
+		SET status = 'archived', archived_at = s.archived_at, updated_at = NOW()
+		FROM stores s
+		WHERE s.id = p.store_id AND p.tenant_id = $1 AND p.store_id = $2
+			AND p.status = 'active' AND p.deleted_at IS NULL AND s.archived_at IS NOT NULL
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, storeID)
+	return err
+}
+
+// UnarchiveProducts reactiva los productos que se archivaron junto con la
+// sucursal; los archivados antes por su cuenta siguen archivados.
+func (r *storeRepository) UnarchiveProducts(ctx context.Context, tenantID, storeID uuid.UUID) error {
+	query := `
+		UPDATE products p
+		SET status = 'active', archived_at = NULL, updated_at = NOW()
+		FROM stores s
+		WHERE s.id = p.store_id AND p.tenant_id = $1 AND p.store_id = $2
+			AND p.status = 'archived' AND p.archived_at = s.archived_at
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, query, tenantID, storeID)
+	return err
+}
+
+func (r *storeRepository) HasStock(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM stock s JOIN products p ON p.id = s.product_id
+			WHERE s.tenant_id = $1 AND p.store_id = $2 AND p.deleted_at IS NULL AND s.quantity > 0
+		)
+	`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, storeID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *storeRepository) HasOpenTransfers(ctx context.Context, tenantID, storeID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM transfers
+			WHERE tenant_id = $1 AND (from_store_id = $2 OR to_store_id = $2) AND deleted_at IS NULL
+				AND status IN ('pending', 'approved', 'in_transit', 'partially_received')
+		)
+	`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, tenantID, storeID).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, storeID).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
 
 	return exists, nil
 }
+
+const locationColumns = `id, tenant_id, store_id, parent_id, type, code, name, path, created_at, updated_at`
+
+func (r *storeRepository) CreateLocation(ctx context.Context, location *entities.Location) error {
+	query := `
+		INSERT INTO store_locations (id, tenant_id, store_id, parent_id, type, code, name, path, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		location.TenantID,
+		location.StoreID,
+		location.ParentID,
+		location.Type,
+		location.Code,
+		location.Name,
+		location.Path,
+	).Scan(&location.ID, &location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.ErrLocationExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeRepository) GetLocation(ctx context.Context, tenantID, storeID, id uuid.UUID) (*entities.Location, error) {
+	query := `
+		SELECT ` + locationColumns + `
+		FROM store_locations
+		WHERE tenant_id = $1 AND store_id = $2 AND id = $3
+	`
+
+	location, err := scanLocation(conn(ctx, r.pool).QueryRow(ctx, query, tenantID, storeID, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrLocationNotFound
+		}
+		return nil, err
+	}
+	return location, nil
+}
+
+func (r *storeRepository) ListLocations(ctx context.Context, tenantID, storeID uuid.UUID) ([]*entities.Location, error) {
+	query := `
+		SELECT ` + locationColumns + `
+		FROM store_locations
+		WHERE tenant_id = $1 AND store_id = $2
+		ORDER BY path
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, storeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := []*entities.Location{}
+	for rows.Next() {
+		location, err := scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+
+	return locations, rows.Err()
+}
+
+func (r *storeRepository) UpdateLocation(ctx context.Context, location *entities.Location) error {
+	query := `
+		UPDATE store_locations
+		SET name = $1, updated_at = NOW()
+		WHERE id = $2 AND tenant_id = $3
+		RETURNING updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query, location.Name, location.ID, location.TenantID).Scan(&location.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrLocationNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeRepository) DeleteLocation(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `DELETE FROM store_locations WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrLocationNotFound
+	}
+
+	return nil
+}
+
+func (r *storeRepository) LocationInUse(ctx context.Context, tenantID, id uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(SELECT 1 FROM store_locations WHERE tenant_id = $1 AND parent_id = $2)
+			OR EXISTS(SELECT 1 FROM bin_stock WHERE tenant_id = $1 AND location_id = $2 AND quantity > 0)
+	`
+
+	var inUse bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, id).Scan(&inUse)
+	if err != nil {
+		return false, err
+	}
+
+	return inUse, nil
+}
+
+func scanLocation(row pgx.Row) (*entities.Location, error) {
+	var location entities.Location
+	err := row.Scan(
+		&location.ID,
+		&location.TenantID,
+		&location.StoreID,
+		&location.ParentID,
+		&location.Type,
+		&location.Code,
+		&location.Name,
+		&location.Path,
+		&location.CreatedAt,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
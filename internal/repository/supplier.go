@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"motico-api/internal/domain/supplier"
+	"motico-api/internal/domain/supplier/entities"
+	"motico-api/pkg/query"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type supplierRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSupplierRepository(pool *pgxpool.Pool) supplier.Repository {
+	return &supplierRepository{pool: pool}
+}
+
+func (r *supplierRepository) Create(ctx context.Context, supplier *entities.Supplier) error {
+	query := `
+		INSERT INTO suppliers (id, tenant_id, name, contact_name, email, phone, address, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		supplier.TenantID,
+		supplier.Name,
+		supplier.ContactName,
+		supplier.Email,
+		supplier.Phone,
+		supplier.Address,
+	).Scan(
+		&supplier.ID,
+		&supplier.CreatedAt,
+		&supplier.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.ErrSupplierNameExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *supplierRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Supplier, error) {
+	query := `
+		SELECT id, tenant_id, name, contact_name, email, phone, address, created_at, updated_at
+		FROM suppliers
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	supplier, err := scanSupplier(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrSupplierNotFound
+		}
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+// supplierQuerySpec define el orden y los filtros genéricos del listado de proveedores.
+var supplierQuerySpec = querySpec{
+	sorts: map[string]string{
+		"name":       "name",
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+	},
+	filters: map[string]filterSpec{
+		"name_contains":  {column: "name", op: "ILIKE", kind: kindText},
+		"email_contains": {column: "email", op: "ILIKE", kind: kindText},
+		"created_after":  {column: "created_at", op: ">", kind: kindTime},
+		"created_before": {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "updated_at", op: ">=", kind: kindTime},
+	},
+	defaultOrder: "name",
+	tieBreaker:   "id",
+}
+
+func (r *supplierRepository) List(ctx context.Context, tenantID uuid.UUID, params query.Params, limit, offset int) ([]*entities.Supplier, error) {
+	query := `
+		SELECT id, tenant_id, name, contact_name, email, phone, address, created_at, updated_at
+		FROM suppliers
+		WHERE tenant_id = $1
+	`
+	args := []interface{}{tenantID}
+
+	query, args, err := supplierQuerySpec.apply(query, args, params)
+	if err != nil {
+		return nil, err
+	}
+	query += fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suppliers []*entities.Supplier
+	for rows.Next() {
+		supplier, err := scanSupplier(rows)
+		if err != nil {
+			return nil, err
+		}
+		suppliers = append(suppliers, supplier)
+	}
+
+	return suppliers, rows.Err()
+}
+
+func (r *supplierRepository) Update(ctx context.Context, supplier *entities.Supplier) error {
+	query := `
+		UPDATE suppliers
+		SET name = $1, contact_name = $2, email = $3, phone = $4, address = $5, updated_at = NOW()
+		WHERE id = $6 AND tenant_id = $7
+		RETURNING updated_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		supplier.Name,
+		supplier.ContactName,
+		supplier.Email,
+		supplier.Phone,
+		supplier.Address,
+		supplier.ID,
+		supplier.TenantID,
+	).Scan(&supplier.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.ErrSupplierNotFound
+		}
+		if isUniqueViolation(err) {
+			return entities.ErrSupplierNameExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *supplierRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `DELETE FROM suppliers WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrSupplierNotFound
+	}
+
+	return nil
+}
+
+func (r *supplierRepository) ExistsByName(ctx context.Context, tenantID uuid.UUID, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM suppliers WHERE tenant_id = $1 AND name = $2)`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, name).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *supplierRepository) HasPurchaseOrders(ctx context.Context, tenantID, supplierID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM purchase_orders WHERE tenant_id = $1 AND supplier_id = $2)`
+
+	var exists bool
+	err := conn(ctx, r.pool).QueryRow(ctx, query, tenantID, supplierID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func scanSupplier(row pgx.Row) (*entities.Supplier, error) {
+	var supplier entities.Supplier
+	err := row.Scan(
+		&supplier.ID,
+		&supplier.TenantID,
+		&supplier.Name,
+		&supplier.ContactName,
+		&supplier.Email,
+		&supplier.Phone,
+		&supplier.Address,
+		&supplier.CreatedAt,
+		&supplier.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &supplier, nil
+}
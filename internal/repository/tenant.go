@@ -22,7 +22,7 @@ func (r *tenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities
 	query := `SELECT id, name, created_at, updated_at FROM tenants WHERE id = $1`
 
 	var tenant entities.Tenant
-	err := r.pool.QueryRow(ctx, query, id).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, query, id).Scan(
 		&tenant.ID,
 		&tenant.Name,
 		&tenant.CreatedAt,
@@ -42,7 +42,7 @@ func (r *tenantRepository) Exists(ctx context.Context, id uuid.UUID) (bool, erro
 	query := `SELECT EXISTS(SELECT 1 FROM tenants WHERE id = $1)`
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, id).Scan(&exists)
+	err := conn(ctx, r.pool).QueryRow(ctx, query, id).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
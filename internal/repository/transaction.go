@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"motico-api/internal/domain/transaction"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type txKey struct{}
+
+// querier es lo común entre el pool y una transacción abierta.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type transactionManager struct {
+	pool *pgxpool.Pool
+}
+
+func NewTransactionManager(pool *pgxpool.Pool) transaction.Manager {
+	return &transactionManager{pool: pool}
+}
+
+func (m *transactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := begin(ctx, m.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// conn devuelve la transacción en curso del ctx o, si no hay, el pool.
+func conn(ctx context.Context, pool *pgxpool.Pool) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return pool
+}
+
+// begin abre una transacción nueva o un savepoint si ya hay una en curso.
+func begin(ctx context.Context, pool *pgxpool.Pool) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return pool.Begin(ctx)
+}
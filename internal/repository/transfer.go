@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"motico-api/internal/domain/transfer"
 	"motico-api/internal/domain/transfer/entities"
+	"motico-api/pkg/query"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -21,19 +23,26 @@ func NewTransferRepository(pool *pgxpool.Pool) transfer.Repository {
 
 func (r *transferRepository) Create(ctx context.Context, transfer *entities.Transfer) error {
 	query := `
-		INSERT INTO transfers (id, tenant_id, product_id, from_store_id, to_store_id, quantity, status, notes, created_at, updated_at)
-		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		INSERT INTO transfers (id, tenant_id, from_store_id, to_store_id, status, notes, requested_by, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query,
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
 		transfer.TenantID,
-		transfer.ProductID,
 		transfer.FromStoreID,
 		transfer.ToStoreID,
-		transfer.Quantity,
 		transfer.Status,
 		transfer.Notes,
+		transfer.RequestedBy,
 	).Scan(
 		&transfer.ID,
 		&transfer.CreatedAt,
@@ -43,29 +52,34 @@ func (r *transferRepository) Create(ctx context.Context, transfer *entities.Tran
 		return err
 	}
 
-	return nil
+	if err := r.saveLines(ctx, tx, transfer); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *transferRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	return r.get(ctx, tenantID, id, false)
+}
+
+// GetByIDForUpdate bloquea la fila del traspaso hasta el fin de la transacción,
+// así dos transiciones concurrentes no parten del mismo estado.
+func (r *transferRepository) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	return r.get(ctx, tenantID, id, true)
+}
+
+func (r *transferRepository) get(ctx context.Context, tenantID, id uuid.UUID, lock bool) (*entities.Transfer, error) {
 	query := `
-		SELECT id, tenant_id, product_id, from_store_id, to_store_id, quantity, status, notes, created_at, updated_at
+		SELECT id, tenant_id, from_store_id, to_store_id, status, notes, requested_by, approved_at, approved_by, dispatched_at, dispatched_by, received_at, received_by, cancelled_at, cancelled_by, created_at, updated_at, deleted_at
 		FROM transfers
-		WHERE id = $1 AND tenant_id = $2
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
 	`
+	if lock {
+		query += ` FOR UPDATE`
+	}
 
-	var transfer entities.Transfer
-	err := r.pool.QueryRow(ctx, query, id, tenantID).Scan(
-		&transfer.ID,
-		&transfer.TenantID,
-		&transfer.ProductID,
-		&transfer.FromStoreID,
-		&transfer.ToStoreID,
-		&transfer.Quantity,
-		&transfer.Status,
-		&transfer.Notes,
-		&transfer.CreatedAt,
-		&transfer.UpdatedAt,
-	)
+	transfer, err := scanTransfer(conn(ctx, r.pool).QueryRow(ctx, query, id, tenantID))
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, entities.ErrTransferNotFound
@@ -73,12 +87,35 @@ func (r *transferRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID
 		return nil, err
 	}
 
-	return &transfer, nil
+	if err := r.loadLines(ctx, tenantID, []*entities.Transfer{transfer}); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// transferQuerySpec define el orden y los filtros genéricos del listado de traspasos.
+var transferQuerySpec = querySpec{
+	sorts: map[string]string{
+		"status":      "status",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+		"received_at": "received_at",
+	},
+	filters: map[string]filterSpec{
+		"created_after":  {column: "created_at", op: ">", kind: kindTime},
+		"created_before": {column: "created_at", op: "<", kind: kindTime},
+		"updated_since":  {column: "updated_at", op: ">=", kind: kindTime},
+		"received_after": {column: "received_at", op: ">", kind: kindTime},
+	},
+	defaultOrder: "created_at DESC",
+	tieBreaker:   "id",
+	deletedAt:    "deleted_at",
 }
 
-func (r *transferRepository) List(ctx context.Context, tenantID uuid.UUID, status *entities.TransferStatus, storeID *uuid.UUID, limit, offset int) ([]*entities.Transfer, error) {
+func (r *transferRepository) List(ctx context.Context, tenantID uuid.UUID, status *entities.TransferStatus, storeID *uuid.UUID, params query.Params, limit, offset int) ([]*entities.Transfer, error) {
 	query := `
-		SELECT id, tenant_id, product_id, from_store_id, to_store_id, quantity, status, notes, created_at, updated_at
+		SELECT id, tenant_id, from_store_id, to_store_id, status, notes, requested_by, approved_at, approved_by, dispatched_at, dispatched_by, received_at, received_by, cancelled_at, cancelled_by, created_at, updated_at, deleted_at
 		FROM transfers
 		WHERE tenant_id = $1
 	`
@@ -97,10 +134,16 @@ func (r *transferRepository) List(ctx context.Context, tenantID uuid.UUID, statu
 		argPos++
 	}
 
-	query += ` ORDER BY created_at DESC LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
+	query, args, err := transferQuerySpec.apply(query, args, params)
+	if err != nil {
+		return nil, err
+	}
+	argPos = len(args) + 1
+
+	query += ` LIMIT $` + fmt.Sprintf("%d", argPos) + ` OFFSET $` + fmt.Sprintf("%d", argPos+1)
 	args = append(args, limit, offset)
 
-	rows, err := r.pool.Query(ctx, query, args...)
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,42 +151,54 @@ func (r *transferRepository) List(ctx context.Context, tenantID uuid.UUID, statu
 
 	var transfers []*entities.Transfer
 	for rows.Next() {
-		var transfer entities.Transfer
-		if err := rows.Scan(
-			&transfer.ID,
-			&transfer.TenantID,
-			&transfer.ProductID,
-			&transfer.FromStoreID,
-			&transfer.ToStoreID,
-			&transfer.Quantity,
-			&transfer.Status,
-			&transfer.Notes,
-			&transfer.CreatedAt,
-			&transfer.UpdatedAt,
-		); err != nil {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
 			return nil, err
 		}
-		transfers = append(transfers, &transfer)
+		transfers = append(transfers, transfer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return transfers, rows.Err()
+	if err := r.loadLines(ctx, tenantID, transfers); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
 }
 
 func (r *transferRepository) Update(ctx context.Context, transfer *entities.Transfer) error {
 	query := `
 		UPDATE transfers
-		SET product_id = $1, from_store_id = $2, to_store_id = $3, quantity = $4, status = $5, notes = $6, updated_at = NOW()
-		WHERE id = $7 AND tenant_id = $8
+		SET from_store_id = $1, to_store_id = $2, status = $3, notes = $4,
+			approved_at = $5, approved_by = $6, dispatched_at = $7, dispatched_by = $8,
+			received_at = $9, received_by = $10, cancelled_at = $11, cancelled_by = $12, updated_at = NOW()
+		WHERE id = $13 AND tenant_id = $14 AND deleted_at IS NULL
 		RETURNING updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query,
-		transfer.ProductID,
+	tx, err := begin(ctx, r.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, query,
 		transfer.FromStoreID,
 		transfer.ToStoreID,
-		transfer.Quantity,
 		transfer.Status,
 		transfer.Notes,
+		transfer.ApprovedAt,
+		transfer.ApprovedBy,
+		transfer.DispatchedAt,
+		transfer.DispatchedBy,
+		transfer.ReceivedAt,
+		transfer.ReceivedBy,
+		transfer.CancelledAt,
+		transfer.CancelledBy,
 		transfer.ID,
 		transfer.TenantID,
 	).Scan(&transfer.UpdatedAt)
@@ -154,13 +209,17 @@ func (r *transferRepository) Update(ctx context.Context, transfer *entities.Tran
 		return err
 	}
 
-	return nil
+	if err := r.saveLines(ctx, tx, transfer); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *transferRepository) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
-	query := `DELETE FROM transfers WHERE id = $1 AND tenant_id = $2`
+	query := `UPDATE transfers SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 
-	result, err := r.pool.Exec(ctx, query, id, tenantID)
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
 	if err != nil {
 		return err
 	}
@@ -171,3 +230,198 @@ func (r *transferRepository) Delete(ctx context.Context, tenantID, id uuid.UUID)
 
 	return nil
 }
+
+func (r *transferRepository) Restore(ctx context.Context, tenantID, id uuid.UUID) error {
+	query := `UPDATE transfers SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NOT NULL`
+
+	result, err := conn(ctx, r.pool).Exec(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrTransferNotFound
+	}
+
+	return nil
+}
+
+func (r *transferRepository) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	return purgeDeleted(ctx, r.pool, "transfers", before, batchSize)
+}
+
+func (r *transferRepository) CreateDiscrepancy(ctx context.Context, discrepancy *entities.TransferDiscrepancy) error {
+	query := `
+		INSERT INTO transfer_discrepancies (id, tenant_id, transfer_id, transfer_line_id, product_id, expected_quantity, received_quantity, variance, recorded_by, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING id, created_at
+	`
+
+	return conn(ctx, r.pool).QueryRow(ctx, query,
+		discrepancy.TenantID,
+		discrepancy.TransferID,
+		discrepancy.TransferLineID,
+		discrepancy.ProductID,
+		discrepancy.ExpectedQuantity,
+		discrepancy.ReceivedQuantity,
+		discrepancy.Variance,
+		discrepancy.RecordedBy,
+	).Scan(&discrepancy.ID, &discrepancy.CreatedAt)
+}
+
+func (r *transferRepository) ListDiscrepancies(ctx context.Context, tenantID, transferID uuid.UUID) ([]*entities.TransferDiscrepancy, error) {
+	query := `
+		SELECT id, tenant_id, transfer_id, transfer_line_id, product_id, expected_quantity, received_quantity, variance, recorded_by, created_at
+		FROM transfer_discrepancies
+		WHERE tenant_id = $1 AND transfer_id = $2
+		ORDER BY created_at
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var discrepancies []*entities.TransferDiscrepancy
+	for rows.Next() {
+		var discrepancy entities.TransferDiscrepancy
+		if err := rows.Scan(
+			&discrepancy.ID,
+			&discrepancy.TenantID,
+			&discrepancy.TransferID,
+			&discrepancy.TransferLineID,
+			&discrepancy.ProductID,
+			&discrepancy.ExpectedQuantity,
+			&discrepancy.ReceivedQuantity,
+			&discrepancy.Variance,
+			&discrepancy.RecordedBy,
+			&discrepancy.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		discrepancies = append(discrepancies, &discrepancy)
+	}
+
+	return discrepancies, rows.Err()
+}
+
+func scanTransfer(row pgx.Row) (*entities.Transfer, error) {
+	var transfer entities.Transfer
+	err := row.Scan(
+		&transfer.ID,
+		&transfer.TenantID,
+		&transfer.FromStoreID,
+		&transfer.ToStoreID,
+		&transfer.Status,
+		&transfer.Notes,
+		&transfer.RequestedBy,
+		&transfer.ApprovedAt,
+		&transfer.ApprovedBy,
+		&transfer.DispatchedAt,
+		&transfer.DispatchedBy,
+		&transfer.ReceivedAt,
+		&transfer.ReceivedBy,
+		&transfer.CancelledAt,
+		&transfer.CancelledBy,
+		&transfer.CreatedAt,
+		&transfer.UpdatedAt,
+		&transfer.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// saveLines deja en la base exactamente las líneas del traspaso: elimina las que
+// ya no están y hace upsert del resto por (transfer_id, product_id).
+func (r *transferRepository) saveLines(ctx context.Context, tx pgx.Tx, transfer *entities.Transfer) error {
+	productIDs := make([]uuid.UUID, len(transfer.Lines))
+	for i, line := range transfer.Lines {
+		productIDs[i] = line.ProductID
+	}
+
+	deleteQuery := `DELETE FROM transfer_lines WHERE transfer_id = $1 AND tenant_id = $2 AND NOT (product_id = ANY($3))`
+	if _, err := tx.Exec(ctx, deleteQuery, transfer.ID, transfer.TenantID, productIDs); err != nil {
+		return err
+	}
+
+	upsertQuery := `
+		INSERT INTO transfer_lines (id, tenant_id, transfer_id, product_id, position, quantity, received_quantity,
+			serials, received_serials, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, COALESCE($7::TEXT[], '{}'), COALESCE($8::TEXT[], '{}'), NOW(), NOW())
+		ON CONFLICT (transfer_id, product_id) DO UPDATE
+		SET position = EXCLUDED.position, quantity = EXCLUDED.quantity,
+			received_quantity = EXCLUDED.received_quantity, serials = EXCLUDED.serials,
+			received_serials = EXCLUDED.received_serials, updated_at = NOW()
+		RETURNING id
+	`
+
+	for i := range transfer.Lines {
+		line := &transfer.Lines[i]
+		err := tx.QueryRow(ctx, upsertQuery,
+			transfer.TenantID,
+			transfer.ID,
+			line.ProductID,
+			i,
+			line.Quantity,
+			line.ReceivedQuantity,
+			line.Serials,
+			line.ReceivedSerials,
+		).Scan(&line.ID)
+		if err != nil {
+			return err
+		}
+		line.TransferID = transfer.ID
+	}
+
+	return nil
+}
+
+func (r *transferRepository) loadLines(ctx context.Context, tenantID uuid.UUID, transfers []*entities.Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.Transfer, len(transfers))
+	ids := make([]uuid.UUID, len(transfers))
+	for i, t := range transfers {
+		byID[t.ID] = t
+		ids[i] = t.ID
+		t.Lines = []entities.TransferLine{}
+	}
+
+	query := `
+		SELECT id, transfer_id, product_id, quantity, received_quantity, serials, received_serials
+		FROM transfer_lines
+		WHERE tenant_id = $1 AND transfer_id = ANY($2)
+		ORDER BY transfer_id, position
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line entities.TransferLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.TransferID,
+			&line.ProductID,
+			&line.Quantity,
+			&line.ReceivedQuantity,
+			&line.Serials,
+			&line.ReceivedSerials,
+		); err != nil {
+			return err
+		}
+		if t, ok := byID[line.TransferID]; ok {
+			t.Lines = append(t.Lines, line)
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,36 @@
+package attachment
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/attachment"
+	"motico-api/internal/domain/attachment/entities"
+	restentities "motico-api/internal/rest/attachment/entities"
+)
+
+type Handler struct {
+	service *attachment.Service
+	config  *config.Config
+}
+
+func NewHandler(service *attachment.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toAttachmentResponse(a *entities.Attachment) restentities.AttachmentResponse {
+	return restentities.AttachmentResponse{
+		ID:           a.ID,
+		ProductID:    a.ProductID,
+		Kind:         string(a.Kind),
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		SizeBytes:    a.SizeBytes,
+		URL:          a.URL,
+		ThumbnailURL: a.ThumbnailURL,
+		Position:     a.Position,
+		CreatedBy:    a.CreatedBy,
+		CreatedAt:    a.CreatedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package attachment
+
+import (
+	restentities "motico-api/internal/rest/attachment/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// List
+// @Summary      List product attachments
+// @Description  Get the images and documents of a product in upload order
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200          {object}  restentities.ListAttachmentsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Product not found"
+// @Security     BearerAuth
+// @Router       /products/{id}/attachments [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	attachments, err := h.service.List(r.Context(), tenantID, productID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list attachments")
+		return
+	}
+
+	responses := make([]restentities.AttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		responses[i] = toAttachmentResponse(a)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListAttachmentsResponse{Data: responses})
+}
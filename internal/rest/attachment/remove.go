@@ -0,0 +1,56 @@
+package attachment
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Remove
+// @Summary      Delete product attachment
+// @Description  Delete an attachment of a product together with its stored file and thumbnail
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID   header    string  true  "Tenant ID"
+// @Param        id            path      string  true  "Product ID"
+// @Param        attachmentId  path      string  true  "Attachment ID"
+// @Success      204           "No Content"
+// @Failure      400           {object}  map[string]interface{}  "Invalid request"
+// @Failure      401           {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404           {object}  map[string]interface{}  "Attachment not found"
+// @Security     BearerAuth
+// @Router       /products/{id}/attachments/{attachmentId} [delete]
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	attachmentIDStr := chi.URLParam(r, "attachmentId")
+	attachmentID, err := uuid.Parse(attachmentIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid attachment ID", nil)
+		return
+	}
+
+	err = h.service.Delete(r.Context(), tenantID, productID, attachmentID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to delete attachment")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,100 @@
+package attachment
+
+import (
+	"errors"
+	"io"
+	"motico-api/internal/domain/attachment"
+	"motico-api/internal/domain/attachment/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// multipartOverhead es el margen para las cabeceras y límites del formulario por
+// encima del tamaño máximo del archivo.
+const multipartOverhead = 64 << 10
+
+// Upload
+// @Summary      Upload product attachment
+// @Description  Upload an image (jpeg, png, gif, webp) or a pdf document as the "file" field of a multipart form. The type is detected from the file content. Images get a thumbnail and are listed in the product images in upload order
+// @Tags         products
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Param        file         formData  file    true  "File to upload"
+// @Success      201          {object}  restentities.AttachmentResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Product not found"
+// @Failure      413          {object}  map[string]interface{}  "File too large"
+// @Failure      415          {object}  map[string]interface{}  "Unsupported file type"
+// @Failure      422          {object}  map[string]interface{}  "Empty file or image cannot be decoded"
+// @Security     BearerAuth
+// @Router       /products/{id}/attachments [post]
+func (h *Handler) Upload(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.config.Storage.MaxUploadSize+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "request must be a multipart form", nil)
+		return
+	}
+
+	// Se lee el archivo directamente del cuerpo, sin pasar por archivos temporales
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			response.Error(w, http.StatusBadRequest, "file field is required", nil)
+			return
+		}
+		if err != nil {
+			uploadError(w, r, err)
+			return
+		}
+		if part.FormName() != "file" {
+			continue
+		}
+
+		uploaded, err := h.service.Upload(r.Context(), attachment.UploadRequest{
+			TenantID:  tenantID,
+			ProductID: productID,
+			FileName:  part.FileName(),
+			Content:   part,
+			Actor:     context.GetUserID(r.Context()),
+		})
+		if err != nil {
+			uploadError(w, r, err)
+			return
+		}
+
+		response.JSON(w, http.StatusCreated, toAttachmentResponse(uploaded))
+		return
+	}
+}
+
+// uploadError responde como ErrFileTooLarge el corte de MaxBytesReader, que
+// llega como error de lectura del cuerpo.
+func uploadError(w http.ResponseWriter, r *http.Request, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		err = entities.ErrFileTooLarge
+	}
+	response.HandleError(w, r, err, "failed to upload attachment")
+}
@@ -52,11 +52,7 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 
 	loginResp, err := h.authService.Login(authReq)
 	if err != nil {
-		if err == authdomain.ErrInvalidCredentials {
-			response.Error(w, http.StatusUnauthorized, "invalid credentials", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "internal server error", nil)
+		response.HandleError(w, r, err, "internal server error")
 		return
 	}
 
@@ -0,0 +1,153 @@
+package catalog
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/catalog/entities"
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// ListBarcodes
+// @Summary      List barcodes of a catalog item
+// @Description  Get the barcodes assigned to a catalog item
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Success      200          {object}  restentities.ListBarcodesResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/barcodes [get]
+func (h *Handler) ListBarcodes(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	barcodes, err := h.service.ListBarcodes(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list barcodes")
+		return
+	}
+
+	responses := make([]restentities.BarcodeResponse, len(barcodes))
+	for i, barcode := range barcodes {
+		responses[i] = toBarcodeResponse(barcode)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListBarcodesResponse{Data: responses})
+}
+
+// AddBarcode
+// @Summary      Add barcode to a catalog item
+// @Description  Assign an EAN-13, UPC-A or Code128 barcode to a catalog item. The format is detected from the code when omitted and check digits are verified
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                          true  "Tenant ID"
+// @Param        id           path      string                          true  "Catalog item ID"
+// @Param        request      body      restentities.AddBarcodeRequest  true  "Barcode data"
+// @Success      201          {object}  restentities.BarcodeResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid barcode"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Failure      409          {object}  map[string]interface{}  "Barcode already exists"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/barcodes [post]
+func (h *Handler) AddBarcode(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	var req restentities.AddBarcodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	barcode, err := h.service.AddBarcode(r.Context(), catalog.AddBarcodeRequest{
+		TenantID:      tenantID,
+		CatalogItemID: id,
+		Code:          req.Code,
+		Format:        entities.BarcodeFormat(req.Format),
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to add barcode")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toBarcodeResponse(barcode))
+}
+
+// RemoveBarcode
+// @Summary      Remove barcode from a catalog item
+// @Description  Unassign a barcode from a catalog item
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Param        code         path      string  true  "Barcode"
+// @Success      204          "No Content"
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Barcode not found"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/barcodes/{code} [delete]
+func (h *Handler) RemoveBarcode(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	err = h.service.RemoveBarcode(r.Context(), tenantID, id, chi.URLParam(r, "code"))
+	if err != nil {
+		response.HandleError(w, r, err, "failed to remove barcode")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
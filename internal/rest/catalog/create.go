@@ -0,0 +1,68 @@
+package catalog
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/catalog/entities"
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/money"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create catalog item
+// @Description  Create a new item in the tenant master catalog
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                true  "Tenant ID"
+// @Param        request      body      restentities.CreateCatalogItemRequest  true  "Catalog item data"
+// @Success      201         {object}  restentities.CatalogItemResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409         {object}  map[string]interface{}  "Catalog SKU already exists"
+// @Security     BearerAuth
+// @Router       /catalog [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreateCatalogItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	createReq := catalog.CreateRequest{
+		TenantID:     tenantID,
+		CategoryID:   req.CategoryID,
+		Name:         req.Name,
+		Description:  req.Description,
+		SKU:          req.SKU,
+		DefaultPrice: req.DefaultPrice,
+		Currency:     (*money.Currency)(req.Currency),
+		Tracking:     (*entities.Tracking)(req.Tracking),
+	}
+
+	item, err := h.service.Create(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create catalog item")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toCatalogItemResponse(item))
+}
@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/product"
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// CreateListing
+// @Summary      List a catalog item in a store
+// @Description  Create a store listing for a catalog item, optionally overriding its price. attributes are validated against the category attribute schema
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                            true  "Tenant ID"
+// @Param        id           path      string                            true  "Catalog item ID"
+// @Param        request      body      restentities.CreateListingRequest  true  "Listing data"
+// @Success      201          {object}  restentities.ListingResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Failure      409          {object}  map[string]interface{}  "Catalog item already listed in store"
+// @Failure      422          {object}  map[string]interface{}  "Store not found or archived, or attributes do not match the category schema"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/listings [post]
+func (h *Handler) CreateListing(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	var req restentities.CreateListingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	listing, err := h.productService.Create(r.Context(), product.CreateRequest{
+		TenantID:      tenantID,
+		StoreID:       req.StoreID,
+		CatalogItemID: &id,
+		Price:         req.PriceOverride,
+		Active:        req.Active,
+		Attributes:    req.Attributes,
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create listing")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toListingResponse(listing))
+}
@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateCatalogItemRequest struct {
+	CategoryID   uuid.UUID     `json:"category_id" validate:"required"`
+	Name         string        `json:"name" validate:"required,max=255"`
+	Description  *string       `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SKU          *string       `json:"sku,omitempty" validate:"omitempty,max=100"`
+	DefaultPrice *money.Amount `json:"default_price,omitempty"`
+	Currency     *string       `json:"currency,omitempty" validate:"omitempty,len=3,alpha"`
+	Tracking     *string       `json:"tracking,omitempty" validate:"omitempty,oneof=none lot serial"`
+}
+
+type UpdateCatalogItemRequest struct {
+	CategoryID   uuid.UUID     `json:"category_id" validate:"required"`
+	Name         string        `json:"name" validate:"required,max=255"`
+	Description  *string       `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SKU          *string       `json:"sku,omitempty" validate:"omitempty,max=100"`
+	DefaultPrice *money.Amount `json:"default_price,omitempty"`
+	Currency     *string       `json:"currency,omitempty" validate:"omitempty,len=3,alpha"`
+	Tracking     *string       `json:"tracking,omitempty" validate:"omitempty,oneof=none lot serial"`
+}
+
+type PartialUpdateCatalogItemRequest struct {
+	CategoryID   *uuid.UUID    `json:"category_id,omitempty"`
+	Name         *string       `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description  *string       `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SKU          *string       `json:"sku,omitempty" validate:"omitempty,max=100"`
+	DefaultPrice *money.Amount `json:"default_price,omitempty"`
+	Currency     *string       `json:"currency,omitempty" validate:"omitempty,len=3,alpha"`
+	Tracking     *string       `json:"tracking,omitempty" validate:"omitempty,oneof=none lot serial"`
+}
+
+type CreateListingRequest struct {
+	StoreID       uuid.UUID              `json:"store_id" validate:"required"`
+	PriceOverride *money.Amount          `json:"price_override,omitempty"`
+	Active        *bool                  `json:"active,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type AddBarcodeRequest struct {
+	Code   string `json:"code" validate:"required,max=80"`
+	Format string `json:"format,omitempty" validate:"omitempty,oneof=ean13 upc_a code128"`
+}
+
+type CatalogItemResponse struct {
+	ID           uuid.UUID     `json:"id"`
+	TenantID     uuid.UUID     `json:"tenant_id"`
+	CategoryID   uuid.UUID     `json:"category_id"`
+	Name         string        `json:"name"`
+	Description  *string       `json:"description,omitempty"`
+	SKU          *string       `json:"sku,omitempty"`
+	DefaultPrice *money.Amount `json:"default_price,omitempty"`
+	Currency     string        `json:"currency"`
+	Tracking     string        `json:"tracking"`
+	ListingCount int           `json:"listing_count"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+type ListingResponse struct {
+	ProductID     uuid.UUID     `json:"product_id"`
+	StoreID       uuid.UUID     `json:"store_id"`
+	Price         *money.Amount `json:"price,omitempty"`
+	PriceOverride *money.Amount `json:"price_override,omitempty"`
+	ListPrice     *money.Amount `json:"list_price,omitempty"`
+	Currency      string        `json:"currency"`
+	Active        bool          `json:"active"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+type ListCatalogItemsResponse struct {
+	Data       []CatalogItemResponse `json:"data"`
+	Pagination PaginationInfo        `json:"pagination"`
+}
+
+type BarcodeResponse struct {
+	ID            uuid.UUID `json:"id"`
+	CatalogItemID uuid.UUID `json:"catalog_item_id"`
+	Code          string    `json:"code"`
+	Format        string    `json:"format"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ListBarcodesResponse struct {
+	Data []BarcodeResponse `json:"data"`
+}
+
+type ListListingsResponse struct {
+	Data []ListingResponse `json:"data"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
+
+// AddFitmentRequest: sin year_to la compatibilidad es de un solo año.
+type AddFitmentRequest struct {
+	Make     string  `json:"make" validate:"required,max=100"`
+	Model    string  `json:"model" validate:"required,max=100"`
+	YearFrom int     `json:"year_from" validate:"required,min=1900,max=2100"`
+	YearTo   int     `json:"year_to,omitempty" validate:"omitempty,min=1900,max=2100"`
+	Notes    *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+type FitmentResponse struct {
+	ID            uuid.UUID `json:"id"`
+	CatalogItemID uuid.UUID `json:"catalog_item_id"`
+	Make          string    `json:"make"`
+	Model         string    `json:"model"`
+	YearFrom      int       `json:"year_from"`
+	YearTo        int       `json:"year_to"`
+	Notes         *string   `json:"notes,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ListFitmentsResponse struct {
+	Data []FitmentResponse `json:"data"`
+}
+
+// ImportFitmentsRequest: con replace las compatibilidades de los artículos
+// importados quedan exactamente como en las filas.
+type ImportFitmentsRequest struct {
+	Rows    []FitmentRowRequest `json:"rows" validate:"required,min=1,max=5000,dive"`
+	Replace bool                `json:"replace"`
+}
+
+type FitmentRowRequest struct {
+	SKU      string  `json:"sku" validate:"required,max=100"`
+	Make     string  `json:"make" validate:"required,max=100"`
+	Model    string  `json:"model" validate:"required,max=100"`
+	YearFrom int     `json:"year_from" validate:"required"`
+	YearTo   int     `json:"year_to,omitempty"`
+	Notes    *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+type FitmentImportResponse struct {
+	Created  int `json:"created"`
+	Skipped  int `json:"skipped"`
+	Replaced int `json:"replaced"`
+}
@@ -0,0 +1,224 @@
+package catalog
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/catalog"
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// ListFitments
+// @Summary      List fitments of a catalog item
+// @Description  Get the vehicles (make, model and year range) a catalog item fits
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Success      200          {object}  restentities.ListFitmentsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/fitments [get]
+func (h *Handler) ListFitments(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	fitments, err := h.service.ListFitments(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list fitments")
+		return
+	}
+
+	responses := make([]restentities.FitmentResponse, len(fitments))
+	for i, fitment := range fitments {
+		responses[i] = toFitmentResponse(fitment)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListFitmentsResponse{Data: responses})
+}
+
+// AddFitment
+// @Summary      Add fitment to a catalog item
+// @Description  Record that a catalog item fits a vehicle make and model for a range of years. Without year_to the fitment covers a single year
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                          true  "Tenant ID"
+// @Param        id           path      string                          true  "Catalog item ID"
+// @Param        request      body      restentities.AddFitmentRequest  true  "Fitment data"
+// @Success      201          {object}  restentities.FitmentResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid fitment"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Failure      409          {object}  map[string]interface{}  "Fitment already exists"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/fitments [post]
+func (h *Handler) AddFitment(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	var req restentities.AddFitmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	fitment, err := h.service.AddFitment(r.Context(), catalog.AddFitmentRequest{
+		TenantID:      tenantID,
+		CatalogItemID: id,
+		Make:          req.Make,
+		Model:         req.Model,
+		YearFrom:      req.YearFrom,
+		YearTo:        req.YearTo,
+		Notes:         req.Notes,
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to add fitment")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toFitmentResponse(fitment))
+}
+
+// RemoveFitment
+// @Summary      Remove fitment from a catalog item
+// @Description  Delete a fitment of a catalog item
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Param        fitmentId    path      string  true  "Fitment ID"
+// @Success      204          "No Content"
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Fitment not found"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/fitments/{fitmentId} [delete]
+func (h *Handler) RemoveFitment(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	fitmentIDStr := chi.URLParam(r, "fitmentId")
+	fitmentID, err := uuid.Parse(fitmentIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid fitment ID", nil)
+		return
+	}
+
+	err = h.service.RemoveFitment(r.Context(), tenantID, id, fitmentID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to remove fitment")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ImportFitments
+// @Summary      Import fitments
+// @Description  Bulk import fitments identified by catalog SKU. Rows that already exist are skipped. With replace, the existing fitments of the imported catalog items are deleted first. If any row is invalid nothing is imported and every invalid row is reported
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                              true  "Tenant ID"
+// @Param        request      body      restentities.ImportFitmentsRequest  true  "Fitment rows"
+// @Success      200          {object}  restentities.FitmentImportResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      422          {object}  map[string]interface{}  "Invalid rows"
+// @Security     BearerAuth
+// @Router       /catalog/fitments/import [post]
+func (h *Handler) ImportFitments(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.ImportFitmentsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	rows := make([]catalog.FitmentRow, len(req.Rows))
+	for i, row := range req.Rows {
+		rows[i] = catalog.FitmentRow{
+			SKU:      row.SKU,
+			Make:     row.Make,
+			Model:    row.Model,
+			YearFrom: row.YearFrom,
+			YearTo:   row.YearTo,
+			Notes:    row.Notes,
+		}
+	}
+
+	result, err := h.service.ImportFitments(r.Context(), catalog.ImportFitmentsRequest{
+		TenantID: tenantID,
+		Rows:     rows,
+		Replace:  req.Replace,
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to import fitments")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, restentities.FitmentImportResponse{
+		Created:  result.Created,
+		Skipped:  result.Skipped,
+		Replaced: result.Replaced,
+	})
+}
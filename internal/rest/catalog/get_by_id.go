@@ -0,0 +1,48 @@
+package catalog
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// GetByID
+// @Summary      Get catalog item by ID
+// @Description  Get a catalog item of the tenant master catalog
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Success      200         {object}  restentities.CatalogItemResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Catalog item not found"
+// @Security     BearerAuth
+// @Router       /catalog/{id} [get]
+func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	item, err := h.service.GetByID(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get catalog item")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCatalogItemResponse(item))
+}
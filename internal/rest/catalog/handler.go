@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	restentities "motico-api/internal/rest/catalog/entities"
+)
+
+type Handler struct {
+	service        *catalog.Service
+	productService *product.Service
+	config         *config.Config
+}
+
+func NewHandler(service *catalog.Service, productService *product.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service:        service,
+		productService: productService,
+		config:         cfg,
+	}
+}
+
+func toCatalogItemResponse(item *entities.CatalogItem) restentities.CatalogItemResponse {
+	return restentities.CatalogItemResponse{
+		ID:           item.ID,
+		TenantID:     item.TenantID,
+		CategoryID:   item.CategoryID,
+		Name:         item.Name,
+		Description:  item.Description,
+		SKU:          item.SKU,
+		DefaultPrice: item.DefaultPrice,
+		Currency:     string(item.Currency),
+		Tracking:     string(item.Tracking),
+		ListingCount: item.ListingCount,
+		CreatedAt:    item.CreatedAt,
+		UpdatedAt:    item.UpdatedAt,
+	}
+}
+
+func toListingResponse(p *productentities.Product) restentities.ListingResponse {
+	return restentities.ListingResponse{
+		ProductID:     p.ID,
+		StoreID:       p.StoreID,
+		Price:         p.EffectivePrice(),
+		PriceOverride: p.Price,
+		ListPrice:     p.ListPrice,
+		Currency:      string(p.Currency),
+		Active:        p.Active,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+func toBarcodeResponse(b *entities.Barcode) restentities.BarcodeResponse {
+	return restentities.BarcodeResponse{
+		ID:            b.ID,
+		CatalogItemID: b.CatalogItemID,
+		Code:          b.Code,
+		Format:        string(b.Format),
+		CreatedAt:     b.CreatedAt,
+	}
+}
+
+func toFitmentResponse(f *entities.Fitment) restentities.FitmentResponse {
+	return restentities.FitmentResponse{
+		ID:            f.ID,
+		CatalogItemID: f.CatalogItemID,
+		Make:          f.Make,
+		Model:         f.Model,
+		YearFrom:      f.YearFrom,
+		YearTo:        f.YearTo,
+		Notes:         f.Notes,
+		CreatedAt:     f.CreatedAt,
+	}
+}
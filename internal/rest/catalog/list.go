@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// List
+// @Summary      List catalog items
+// @Description  Get paginated list of the tenant master catalog
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        category_id  query     string  false "Filter by category ID, including its subcategories"
+// @Success      200          {object}  restentities.ListCatalogItemsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /catalog [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var categoryID *uuid.UUID
+	if categoryIDStr := r.URL.Query().Get("category_id"); categoryIDStr != "" {
+		id, err := uuid.Parse(categoryIDStr)
+		if err == nil {
+			categoryID = &id
+		}
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	items, err := h.service.List(r.Context(), tenantID, categoryID, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list catalog items")
+		return
+	}
+
+	responses := make([]restentities.CatalogItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = toCatalogItemResponse(item)
+	}
+
+	total := len(items)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListCatalogItemsResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
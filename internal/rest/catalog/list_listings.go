@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"motico-api/internal/domain/product"
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListListings
+// @Summary      List store listings of a catalog item
+// @Description  Get the stores where a catalog item is listed, with their effective price and status
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Success      200          {object}  restentities.ListListingsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Security     BearerAuth
+// @Router       /catalog/{id}/listings [get]
+func (h *Handler) ListListings(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	item, err := h.service.GetByID(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get catalog item")
+		return
+	}
+
+	filter := product.ListFilter{CatalogItemID: &item.ID, IncludeVariants: true}
+	listings, err := h.productService.List(r.Context(), tenantID, filter, item.ListingCount+1, 0)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list catalog listings")
+		return
+	}
+
+	responses := make([]restentities.ListingResponse, len(listings))
+	for i, listing := range listings {
+		responses[i] = toListingResponse(listing)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListListingsResponse{Data: responses})
+}
@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/catalog"
+	"motico-api/internal/domain/catalog/entities"
+	restentities "motico-api/internal/rest/catalog/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/money"
+	"motico-api/pkg/validator"
+)
+
+// ParcialUpdate
+// @Summary      Partially update catalog item
+// @Description  Update only the provided fields of a catalog item. Name, description, category and SKU are applied to every store listing
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                true  "Tenant ID"
+// @Param        id           path      string                                true  "Catalog item ID"
+// @Param        request      body      restentities.PartialUpdateCatalogItemRequest  true  "Catalog item data"
+// @Success      200          {object}  restentities.CatalogItemResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Catalog item not found"
+// @Failure      409          {object}  map[string]interface{}  "Catalog SKU already exists"
+// @Security     BearerAuth
+// @Router       /catalog/{id} [patch]
+func (h *Handler) ParcialUpdate(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	var req restentities.PartialUpdateCatalogItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	updateReq := catalog.UpdateRequest{
+		ID:           id,
+		TenantID:     tenantID,
+		CategoryID:   req.CategoryID,
+		Name:         req.Name,
+		Description:  req.Description,
+		SKU:          req.SKU,
+		DefaultPrice: req.DefaultPrice,
+		Currency:     (*money.Currency)(req.Currency),
+		Tracking:     (*entities.Tracking)(req.Tracking),
+	}
+
+	item, err := h.service.Update(r.Context(), updateReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to update catalog item")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCatalogItemResponse(item))
+}
@@ -0,0 +1,49 @@
+package catalog
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Remove
+// @Summary      Delete catalog item
+// @Description  Delete a catalog item that is not listed in any store
+// @Tags         catalog
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Catalog item ID"
+// @Success      204          "No Content"
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Catalog item not found"
+// @Failure      409         {object}  map[string]interface{}  "Catalog item has store listings"
+// @Security     BearerAuth
+// @Router       /catalog/{id} [delete]
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid catalog item ID", nil)
+		return
+	}
+
+	err = h.service.Delete(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to delete catalog item")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
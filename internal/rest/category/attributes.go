@@ -0,0 +1,52 @@
+package category
+
+import (
+	restentities "motico-api/internal/rest/category/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// AttributeSchema
+// @Summary      Get category attribute schema
+// @Description  Get the effective attribute schema of a category: its own attributes plus the ones inherited from its ancestors. Products in the category are validated against it
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Category ID"
+// @Success      200          {object}  restentities.AttributeSchemaResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Category not found"
+// @Security     BearerAuth
+// @Router       /categories/{id}/attributes [get]
+func (h *Handler) AttributeSchema(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid category ID", nil)
+		return
+	}
+
+	schema, err := h.service.AttributeSchema(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get attribute schema")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, restentities.AttributeSchemaResponse{
+		CategoryID: id,
+		Data:       toAttributeResponses(schema),
+	})
+}
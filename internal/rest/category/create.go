@@ -3,7 +3,6 @@ package category
 import (
 	"encoding/json"
 	"motico-api/internal/domain/category"
-	"motico-api/internal/domain/category/entities"
 	restentities "motico-api/internal/rest/category/entities"
 	"motico-api/internal/rest/response"
 	"motico-api/pkg/validator"
@@ -15,7 +14,7 @@ import (
 
 // Create
 // @Summary      Create category
-// @Description  Create a new category for the tenant
+// @Description  Create a new category for the tenant, at the root or under parent_id. Names are unique among sibling categories. attributes defines the typed spec fields of its products; subcategories inherit them
 // @Tags         categories
 // @Accept       json
 // @Produce      json
@@ -25,6 +24,7 @@ import (
 // @Failure      400          {object}  map[string]interface{}  "Invalid request"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
 // @Failure      409          {object}  map[string]interface{}  "Category name already exists"
+// @Failure      422          {object}  map[string]interface{}  "Invalid attribute schema or parent category not found"
 // @Failure      500          {object}  map[string]interface{}  "Internal server error"
 // @Security     BearerAuth
 // @Router       /categories [post]
@@ -43,32 +43,23 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
 	createReq := category.CreateRequest{
 		TenantID:    tenantID,
+		ParentID:    req.ParentID,
 		Name:        req.Name,
 		Description: req.Description,
+		Attributes:  toAttributeSchema(req.Attributes),
 	}
 
 	category, err := h.service.Create(r.Context(), createReq)
 	if err != nil {
-		if err == entities.ErrCategoryNameExists {
-			response.Error(w, http.StatusConflict, "category name already exists", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to create category", nil)
+		response.HandleError(w, r, err, "failed to create category")
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, restentities.CategoryResponse{
-		ID:          category.ID,
-		TenantID:    category.TenantID,
-		Name:        category.Name,
-		Description: category.Description,
-		CreatedAt:   category.CreatedAt,
-		UpdatedAt:   category.UpdatedAt,
-	})
+	response.JSON(w, http.StatusCreated, toCategoryResponse(category))
 }
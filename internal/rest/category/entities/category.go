@@ -7,27 +7,72 @@ import (
 )
 
 type CreateCategoryRequest struct {
-	Name        string  `json:"name" validate:"required,max=255"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ParentID    *uuid.UUID                   `json:"parent_id,omitempty"`
+	Name        string                       `json:"name" validate:"required,max=255"`
+	Description *string                      `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Attributes  []AttributeDefinitionRequest `json:"attributes,omitempty" validate:"omitempty,max=100,dive"`
 }
 
 type UpdateCategoryRequest struct {
-	Name        string  `json:"name" validate:"required,max=255"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Name        string                       `json:"name" validate:"required,max=255"`
+	Description *string                      `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Attributes  []AttributeDefinitionRequest `json:"attributes,omitempty" validate:"omitempty,max=100,dive"`
 }
 
 type PartialUpdateCategoryRequest struct {
-	Name        *string `json:"name,omitempty" validate:"omitempty,max=255"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Name        *string                      `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description *string                      `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Attributes  []AttributeDefinitionRequest `json:"attributes,omitempty" validate:"omitempty,max=100,dive"`
+}
+
+// AttributeDefinitionRequest define un atributo de los productos de la categoría.
+// options solo aplica a enum y unit solo a unit.
+type AttributeDefinitionRequest struct {
+	Key      string   `json:"key" validate:"required,max=50"`
+	Label    string   `json:"label,omitempty" validate:"max=100"`
+	Type     string   `json:"type" validate:"required,oneof=string number enum boolean unit"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty" validate:"omitempty,dive,max=100"`
+	Unit     string   `json:"unit,omitempty" validate:"max=20"`
+}
+
+// MoveCategoryRequest: parent_id nulo pasa la categoría a la raíz.
+type MoveCategoryRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"`
 }
 
 type CategoryResponse struct {
-	ID          uuid.UUID `json:"id"`
-	TenantID    uuid.UUID `json:"tenant_id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uuid.UUID                     `json:"id"`
+	TenantID    uuid.UUID                     `json:"tenant_id"`
+	ParentID    *uuid.UUID                    `json:"parent_id,omitempty"`
+	Name        string                        `json:"name"`
+	Description *string                       `json:"description,omitempty"`
+	Attributes  []AttributeDefinitionResponse `json:"attributes"`
+	Path        []CategoryPathResponse        `json:"path"`
+	CreatedAt   time.Time                     `json:"created_at"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+	DeletedAt   *time.Time                    `json:"deleted_at,omitempty"`
+}
+
+type AttributeDefinitionResponse struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label,omitempty"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+	Unit     string   `json:"unit,omitempty"`
+}
+
+// AttributeSchemaResponse es el esquema efectivo: el de la categoría más el heredado.
+type AttributeSchemaResponse struct {
+	CategoryID uuid.UUID                     `json:"category_id"`
+	Data       []AttributeDefinitionResponse `json:"data"`
+}
+
+// CategoryPathResponse es un tramo del breadcrumb, desde la categoría raíz.
+type CategoryPathResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
 type ListCategoriesResponse struct {
@@ -1,8 +1,6 @@
 package category
 
 import (
-	"motico-api/internal/domain/category/entities"
-	restentities "motico-api/internal/rest/category/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
 
@@ -42,20 +40,9 @@ func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
 
 	category, err := h.service.GetByID(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrCategoryNotFound {
-			response.Error(w, http.StatusNotFound, "category not found", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to get category", nil)
+		response.HandleError(w, r, err, "failed to get category")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.CategoryResponse{
-		ID:          category.ID,
-		TenantID:    category.TenantID,
-		Name:        category.Name,
-		Description: category.Description,
-		CreatedAt:   category.CreatedAt,
-		UpdatedAt:   category.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toCategoryResponse(category))
 }
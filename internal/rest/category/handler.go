@@ -3,6 +3,8 @@ package category
 import (
 	"motico-api/config"
 	"motico-api/internal/domain/category"
+	"motico-api/internal/domain/category/entities"
+	restentities "motico-api/internal/rest/category/entities"
 )
 
 type Handler struct {
@@ -16,3 +18,57 @@ func NewHandler(service *category.Service, cfg *config.Config) *Handler {
 		config:  cfg,
 	}
 }
+
+func toCategoryResponse(category *entities.Category) restentities.CategoryResponse {
+	path := make([]restentities.CategoryPathResponse, len(category.Path))
+	for i, ref := range category.Path {
+		path[i] = restentities.CategoryPathResponse{ID: ref.ID, Name: ref.Name}
+	}
+
+	return restentities.CategoryResponse{
+		ID:          category.ID,
+		TenantID:    category.TenantID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Description: category.Description,
+		Attributes:  toAttributeResponses(category.Attributes),
+		Path:        path,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+		DeletedAt:   category.DeletedAt,
+	}
+}
+
+// toAttributeSchema conserva el nil para distinguir un esquema no enviado de uno vacío.
+func toAttributeSchema(reqs []restentities.AttributeDefinitionRequest) entities.AttributeSchema {
+	if reqs == nil {
+		return nil
+	}
+	schema := make(entities.AttributeSchema, len(reqs))
+	for i, req := range reqs {
+		schema[i] = entities.AttributeDefinition{
+			Key:      req.Key,
+			Label:    req.Label,
+			Type:     entities.AttributeType(req.Type),
+			Required: req.Required,
+			Options:  req.Options,
+			Unit:     req.Unit,
+		}
+	}
+	return schema
+}
+
+func toAttributeResponses(schema entities.AttributeSchema) []restentities.AttributeDefinitionResponse {
+	responses := make([]restentities.AttributeDefinitionResponse, len(schema))
+	for i, def := range schema {
+		responses[i] = restentities.AttributeDefinitionResponse{
+			Key:      def.Key,
+			Label:    def.Label,
+			Type:     string(def.Type),
+			Required: def.Required,
+			Options:  def.Options,
+			Unit:     def.Unit,
+		}
+	}
+	return responses
+}
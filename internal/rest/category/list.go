@@ -1,7 +1,6 @@
 package category
 
 import (
-	"fmt"
 	restentities "motico-api/internal/rest/category/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
@@ -9,20 +8,28 @@ import (
 
 	"github.com/google/uuid"
 	"motico-api/pkg/context"
+	"motico-api/pkg/query"
 )
 
 // List
 // @Summary      List categories
-// @Description  Get paginated list of categories for the tenant
+// @Description  Get paginated list of categories for the tenant, each with its breadcrumb path from the root category
 // @Tags         categories
 // @Accept       json
 // @Produce      json
 // @Param        X-Tenant-ID  header    string  true  "Tenant ID"
 // @Param        page         query     int     false "Page number" default(1)
 // @Param        limit        query     int     false "Items per page" default(20)
+// @Param        sort         query     string  false "Sort fields (name, created_at, updated_at), prefix with - for descending"
+// @Param        name_contains   query  string  false "Filter by name substring"
+// @Param        created_after   query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before  query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since   query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        include_deleted query  bool    false "Include soft-deleted categories (admins only)"
 // @Success      200          {object}  restentities.ListCategoriesResponse
-// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID"
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "include_deleted requires the admin role"
 // @Failure      500          {object}  map[string]interface{}  "Internal server error"
 // @Security     BearerAuth
 // @Router       /categories [get]
@@ -34,6 +41,8 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	params := query.Parse(r.URL.Query(), "page", "limit")
+
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	if page < 1 {
 		page = 1
@@ -44,24 +53,15 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	}
 	offset := (page - 1) * limit
 
-	categories, err := h.service.List(r.Context(), tenantID, limit, offset)
+	categories, err := h.service.List(r.Context(), tenantID, params, limit, offset)
 	if err != nil {
-		// Log del error para debugging (temporal)
-		// En producción, usar el logger del handler
-		response.Error(w, http.StatusInternalServerError, fmt.Sprintf("failed to list categories: %v", err), nil)
+		response.HandleError(w, r, err, "failed to list categories")
 		return
 	}
 
 	responses := make([]restentities.CategoryResponse, len(categories))
 	for i, cat := range categories {
-		responses[i] = restentities.CategoryResponse{
-			ID:          cat.ID,
-			TenantID:    cat.TenantID,
-			Name:        cat.Name,
-			Description: cat.Description,
-			CreatedAt:   cat.CreatedAt,
-			UpdatedAt:   cat.UpdatedAt,
-		}
+		responses[i] = toCategoryResponse(cat)
 	}
 
 	total := len(categories)
@@ -0,0 +1,65 @@
+package category
+
+import (
+	"encoding/json"
+	restentities "motico-api/internal/rest/category/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Move
+// @Summary      Move category
+// @Description  Reparent a category, together with its subcategories and products. A null parent_id moves it to the root. A category cannot be moved under itself or one of its subcategories
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                            true  "Tenant ID"
+// @Param        id           path      string                            true  "Category ID"
+// @Param        request      body      restentities.MoveCategoryRequest  true  "New parent"
+// @Success      200          {object}  restentities.CategoryResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Category not found"
+// @Failure      409          {object}  map[string]interface{}  "Category name already exists under the new parent"
+// @Failure      422          {object}  map[string]interface{}  "Parent category not found or inside the moved category"
+// @Security     BearerAuth
+// @Router       /categories/{id}/move [post]
+func (h *Handler) Move(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid category ID", nil)
+		return
+	}
+
+	var req restentities.MoveCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	category, err := h.service.Move(r.Context(), tenantID, id, req.ParentID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to move category")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCategoryResponse(category))
+}
@@ -1,7 +1,6 @@
 package category
 
 import (
-	"motico-api/internal/domain/category/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
 
@@ -12,7 +11,7 @@ import (
 
 // Remove
 // @Summary      Delete category
-// @Description  Delete a category by ID
+// @Description  Delete a category by ID. Categories with products or subcategories cannot be deleted
 // @Tags         categories
 // @Accept       json
 // @Produce      json
@@ -22,7 +21,7 @@ import (
 // @Failure      400          {object}  map[string]interface{}  "Invalid request"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
 // @Failure      404          {object}  map[string]interface{}  "Category not found"
-// @Failure      409          {object}  map[string]interface{}  "Category has associated products"
+// @Failure      409          {object}  map[string]interface{}  "Category has associated products or subcategories"
 // @Security     BearerAuth
 // @Router       /categories/{id} [delete]
 func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
@@ -42,15 +41,7 @@ func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.Delete(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrCategoryNotFound {
-			response.Error(w, http.StatusNotFound, "category not found", nil)
-			return
-		}
-		if err == entities.ErrCategoryHasProducts {
-			response.Error(w, http.StatusConflict, "category has associated products and cannot be deleted", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to delete category", nil)
+		response.HandleError(w, r, err, "failed to delete category")
 		return
 	}
 
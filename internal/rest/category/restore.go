@@ -0,0 +1,51 @@
+package category
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Restore
+// @Summary      Restore category
+// @Description  Restore a soft-deleted category. Its parent must not be deleted and no sibling may have taken its name in the meantime. Admins only
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Category ID"
+// @Success      200          {object}  restentities.CategoryResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the admin role"
+// @Failure      404          {object}  map[string]interface{}  "Deleted category not found"
+// @Failure      409          {object}  map[string]interface{}  "Category name already exists under the same parent"
+// @Failure      422          {object}  map[string]interface{}  "Parent category is deleted"
+// @Security     BearerAuth
+// @Router       /categories/{id}/restore [post]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid category ID", nil)
+		return
+	}
+
+	category, err := h.service.Restore(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to restore category")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCategoryResponse(category))
+}
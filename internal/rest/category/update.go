@@ -3,7 +3,6 @@ package category
 import (
 	"encoding/json"
 	"motico-api/internal/domain/category"
-	"motico-api/internal/domain/category/entities"
 	restentities "motico-api/internal/rest/category/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
@@ -28,6 +27,7 @@ import (
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
 // @Failure      404          {object}  map[string]interface{}  "Category not found"
 // @Failure      409          {object}  map[string]interface{}  "Category name already exists"
+// @Failure      422          {object}  map[string]interface{}  "Invalid attribute schema"
 // @Security     BearerAuth
 // @Router       /categories/{id} [put]
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
@@ -52,7 +52,7 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
@@ -61,28 +61,14 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		TenantID:    tenantID,
 		Name:        &req.Name,
 		Description: req.Description,
+		Attributes:  toAttributeSchema(req.Attributes),
 	}
 
 	category, err := h.service.Update(r.Context(), updateReq)
 	if err != nil {
-		if err == entities.ErrCategoryNotFound {
-			response.Error(w, http.StatusNotFound, "category not found", nil)
-			return
-		}
-		if err == entities.ErrCategoryNameExists {
-			response.Error(w, http.StatusConflict, "category name already exists", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to update category", nil)
+		response.HandleError(w, r, err, "failed to update category")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.CategoryResponse{
-		ID:          category.ID,
-		TenantID:    category.TenantID,
-		Name:        category.Name,
-		Description: category.Description,
-		CreatedAt:   category.CreatedAt,
-		UpdatedAt:   category.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toCategoryResponse(category))
 }
@@ -0,0 +1,59 @@
+package countsession
+
+import (
+	"motico-api/internal/domain/auth"
+	"motico-api/internal/domain/cyclecount"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Approve
+// @Summary      Approve count session
+// @Description  Approve a submitted session and apply the variance of every counted line as a cycle count stock adjustment. Found units are valued at the snapshot average cost. Requires the manager role
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Count session ID"
+// @Success      200          {object}  restentities.CountSessionResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Caller is not a manager"
+// @Failure      404          {object}  map[string]interface{}  "Count session not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status, variance exceeds the held stock, or product or store archived"
+// @Security     BearerAuth
+// @Router       /count-sessions/{id}/approve [patch]
+func (h *Handler) Approve(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid count session ID", nil)
+		return
+	}
+
+	approveReq := cyclecount.ApproveRequest{
+		ID:        id,
+		TenantID:  tenantID,
+		Actor:     context.GetUserID(r.Context()),
+		ActorRole: auth.Role(context.GetRole(r.Context())),
+	}
+
+	session, err := h.service.Approve(r.Context(), approveReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to approve count session")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCountSessionResponse(session))
+}
@@ -0,0 +1,64 @@
+package countsession
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/cyclecount"
+	restentities "motico-api/internal/rest/countsession/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create count session
+// @Description  Open a cycle count session for a store, optionally limited to a category and its subcategories. The expected quantity and average cost of every product are snapshotted when the session opens
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                  true  "Tenant ID"
+// @Param        request      body      restentities.CreateCountSessionRequest  true  "Count session data"
+// @Success      201          {object}  restentities.CountSessionResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Another session is active for the same products"
+// @Failure      422          {object}  map[string]interface{}  "Unknown store or category, or nothing to count"
+// @Security     BearerAuth
+// @Router       /count-sessions [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreateCountSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	createReq := cyclecount.CreateRequest{
+		TenantID:   tenantID,
+		StoreID:    req.StoreID,
+		CategoryID: req.CategoryID,
+		Notes:      req.Notes,
+		CreatedBy:  context.GetUserID(r.Context()),
+	}
+
+	session, err := h.service.Create(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create count session")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toCountSessionResponse(session))
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateCountSessionRequest abre una sesión para toda la sucursal o, con
+// category_id, solo para los productos de esa categoría.
+type CreateCountSessionRequest struct {
+	StoreID    uuid.UUID  `json:"store_id" validate:"required"`
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	Notes      *string    `json:"notes,omitempty"`
+}
+
+type CountLineRequest struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  *int      `json:"quantity" validate:"required,gte=0"`
+}
+
+// RecordCountsRequest es lo que contó un dispositivo. Volver a enviar un producto
+// desde el mismo dispositivo reemplaza su conteo anterior.
+type RecordCountsRequest struct {
+	DeviceID string             `json:"device_id" validate:"required,max=100"`
+	Lines    []CountLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type DeviceCountResponse struct {
+	DeviceID  string    `json:"device_id"`
+	Quantity  int       `json:"quantity"`
+	CountedBy *string   `json:"counted_by,omitempty"`
+	CountedAt time.Time `json:"counted_at"`
+}
+
+type CountLineResponse struct {
+	ID               uuid.UUID             `json:"id"`
+	ProductID        uuid.UUID             `json:"product_id"`
+	ExpectedQuantity int                   `json:"expected_quantity"`
+	CountedQuantity  *int                  `json:"counted_quantity"`
+	Variance         int                   `json:"variance"`
+	UnitCost         money.Amount          `json:"unit_cost"`
+	VarianceValue    money.Amount          `json:"variance_value"`
+	Counts           []DeviceCountResponse `json:"counts"`
+}
+
+type CountSessionResponse struct {
+	ID          uuid.UUID           `json:"id"`
+	TenantID    uuid.UUID           `json:"tenant_id"`
+	StoreID     uuid.UUID           `json:"store_id"`
+	CategoryID  *uuid.UUID          `json:"category_id,omitempty"`
+	Status      string              `json:"status"`
+	Notes       *string             `json:"notes,omitempty"`
+	Lines       []CountLineResponse `json:"lines"`
+	CreatedBy   *string             `json:"created_by,omitempty"`
+	SubmittedAt *time.Time          `json:"submitted_at,omitempty"`
+	SubmittedBy *string             `json:"submitted_by,omitempty"`
+	ApprovedAt  *time.Time          `json:"approved_at,omitempty"`
+	ApprovedBy  *string             `json:"approved_by,omitempty"`
+	CancelledAt *time.Time          `json:"cancelled_at,omitempty"`
+	CancelledBy *string             `json:"cancelled_by,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+type VarianceSummaryResponse struct {
+	Lines         int          `json:"lines"`
+	CountedLines  int          `json:"counted_lines"`
+	VarianceLines int          `json:"variance_lines"`
+	UnitsOver     int          `json:"units_over"`
+	UnitsShort    int          `json:"units_short"`
+	NetUnits      int          `json:"net_units"`
+	ValueOver     money.Amount `json:"value_over"`
+	ValueShort    money.Amount `json:"value_short"`
+	NetValue      money.Amount `json:"net_value"`
+	ExpectedValue money.Amount `json:"expected_value"`
+	AccuracyPct   float64      `json:"accuracy_pct"`
+}
+
+// VarianceReportResponse resume las diferencias de la sesión y lista las líneas
+// que no coinciden con lo esperado.
+type VarianceReportResponse struct {
+	SessionID uuid.UUID               `json:"session_id"`
+	StoreID   uuid.UUID               `json:"store_id"`
+	Status    string                  `json:"status"`
+	Summary   VarianceSummaryResponse `json:"summary"`
+	Lines     []CountLineResponse     `json:"lines"`
+}
+
+type ListCountSessionsResponse struct {
+	Data       []CountSessionResponse `json:"data"`
+	Pagination PaginationInfo         `json:"pagination"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
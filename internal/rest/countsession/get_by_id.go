@@ -0,0 +1,48 @@
+package countsession
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// GetByID
+// @Summary      Get count session by ID
+// @Description  Get a specific count session by its ID
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Count session ID"
+// @Success      200          {object}  restentities.CountSessionResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Count session not found"
+// @Security     BearerAuth
+// @Router       /count-sessions/{id} [get]
+func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid count session ID", nil)
+		return
+	}
+
+	session, err := h.service.GetByID(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get count session")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCountSessionResponse(session))
+}
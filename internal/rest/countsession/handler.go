@@ -0,0 +1,73 @@
+package countsession
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/cyclecount"
+	"motico-api/internal/domain/cyclecount/entities"
+	restentities "motico-api/internal/rest/countsession/entities"
+)
+
+type Handler struct {
+	service *cyclecount.Service
+	config  *config.Config
+}
+
+func NewHandler(service *cyclecount.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toCountLineResponse(line *entities.CountLine) restentities.CountLineResponse {
+	counts := make([]restentities.DeviceCountResponse, len(line.Counts))
+	for i, c := range line.Counts {
+		counts[i] = restentities.DeviceCountResponse{
+			DeviceID:  c.DeviceID,
+			Quantity:  c.Quantity,
+			CountedBy: c.CountedBy,
+			CountedAt: c.CountedAt,
+		}
+	}
+
+	res := restentities.CountLineResponse{
+		ID:               line.ID,
+		ProductID:        line.ProductID,
+		ExpectedQuantity: line.ExpectedQuantity,
+		Variance:         line.Variance(),
+		UnitCost:         line.UnitCost,
+		VarianceValue:    line.VarianceValue(),
+		Counts:           counts,
+	}
+	if line.IsCounted() {
+		counted := line.CountedQuantity()
+		res.CountedQuantity = &counted
+	}
+	return res
+}
+
+func toCountSessionResponse(s *entities.CountSession) restentities.CountSessionResponse {
+	lines := make([]restentities.CountLineResponse, len(s.Lines))
+	for i := range s.Lines {
+		lines[i] = toCountLineResponse(&s.Lines[i])
+	}
+
+	return restentities.CountSessionResponse{
+		ID:          s.ID,
+		TenantID:    s.TenantID,
+		StoreID:     s.StoreID,
+		CategoryID:  s.CategoryID,
+		Status:      string(s.Status),
+		Notes:       s.Notes,
+		Lines:       lines,
+		CreatedBy:   s.CreatedBy,
+		SubmittedAt: s.SubmittedAt,
+		SubmittedBy: s.SubmittedBy,
+		ApprovedAt:  s.ApprovedAt,
+		ApprovedBy:  s.ApprovedBy,
+		CancelledAt: s.CancelledAt,
+		CancelledBy: s.CancelledBy,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
@@ -0,0 +1,98 @@
+package countsession
+
+import (
+	"motico-api/internal/domain/cyclecount"
+	"motico-api/internal/domain/cyclecount/entities"
+	restentities "motico-api/internal/rest/countsession/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/query"
+)
+
+// List
+// @Summary      List count sessions
+// @Description  Get paginated list of count sessions for the tenant
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        status       query     string  false "Filter by status (open, submitted, approved, cancelled)"
+// @Param        store_id     query     string  false "Filter by store ID"
+// @Param        sort         query     string  false "Sort fields (status, created_at, updated_at, approved_at), prefix with - for descending"
+// @Param        created_after    query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before   query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since    query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        approved_after  query  string  false "Approved after (RFC 3339 or YYYY-MM-DD)"
+// @Success      200          {object}  restentities.ListCountSessionsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /count-sessions [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var filter cyclecount.ListFilter
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.CountSessionStatus(statusStr)
+		if s.IsValid() {
+			filter.Status = &s
+		}
+	}
+
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		id, err := uuid.Parse(storeIDStr)
+		if err == nil {
+			filter.StoreID = &id
+		}
+	}
+
+	filter.Query = query.Parse(r.URL.Query(), "page", "limit", "status", "store_id")
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	sessions, err := h.service.List(r.Context(), tenantID, filter, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list count sessions")
+		return
+	}
+
+	responses := make([]restentities.CountSessionResponse, len(sessions))
+	for i, s := range sessions {
+		responses[i] = toCountSessionResponse(s)
+	}
+
+	total := len(sessions)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListCountSessionsResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
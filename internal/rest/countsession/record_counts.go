@@ -0,0 +1,79 @@
+package countsession
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/cyclecount"
+	restentities "motico-api/internal/rest/countsession/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// RecordCounts
+// @Summary      Record counts
+// @Description  Record what a device counted while the session is open. Several devices can count the same product and their counts are added up; a device that counts a product again replaces its previous count
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                            true  "Tenant ID"
+// @Param        id           path      string                            true  "Count session ID"
+// @Param        request      body      restentities.RecordCountsRequest  true  "Counted lines"
+// @Success      200          {object}  restentities.CountSessionResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Count session not found"
+// @Failure      409          {object}  map[string]interface{}  "Session is not open"
+// @Failure      422          {object}  map[string]interface{}  "Product is not part of the session"
+// @Security     BearerAuth
+// @Router       /count-sessions/{id}/counts [post]
+func (h *Handler) RecordCounts(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid count session ID", nil)
+		return
+	}
+
+	var req restentities.RecordCountsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	lines := make([]cyclecount.CountRequest, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = cyclecount.CountRequest{ProductID: line.ProductID, Quantity: *line.Quantity}
+	}
+
+	recordReq := cyclecount.RecordCountsRequest{
+		ID:       id,
+		TenantID: tenantID,
+		DeviceID: req.DeviceID,
+		Actor:    context.GetUserID(r.Context()),
+		Lines:    lines,
+	}
+
+	session, err := h.service.RecordCounts(r.Context(), recordReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to record counts")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCountSessionResponse(session))
+}
@@ -0,0 +1,56 @@
+package countsession
+
+import (
+	"motico-api/internal/domain/cyclecount"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Submit
+// @Summary      Submit count session
+// @Description  Submit the counts of an open session for approval. No more counts are accepted until the session is reopened
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Count session ID"
+// @Success      200          {object}  restentities.CountSessionResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Count session not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
+// @Security     BearerAuth
+// @Router       /count-sessions/{id}/submit [patch]
+func (h *Handler) Submit(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid count session ID", nil)
+		return
+	}
+
+	actionReq := cyclecount.ActionRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+	}
+
+	session, err := h.service.Submit(r.Context(), actionReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to submit count session")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toCountSessionResponse(session))
+}
@@ -0,0 +1,78 @@
+package countsession
+
+import (
+	restentities "motico-api/internal/rest/countsession/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Variances
+// @Summary      Count session variance report
+// @Description  Summarize the variances of a session: units and value over and short, net change and accuracy. Lists the counted lines that differ from the snapshot; with all=true every line is listed
+// @Tags         count-sessions
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true   "Tenant ID"
+// @Param        id           path      string  true   "Count session ID"
+// @Param        all          query     bool    false  "List every line, not only the ones with a variance"
+// @Success      200          {object}  restentities.VarianceReportResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Count session not found"
+// @Security     BearerAuth
+// @Router       /count-sessions/{id}/variances [get]
+func (h *Handler) Variances(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid count session ID", nil)
+		return
+	}
+
+	session, err := h.service.GetByID(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get count session")
+		return
+	}
+
+	all := r.URL.Query().Get("all") == "true"
+	lines := []restentities.CountLineResponse{}
+	for i := range session.Lines {
+		line := &session.Lines[i]
+		if all || line.Variance() != 0 {
+			lines = append(lines, toCountLineResponse(line))
+		}
+	}
+
+	summary := session.Summary()
+	response.JSON(w, http.StatusOK, restentities.VarianceReportResponse{
+		SessionID: session.ID,
+		StoreID:   session.StoreID,
+		Status:    string(session.Status),
+		Summary: restentities.VarianceSummaryResponse{
+			Lines:         summary.Lines,
+			CountedLines:  summary.CountedLines,
+			VarianceLines: summary.VarianceLines,
+			UnitsOver:     summary.UnitsOver,
+			UnitsShort:    summary.UnitsShort,
+			NetUnits:      summary.NetUnits,
+			ValueOver:     summary.ValueOver,
+			ValueShort:    summary.ValueShort,
+			NetValue:      summary.NetValue,
+			ExpectedValue: summary.ExpectedValue,
+			AccuracyPct:   summary.AccuracyPct,
+		},
+		Lines: lines,
+	})
+}
@@ -5,7 +5,9 @@ import (
 	authdomain "motico-api/internal/domain/auth"
 	"motico-api/internal/rest/response"
 	ctxpkg "motico-api/pkg/context"
+	"motico-api/pkg/query"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -18,6 +20,10 @@ func TenantMiddleware(next http.Handler) http.Handler {
 		}
 
 		ctx := context.WithValue(r.Context(), ctxpkg.TenantIDKey, tenantID)
+		// La sucursal desde la que opera el usuario es opcional
+		if storeID := r.Header.Get("X-Store-ID"); storeID != "" {
+			ctx = context.WithValue(ctx, ctxpkg.StoreIDKey, storeID)
+		}
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -45,11 +51,49 @@ func AuthMiddleware(authService *authdomain.Service) func(http.Handler) http.Han
 			}
 
 			ctx := context.WithValue(r.Context(), ctxpkg.UserIDKey, claims.UserID)
+			ctx = context.WithValue(ctx, ctxpkg.RoleKey, string(claims.Role))
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// RequireRole deja pasar solo a los usuarios con al menos el rol min. Va después
+// de AuthMiddleware, que guarda el rol en el contexto.
+func RequireRole(min authdomain.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !authdomain.Role(ctxpkg.GetRole(r.Context())).IsAtLeast(min) {
+				response.Error(w, http.StatusForbidden, "this action requires the "+string(min)+" role", nil)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// IncludeDeletedMiddleware valida include_deleted en cualquier ruta: solo los
+// administradores ven las filas borradas lógicamente.
+func IncludeDeletedMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw := r.URL.Query().Get(query.IncludeDeletedParam)
+		if raw == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		includeDeleted, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.HandleError(w, r, &query.ValidationError{Param: query.IncludeDeletedParam, Message: "must be a boolean"}, "failed to validate request")
+			return
+		}
+		if includeDeleted && !authdomain.Role(ctxpkg.GetRole(r.Context())).IsAtLeast(authdomain.RoleAdmin) {
+			response.Error(w, http.StatusForbidden, "include_deleted requires the admin role", nil)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func LoggerMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// TODO: Implementar logging con el logger configurado
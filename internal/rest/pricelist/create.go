@@ -0,0 +1,63 @@
+package pricelist
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/pricelist"
+	restentities "motico-api/internal/rest/pricelist/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/money"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create price list
+// @Description  Create a price list for a store, valid from valid_from until valid_to (exclusive, open-ended when omitted). Prices are decimal strings in the list currency
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                               true  "Tenant ID"
+// @Param        request      body      restentities.CreatePriceListRequest  true  "Price list data"
+// @Success      201          {object}  restentities.PriceListResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /price-lists [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreatePriceListRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	priceList, err := h.service.Create(r.Context(), pricelist.CreateRequest{
+		TenantID:  tenantID,
+		StoreID:   req.StoreID,
+		Name:      req.Name,
+		Currency:  (*money.Currency)(req.Currency),
+		ValidFrom: req.ValidFrom,
+		ValidTo:   req.ValidTo,
+		Items:     toItemRequests(req.Items),
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create price list")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toPriceListResponse(priceList))
+}
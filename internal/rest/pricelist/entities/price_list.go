@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceListItemRequest struct {
+	CatalogItemID uuid.UUID    `json:"catalog_item_id" validate:"required"`
+	Price         money.Amount `json:"price"`
+}
+
+type CreatePriceListRequest struct {
+	StoreID   uuid.UUID              `json:"store_id" validate:"required"`
+	Name      string                 `json:"name" validate:"required,max=255"`
+	Currency  *string                `json:"currency,omitempty" validate:"omitempty,len=3,alpha"`
+	ValidFrom time.Time              `json:"valid_from" validate:"required"`
+	ValidTo   *time.Time             `json:"valid_to,omitempty"`
+	Items     []PriceListItemRequest `json:"items" validate:"dive"`
+}
+
+type UpdatePriceListRequest struct {
+	Name      string                 `json:"name" validate:"required,max=255"`
+	ValidFrom time.Time              `json:"valid_from" validate:"required"`
+	ValidTo   *time.Time             `json:"valid_to,omitempty"`
+	Items     []PriceListItemRequest `json:"items" validate:"dive"`
+}
+
+type PriceListItemResponse struct {
+	ID            uuid.UUID    `json:"id"`
+	CatalogItemID uuid.UUID    `json:"catalog_item_id"`
+	Price         money.Amount `json:"price"`
+}
+
+type PriceListResponse struct {
+	ID        uuid.UUID               `json:"id"`
+	TenantID  uuid.UUID               `json:"tenant_id"`
+	StoreID   uuid.UUID               `json:"store_id"`
+	Name      string                  `json:"name"`
+	Currency  string                  `json:"currency"`
+	ValidFrom time.Time               `json:"valid_from"`
+	ValidTo   *time.Time              `json:"valid_to,omitempty"`
+	Active    bool                    `json:"active"`
+	Items     []PriceListItemResponse `json:"items"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+type ListPriceListsResponse struct {
+	Data       []PriceListResponse `json:"data"`
+	Pagination PaginationInfo      `json:"pagination"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
@@ -0,0 +1,48 @@
+package pricelist
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// GetByID
+// @Summary      Get price list by ID
+// @Description  Get a price list with its items
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Price list ID"
+// @Success      200          {object}  restentities.PriceListResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Price list not found"
+// @Security     BearerAuth
+// @Router       /price-lists/{id} [get]
+func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid price list ID", nil)
+		return
+	}
+
+	priceList, err := h.service.GetByID(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get price list")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toPriceListResponse(priceList))
+}
@@ -0,0 +1,57 @@
+package pricelist
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/pricelist"
+	"motico-api/internal/domain/pricelist/entities"
+	restentities "motico-api/internal/rest/pricelist/entities"
+	"time"
+)
+
+type Handler struct {
+	service *pricelist.Service
+	config  *config.Config
+}
+
+func NewHandler(service *pricelist.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toPriceListResponse(l *entities.PriceList) restentities.PriceListResponse {
+	items := make([]restentities.PriceListItemResponse, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = restentities.PriceListItemResponse{
+			ID:            item.ID,
+			CatalogItemID: item.CatalogItemID,
+			Price:         item.Price,
+		}
+	}
+
+	return restentities.PriceListResponse{
+		ID:        l.ID,
+		TenantID:  l.TenantID,
+		StoreID:   l.StoreID,
+		Name:      l.Name,
+		Currency:  string(l.Currency),
+		ValidFrom: l.ValidFrom,
+		ValidTo:   l.ValidTo,
+		Active:    l.IsActiveAt(time.Now()),
+		Items:     items,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func toItemRequests(items []restentities.PriceListItemRequest) []pricelist.ItemRequest {
+	requests := make([]pricelist.ItemRequest, len(items))
+	for i, item := range items {
+		requests[i] = pricelist.ItemRequest{
+			CatalogItemID: item.CatalogItemID,
+			Price:         item.Price,
+		}
+	}
+	return requests
+}
@@ -0,0 +1,80 @@
+package pricelist
+
+import (
+	restentities "motico-api/internal/rest/pricelist/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// List
+// @Summary      List price lists
+// @Description  Get paginated list of store price lists, most recent first
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        store_id     query     string  false "Filter by store ID"
+// @Success      200          {object}  restentities.ListPriceListsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /price-lists [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var storeID *uuid.UUID
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		id, err := uuid.Parse(storeIDStr)
+		if err == nil {
+			storeID = &id
+		}
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	priceLists, err := h.service.List(r.Context(), tenantID, storeID, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list price lists")
+		return
+	}
+
+	responses := make([]restentities.PriceListResponse, len(priceLists))
+	for i, l := range priceLists {
+		responses[i] = toPriceListResponse(l)
+	}
+
+	total := len(priceLists)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListPriceListsResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package pricelist
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Remove
+// @Summary      Delete price list
+// @Description  Delete a price list; its products fall back to their listing or catalog price
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Price list ID"
+// @Success      204          "No Content"
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Price list not found"
+// @Security     BearerAuth
+// @Router       /price-lists/{id} [delete]
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid price list ID", nil)
+		return
+	}
+
+	err = h.service.Delete(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to delete price list")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
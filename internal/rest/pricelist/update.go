@@ -0,0 +1,71 @@
+package pricelist
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/pricelist"
+	restentities "motico-api/internal/rest/pricelist/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Update
+// @Summary      Update price list
+// @Description  Replace the name, validity period and items of a price list
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                               true  "Tenant ID"
+// @Param        id           path      string                               true  "Price list ID"
+// @Param        request      body      restentities.UpdatePriceListRequest  true  "Price list data"
+// @Success      200          {object}  restentities.PriceListResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Price list not found"
+// @Security     BearerAuth
+// @Router       /price-lists/{id} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid price list ID", nil)
+		return
+	}
+
+	var req restentities.UpdatePriceListRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	priceList, err := h.service.Update(r.Context(), pricelist.UpdateRequest{
+		ID:        id,
+		TenantID:  tenantID,
+		Name:      req.Name,
+		ValidFrom: req.ValidFrom,
+		ValidTo:   req.ValidTo,
+		Items:     toItemRequests(req.Items),
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to update price list")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toPriceListResponse(priceList))
+}
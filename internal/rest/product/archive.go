@@ -0,0 +1,91 @@
+package product
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Archive
+// @Summary      Archive product
+// @Description  Retire a discontinued product together with its variants. Archived products are hidden from default listings and accept no transfers or stock adjustments. The product and its variants must have no stock and no open transfers
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200          {object}  restentities.ProductResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the manager role"
+// @Failure      404          {object}  map[string]interface{}  "Product not found"
+// @Failure      409          {object}  map[string]interface{}  "Product already archived, has stock or has open transfers"
+// @Security     BearerAuth
+// @Router       /products/{id}/archive [post]
+func (h *Handler) Archive(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	product, err := h.service.Archive(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to archive product")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, h.withStock(r.Context(), tenantID, product))
+}
+
+// Unarchive
+// @Summary      Unarchive product
+// @Description  Reactivate an archived product together with the variants archived with it. Its store and, for a variant, its parent product must be active
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200          {object}  restentities.ProductResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the manager role"
+// @Failure      404          {object}  map[string]interface{}  "Product not found"
+// @Failure      409          {object}  map[string]interface{}  "Product is not archived"
+// @Failure      422          {object}  map[string]interface{}  "Store or parent product is archived"
+// @Security     BearerAuth
+// @Router       /products/{id}/unarchive [post]
+func (h *Handler) Unarchive(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	product, err := h.service.Unarchive(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to unarchive product")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, h.withStock(r.Context(), tenantID, product))
+}
@@ -3,7 +3,6 @@ package product
 import (
 	"encoding/json"
 	"motico-api/internal/domain/product"
-	"motico-api/internal/domain/product/entities"
 	restentities "motico-api/internal/rest/product/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
@@ -15,7 +14,7 @@ import (
 
 // Create
 // @Summary      Create product
-// @Description  Create a new product for the tenant
+// @Description  List a product in a store. The listing is linked to the catalog item given by catalog_item_id, to the catalog item with the same SKU, or to a new catalog item
 // @Tags         products
 // @Accept       json
 // @Produce      json
@@ -24,7 +23,9 @@ import (
 // @Success      201         {object}  restentities.ProductResponse
 // @Failure      400         {object}  map[string]interface{}  "Invalid request"
 // @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Catalog item not found"
 // @Failure      409         {object}  map[string]interface{}  "Product SKU already exists"
+// @Failure      422         {object}  map[string]interface{}  "Store not found or archived, or attributes do not match the category schema"
 // @Security     BearerAuth
 // @Router       /products [post]
 func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
@@ -42,40 +43,28 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
 	createReq := product.CreateRequest{
-		TenantID:    tenantID,
-		StoreID:     req.StoreID,
-		CategoryID:  req.CategoryID,
-		Name:        req.Name,
-		Description: req.Description,
-		SKU:         req.SKU,
-		Price:       req.Price,
+		TenantID:      tenantID,
+		StoreID:       req.StoreID,
+		CatalogItemID: req.CatalogItemID,
+		CategoryID:    req.CategoryID,
+		Name:          req.Name,
+		Description:   req.Description,
+		SKU:           req.SKU,
+		Price:         req.Price,
+		Active:        req.Active,
+		Attributes:    req.Attributes,
 	}
 
 	product, err := h.service.Create(r.Context(), createReq)
 	if err != nil {
-		if err == entities.ErrProductSKUExists {
-			response.Error(w, http.StatusConflict, "product SKU already exists for this store", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to create product", nil)
+		response.HandleError(w, r, err, "failed to create product")
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, restentities.ProductResponse{
-		ID:          product.ID,
-		TenantID:    product.TenantID,
-		StoreID:     product.StoreID,
-		CategoryID:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		SKU:         product.SKU,
-		Price:       product.Price,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
-	})
+	response.JSON(w, http.StatusCreated, toProductResponse(product))
 }
@@ -0,0 +1,73 @@
+package product
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/product"
+	restentities "motico-api/internal/rest/product/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// CreateVariant
+// @Summary      Create product variant
+// @Description  Create a single variant for a combination of option values. SKU and price default to values derived from the parent
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                             true  "Tenant ID"
+// @Param        id           path      string                             true  "Product ID"
+// @Param        request      body      restentities.CreateVariantRequest  true  "Variant data"
+// @Success      201         {object}  restentities.ProductResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Product not found"
+// @Failure      409         {object}  map[string]interface{}  "Variant or SKU already exists, or product is archived"
+// @Security     BearerAuth
+// @Router       /products/{id}/variants [post]
+func (h *Handler) CreateVariant(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	var req restentities.CreateVariantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	createReq := product.CreateVariantRequest{
+		TenantID:     tenantID,
+		ParentID:     id,
+		OptionValues: req.OptionValues,
+		SKU:          req.SKU,
+		Price:        req.Price,
+	}
+
+	variant, err := h.service.CreateVariant(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create product variant")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toProductResponse(variant))
+}
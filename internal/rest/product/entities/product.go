@@ -1,56 +1,86 @@
 package entities
 
 import (
+	"motico-api/pkg/money"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type CreateProductRequest struct {
-	StoreID     uuid.UUID `json:"store_id" validate:"required"`
-	CategoryID  uuid.UUID `json:"category_id" validate:"required"`
-	Name        string    `json:"name" validate:"required,max=255"`
-	Description *string   `json:"description,omitempty" validate:"omitempty,max=1000"`
-	SKU         *string   `json:"sku,omitempty" validate:"omitempty,max=100"`
-	Price       *float64  `json:"price,omitempty" validate:"omitempty,gte=0"`
+	StoreID       uuid.UUID              `json:"store_id" validate:"required"`
+	CatalogItemID *uuid.UUID             `json:"catalog_item_id,omitempty"`
+	CategoryID    uuid.UUID              `json:"category_id" validate:"required_without=CatalogItemID"`
+	Name          string                 `json:"name" validate:"required_without=CatalogItemID,max=255"`
+	Description   *string                `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SKU           *string                `json:"sku,omitempty" validate:"omitempty,max=100"`
+	Price         *money.Amount          `json:"price,omitempty"`
+	Active        *bool                  `json:"active,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
 }
 
 type UpdateProductRequest struct {
-	StoreID     uuid.UUID `json:"store_id" validate:"required"`
-	CategoryID  uuid.UUID `json:"category_id" validate:"required"`
-	Name        string    `json:"name" validate:"required,max=255"`
-	Description *string   `json:"description,omitempty" validate:"omitempty,max=1000"`
-	SKU         *string   `json:"sku,omitempty" validate:"omitempty,max=100"`
-	Price       *float64  `json:"price,omitempty" validate:"omitempty,gte=0"`
+	StoreID     uuid.UUID              `json:"store_id" validate:"required"`
+	CategoryID  uuid.UUID              `json:"category_id" validate:"required"`
+	Name        string                 `json:"name" validate:"required,max=255"`
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SKU         *string                `json:"sku,omitempty" validate:"omitempty,max=100"`
+	Price       *money.Amount          `json:"price,omitempty"`
+	Active      *bool                  `json:"active,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 }
 
 type PartialUpdateProductRequest struct {
-	StoreID     *uuid.UUID `json:"store_id,omitempty"`
-	CategoryID  *uuid.UUID `json:"category_id,omitempty"`
-	Name        *string    `json:"name,omitempty" validate:"omitempty,max=255"`
-	Description *string    `json:"description,omitempty" validate:"omitempty,max=1000"`
-	SKU         *string    `json:"sku,omitempty" validate:"omitempty,max=100"`
-	Price       *float64   `json:"price,omitempty" validate:"omitempty,gte=0"`
+	StoreID     *uuid.UUID             `json:"store_id,omitempty"`
+	CategoryID  *uuid.UUID             `json:"category_id,omitempty"`
+	Name        *string                `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SKU         *string                `json:"sku,omitempty" validate:"omitempty,max=100"`
+	Price       *money.Amount          `json:"price,omitempty"`
+	Active      *bool                  `json:"active,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 }
 
 type StockInfo struct {
-	Quantity          int `json:"quantity"`
-	ReservedQuantity  int `json:"reserved_quantity"`
-	AvailableQuantity int `json:"available_quantity"`
+	Quantity            int `json:"quantity"`
+	ReservedQuantity    int `json:"reserved_quantity"`
+	QuarantinedQuantity int `json:"quarantined_quantity"`
+	AvailableQuantity   int `json:"available_quantity"`
 }
 
 type ProductResponse struct {
-	ID          uuid.UUID  `json:"id"`
-	TenantID    uuid.UUID  `json:"tenant_id"`
-	StoreID     uuid.UUID  `json:"store_id"`
-	CategoryID  uuid.UUID  `json:"category_id"`
-	Name        string     `json:"name"`
-	Description *string    `json:"description,omitempty"`
-	SKU         *string    `json:"sku,omitempty"`
-	Price       *float64   `json:"price,omitempty"`
-	Stock       *StockInfo `json:"stock,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID            uuid.UUID              `json:"id"`
+	TenantID      uuid.UUID              `json:"tenant_id"`
+	StoreID       uuid.UUID              `json:"store_id"`
+	CategoryID    uuid.UUID              `json:"category_id"`
+	CatalogItemID uuid.UUID              `json:"catalog_item_id"`
+	ParentID      *uuid.UUID             `json:"parent_id,omitempty"`
+	Name          string                 `json:"name"`
+	Description   *string                `json:"description,omitempty"`
+	SKU           *string                `json:"sku,omitempty"`
+	Price         *money.Amount          `json:"price,omitempty"`
+	PriceOverride *money.Amount          `json:"price_override,omitempty"`
+	ListPrice     *money.Amount          `json:"list_price,omitempty"`
+	Currency      string                 `json:"currency"`
+	Tracking      string                 `json:"tracking"`
+	Active        bool                   `json:"active"`
+	Status        string                 `json:"status"`
+	ArchivedAt    *time.Time             `json:"archived_at,omitempty"`
+	OptionValues  map[string]string      `json:"option_values,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes"`
+	VariantCount  int                    `json:"variant_count"`
+	Images        []ImageResponse        `json:"images"`
+	Stock         *StockInfo             `json:"stock,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+	DeletedAt     *time.Time             `json:"deleted_at,omitempty"`
+}
+
+// ImageResponse es una imagen del producto; la primera de la lista es la principal.
+type ImageResponse struct {
+	ID           uuid.UUID `json:"id"`
+	URL          string    `json:"url"`
+	ThumbnailURL *string   `json:"thumbnail_url,omitempty"`
 }
 
 type ListProductsResponse struct {
@@ -58,9 +88,49 @@ type ListProductsResponse struct {
 	Pagination PaginationInfo    `json:"pagination"`
 }
 
+type SearchProductResponse struct {
+	ProductResponse
+	Rank float64 `json:"rank"`
+}
+
+type SearchProductsResponse struct {
+	Data       []SearchProductResponse `json:"data"`
+	Pagination PaginationInfo          `json:"pagination"`
+}
+
 type PaginationInfo struct {
 	Page       int `json:"page"`
 	Limit      int `json:"limit"`
 	Total      int `json:"total"`
 	TotalPages int `json:"total_pages"`
 }
+
+type ProductOptionRequest struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Values []string `json:"values" validate:"required,min=1,dive,required,max=100"`
+}
+
+type ReplaceProductOptionsRequest struct {
+	Options []ProductOptionRequest `json:"options" validate:"dive"`
+}
+
+type ProductOptionResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Position int       `json:"position"`
+	Values   []string  `json:"values"`
+}
+
+type ListProductOptionsResponse struct {
+	Data []ProductOptionResponse `json:"data"`
+}
+
+type CreateVariantRequest struct {
+	OptionValues map[string]string `json:"option_values" validate:"required,min=1"`
+	SKU          *string           `json:"sku,omitempty" validate:"omitempty,max=100"`
+	Price        *money.Amount     `json:"price,omitempty"`
+}
+
+type ListVariantsResponse struct {
+	Data []ProductResponse `json:"data"`
+}
@@ -0,0 +1,55 @@
+package product
+
+import (
+	restentities "motico-api/internal/rest/product/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// GenerateVariants
+// @Summary      Generate variant matrix
+// @Description  Create a variant for every combination of option values that does not exist yet, all or nothing. Options can form at most 100 combinations. Returns only the created variants
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      201         {object}  restentities.ListVariantsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request, or options exceed 100 combinations"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Product not found"
+// @Failure      409         {object}  map[string]interface{}  "Generated SKU already exists, or product is archived"
+// @Security     BearerAuth
+// @Router       /products/{id}/variants/generate [post]
+func (h *Handler) GenerateVariants(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	variants, err := h.service.GenerateVariants(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to generate product variants")
+		return
+	}
+
+	responses := make([]restentities.ProductResponse, len(variants))
+	for i, variant := range variants {
+		responses[i] = toProductResponse(variant)
+	}
+
+	response.JSON(w, http.StatusCreated, restentities.ListVariantsResponse{Data: responses})
+}
@@ -1,8 +1,6 @@
 package product
 
 import (
-	"motico-api/internal/domain/product/entities"
-	restentities "motico-api/internal/rest/product/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
 
@@ -42,34 +40,9 @@ func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
 
 	product, err := h.service.GetByID(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrProductNotFound {
-			response.Error(w, http.StatusNotFound, "product not found", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to get product", nil)
+		response.HandleError(w, r, err, "failed to get product")
 		return
 	}
 
-	stockInfo, _ := h.stockService.GetByProductID(r.Context(), tenantID, id)
-	productResponse := restentities.ProductResponse{
-		ID:          product.ID,
-		TenantID:    product.TenantID,
-		StoreID:     product.StoreID,
-		CategoryID:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		SKU:         product.SKU,
-		Price:       product.Price,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
-	}
-	if stockInfo != nil {
-		productResponse.Stock = &restentities.StockInfo{
-			Quantity:          stockInfo.Quantity,
-			ReservedQuantity:  stockInfo.ReservedQuantity,
-			AvailableQuantity: stockInfo.AvailableQuantity(),
-		}
-	}
-
-	response.JSON(w, http.StatusOK, productResponse)
+	response.JSON(w, http.StatusOK, h.withStock(r.Context(), tenantID, product))
 }
@@ -1,9 +1,15 @@
 package product
 
 import (
+	"context"
 	"motico-api/config"
 	"motico-api/internal/domain/product"
+	"motico-api/internal/domain/product/entities"
 	"motico-api/internal/domain/stock"
+	stockentities "motico-api/internal/domain/stock/entities"
+	restentities "motico-api/internal/rest/product/entities"
+
+	"github.com/google/uuid"
 )
 
 type Handler struct {
@@ -19,3 +25,67 @@ func NewHandler(service *product.Service, stockService *stock.Service, cfg *conf
 		config:       cfg,
 	}
 }
+
+func toProductResponse(p *entities.Product) restentities.ProductResponse {
+	return restentities.ProductResponse{
+		ID:            p.ID,
+		TenantID:      p.TenantID,
+		StoreID:       p.StoreID,
+		CategoryID:    p.CategoryID,
+		CatalogItemID: p.CatalogItemID,
+		ParentID:      p.ParentID,
+		Name:          p.Name,
+		Description:   p.Description,
+		SKU:           p.SKU,
+		Price:         p.EffectivePrice(),
+		PriceOverride: p.Price,
+		ListPrice:     p.ListPrice,
+		Currency:      string(p.Currency),
+		Tracking:      string(p.Tracking),
+		Active:        p.Active,
+		Status:        string(p.Status),
+		ArchivedAt:    p.ArchivedAt,
+		OptionValues:  p.OptionValues,
+		Attributes:    p.Attributes,
+		VariantCount:  p.VariantCount,
+		Images:        toImageResponses(p.Images),
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+		DeletedAt:     p.DeletedAt,
+	}
+}
+
+func toImageResponses(images []entities.Image) []restentities.ImageResponse {
+	responses := make([]restentities.ImageResponse, len(images))
+	for i, image := range images {
+		responses[i] = restentities.ImageResponse{
+			ID:           image.ID,
+			URL:          image.URL,
+			ThumbnailURL: image.ThumbnailURL,
+		}
+	}
+	return responses
+}
+
+// withStock agrega el stock a la respuesta; un producto con variantes muestra la
+// suma del stock de sus variantes.
+func (h *Handler) withStock(ctx context.Context, tenantID uuid.UUID, p *entities.Product) restentities.ProductResponse {
+	response := toProductResponse(p)
+
+	var stockInfo *stockentities.Stock
+	if p.HasVariants() {
+		stockInfo, _ = h.stockService.GetByParentProductID(ctx, tenantID, p.ID)
+	} else {
+		stockInfo, _ = h.stockService.GetByProductID(ctx, tenantID, p.ID)
+	}
+	if stockInfo != nil {
+		response.Stock = &restentities.StockInfo{
+			Quantity:            stockInfo.Quantity,
+			ReservedQuantity:    stockInfo.ReservedQuantity,
+			QuarantinedQuantity: stockInfo.QuarantinedQuantity,
+			AvailableQuantity:   stockInfo.AvailableQuantity(),
+		}
+	}
+
+	return response
+}
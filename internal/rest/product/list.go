@@ -1,6 +1,9 @@
 package product
 
 import (
+	catalogentities "motico-api/internal/domain/catalog/entities"
+	"motico-api/internal/domain/product"
+	"motico-api/internal/domain/product/entities"
 	restentities "motico-api/internal/rest/product/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
@@ -8,11 +11,12 @@ import (
 
 	"github.com/google/uuid"
 	"motico-api/pkg/context"
+	"motico-api/pkg/query"
 )
 
 // List
 // @Summary      List products
-// @Description  Get paginated list of products for the tenant
+// @Description  Get paginated list of products for the tenant. Variants are hidden unless requested and archived products unless requested by status; products with variants report the sum of their variants' stock
 // @Tags         products
 // @Accept       json
 // @Produce      json
@@ -20,10 +24,28 @@ import (
 // @Param        page         query     int     false "Page number" default(1)
 // @Param        limit        query     int     false "Items per page" default(20)
 // @Param        store_id     query     string  false "Filter by store ID"
-// @Param        category_id  query     string  false "Filter by category ID"
+// @Param        category_id  query     string  false "Filter by category ID, including its subcategories"
+// @Param        catalog_item_id  query  string  false "Filter by catalog item ID"
+// @Param        active       query     bool    false "Filter by active flag"
+// @Param        status       query     string  false "Filter by status (active, archived)" default(active)
+// @Param        parent_id    query     string  false "List the variants of this product"
+// @Param        include_variants  query  bool  false "Include variants along with top-level products"
+// @Param        fits         query     string  false "Only parts compatible with the vehicle, as make:model:year (e.g. honda:cb190r:2019)"
+// @Param        in_stock     query     bool    false "Only products with available stock"
+// @Param        sort         query     string  false "Sort fields (name, sku, price, created_at, updated_at), prefix with - for descending"
+// @Param        name_contains   query  string  false "Filter by name substring"
+// @Param        sku_contains    query  string  false "Filter by SKU substring"
+// @Param        price_gte       query  number  false "Minimum effective price"
+// @Param        price_lte       query  number  false "Maximum effective price"
+// @Param        created_after   query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before  query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since   query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        attr.{key}      query  string  false "Filter by attribute value, e.g. attr.thread_size=M10; attr.{key}_gte and attr.{key}_lte compare numeric attributes"
+// @Param        include_deleted query  bool    false "Include soft-deleted products (admins only)"
 // @Success      200          {object}  restentities.ListProductsResponse
-// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID"
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID, invalid fits vehicle or unknown sort/filter field"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "include_deleted requires the admin role"
 // @Security     BearerAuth
 // @Router       /products [get]
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
@@ -34,19 +56,57 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var storeID, categoryID *uuid.UUID
+	var filter product.ListFilter
 	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
 		id, err := uuid.Parse(storeIDStr)
 		if err == nil {
-			storeID = &id
+			filter.StoreID = &id
 		}
 	}
 	if categoryIDStr := r.URL.Query().Get("category_id"); categoryIDStr != "" {
 		id, err := uuid.Parse(categoryIDStr)
 		if err == nil {
-			categoryID = &id
+			filter.CategoryID = &id
 		}
 	}
+	if parentIDStr := r.URL.Query().Get("parent_id"); parentIDStr != "" {
+		id, err := uuid.Parse(parentIDStr)
+		if err == nil {
+			filter.ParentID = &id
+		}
+	}
+	if catalogItemIDStr := r.URL.Query().Get("catalog_item_id"); catalogItemIDStr != "" {
+		id, err := uuid.Parse(catalogItemIDStr)
+		if err == nil {
+			filter.CatalogItemID = &id
+		}
+	}
+	if activeStr := r.URL.Query().Get("active"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err == nil {
+			filter.Active = &active
+		}
+	}
+	status := entities.ProductStatusActive
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.ProductStatus(statusStr)
+		if s.IsValid() {
+			status = s
+		}
+	}
+	filter.Status = &status
+	filter.IncludeVariants = r.URL.Query().Get("include_variants") == "true"
+	if fits := r.URL.Query().Get("fits"); fits != "" {
+		vehicle, err := catalogentities.ParseVehicle(fits)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "fits must be make:model:year", nil)
+			return
+		}
+		filter.Fits = &vehicle
+	}
+	filter.InStock = r.URL.Query().Get("in_stock") == "true"
+
+	filter.Query = query.Parse(r.URL.Query(), "page", "limit", "store_id", "category_id", "parent_id", "catalog_item_id", "active", "status", "include_variants", "fits", "in_stock")
 
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	if page < 1 {
@@ -58,35 +118,15 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	}
 	offset := (page - 1) * limit
 
-	products, err := h.service.List(r.Context(), tenantID, storeID, categoryID, limit, offset)
+	products, err := h.service.List(r.Context(), tenantID, filter, limit, offset)
 	if err != nil {
-		response.Error(w, http.StatusInternalServerError, "failed to list products", nil)
+		response.HandleError(w, r, err, "failed to list products")
 		return
 	}
 
 	responses := make([]restentities.ProductResponse, len(products))
 	for i, p := range products {
-		stockInfo, _ := h.stockService.GetByProductID(r.Context(), tenantID, p.ID)
-		response := restentities.ProductResponse{
-			ID:          p.ID,
-			TenantID:    p.TenantID,
-			StoreID:     p.StoreID,
-			CategoryID:  p.CategoryID,
-			Name:        p.Name,
-			Description: p.Description,
-			SKU:         p.SKU,
-			Price:       p.Price,
-			CreatedAt:   p.CreatedAt,
-			UpdatedAt:   p.UpdatedAt,
-		}
-		if stockInfo != nil {
-			response.Stock = &restentities.StockInfo{
-				Quantity:          stockInfo.Quantity,
-				ReservedQuantity:  stockInfo.ReservedQuantity,
-				AvailableQuantity: stockInfo.AvailableQuantity(),
-			}
-		}
-		responses[i] = response
+		responses[i] = h.withStock(r.Context(), tenantID, p)
 	}
 
 	total := len(products)
@@ -0,0 +1,63 @@
+package product
+
+import (
+	"motico-api/internal/domain/product/entities"
+	restentities "motico-api/internal/rest/product/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListOptions
+// @Summary      List product options
+// @Description  Get the option axes (size, color...) used to build the product variants
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200         {object}  restentities.ListProductOptionsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Product not found"
+// @Security     BearerAuth
+// @Router       /products/{id}/options [get]
+func (h *Handler) ListOptions(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	options, err := h.service.ListOptions(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list product options")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListProductOptionsResponse{Data: toOptionResponses(options)})
+}
+
+func toOptionResponses(options []entities.ProductOption) []restentities.ProductOptionResponse {
+	responses := make([]restentities.ProductOptionResponse, len(options))
+	for i, option := range options {
+		responses[i] = restentities.ProductOptionResponse{
+			ID:       option.ID,
+			Name:     option.Name,
+			Position: option.Position,
+			Values:   option.Values,
+		}
+	}
+	return responses
+}
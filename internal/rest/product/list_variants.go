@@ -0,0 +1,54 @@
+package product
+
+import (
+	restentities "motico-api/internal/rest/product/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListVariants
+// @Summary      List product variants
+// @Description  Get every variant of a product with its own SKU, price and stock
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200         {object}  restentities.ListVariantsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Product not found"
+// @Security     BearerAuth
+// @Router       /products/{id}/variants [get]
+func (h *Handler) ListVariants(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	variants, err := h.service.ListVariants(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list product variants")
+		return
+	}
+
+	responses := make([]restentities.ProductResponse, len(variants))
+	for i, variant := range variants {
+		responses[i] = h.withStock(r.Context(), tenantID, variant)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListVariantsResponse{Data: responses})
+}
@@ -0,0 +1,58 @@
+package product
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Lookup
+// @Summary      Look up product by barcode
+// @Description  Find the product listed in the caller's store for a scanned barcode (EAN-13, UPC-A or Code128), including its stock in that store
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true   "Tenant ID"
+// @Param        X-Store-ID   header    string  false  "Caller's store ID"
+// @Param        barcode      query     string  true   "Scanned barcode"
+// @Param        store_id     query     string  false  "Store ID, when the X-Store-ID header is not sent"
+// @Success      200          {object}  restentities.ProductResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Barcode not found or product not listed in store"
+// @Security     BearerAuth
+// @Router       /products/lookup [get]
+func (h *Handler) Lookup(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	barcode := r.URL.Query().Get("barcode")
+	if barcode == "" {
+		response.Error(w, http.StatusBadRequest, "barcode is required", nil)
+		return
+	}
+
+	storeIDStr := context.GetStoreID(r.Context())
+	if storeIDStr == "" {
+		storeIDStr = r.URL.Query().Get("store_id")
+	}
+	storeID, err := uuid.Parse(storeIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "a valid store ID is required via X-Store-ID header or store_id", nil)
+		return
+	}
+
+	product, err := h.service.LookupByBarcode(r.Context(), tenantID, storeID, barcode)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to look up product")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, h.withStock(r.Context(), tenantID, product))
+}
@@ -1,7 +1,6 @@
 package product
 
 import (
-	"motico-api/internal/domain/product/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
 
@@ -12,7 +11,7 @@ import (
 
 // Remove
 // @Summary      Delete product
-// @Description  Delete a product by ID
+// @Description  Delete a product by ID. A product with stock or transfers cannot be deleted; archive it instead
 // @Tags         products
 // @Accept       json
 // @Produce      json
@@ -42,19 +41,7 @@ func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.Delete(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrProductNotFound {
-			response.Error(w, http.StatusNotFound, "product not found", nil)
-			return
-		}
-		if err == entities.ErrProductHasStock {
-			response.Error(w, http.StatusConflict, "product has stock and cannot be deleted", nil)
-			return
-		}
-		if err == entities.ErrProductHasTransfers {
-			response.Error(w, http.StatusConflict, "product has transfers and cannot be deleted", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to delete product", nil)
+		response.HandleError(w, r, err, "failed to delete product")
 		return
 	}
 
@@ -0,0 +1,70 @@
+package product
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/product"
+	restentities "motico-api/internal/rest/product/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// ReplaceOptions
+// @Summary      Replace product options
+// @Description  Define the option axes of a product and their values, in order. Values used by existing variants cannot be removed, and the axes can form at most 100 combinations
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                   true  "Tenant ID"
+// @Param        id           path      string                                   true  "Product ID"
+// @Param        request      body      restentities.ReplaceProductOptionsRequest  true  "Product options"
+// @Success      200         {object}  restentities.ListProductOptionsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Product not found"
+// @Failure      409         {object}  map[string]interface{}  "Options are in use by existing variants"
+// @Security     BearerAuth
+// @Router       /products/{id}/options [put]
+func (h *Handler) ReplaceOptions(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	var req restentities.ReplaceProductOptionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	optionReqs := make([]product.OptionRequest, len(req.Options))
+	for i, option := range req.Options {
+		optionReqs[i] = product.OptionRequest{Name: option.Name, Values: option.Values}
+	}
+
+	options, err := h.service.ReplaceOptions(r.Context(), tenantID, id, optionReqs)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to update product options")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListProductOptionsResponse{Data: toOptionResponses(options)})
+}
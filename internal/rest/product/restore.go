@@ -0,0 +1,51 @@
+package product
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Restore
+// @Summary      Restore product
+// @Description  Restore a soft-deleted product. Its store, its category and, for a variant, its parent product must not be deleted. Fails if another product took its SKU, its catalog item in the store or its option values in the meantime. Admins only
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200          {object}  restentities.ProductResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the admin role"
+// @Failure      404          {object}  map[string]interface{}  "Deleted product not found"
+// @Failure      409          {object}  map[string]interface{}  "SKU, catalog item or variant options already taken"
+// @Failure      422          {object}  map[string]interface{}  "Store, category or parent product is deleted, or store is archived"
+// @Security     BearerAuth
+// @Router       /products/{id}/restore [post]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	product, err := h.service.Restore(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to restore product")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, h.withStock(r.Context(), tenantID, product))
+}
@@ -0,0 +1,109 @@
+package product
+
+import (
+	"motico-api/internal/domain/product"
+	restentities "motico-api/internal/rest/product/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/money"
+)
+
+// Search
+// @Summary      Search products
+// @Description  Full-text and fuzzy search over product name, description and SKU, ordered by relevance. The last word matches as a prefix so results update while typing
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        q            query     string  true  "Search text"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        store_id     query     string  false "Filter by store ID"
+// @Param        category_id  query     string  false "Filter by category ID, including its subcategories"
+// @Param        min_price    query     number  false "Minimum effective price"
+// @Param        max_price    query     number  false "Maximum effective price"
+// @Param        in_stock     query     bool    false "Only products with available stock"
+// @Success      200          {object}  restentities.SearchProductsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /products/search [get]
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	filter := product.SearchFilter{Query: r.URL.Query().Get("q")}
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		id, err := uuid.Parse(storeIDStr)
+		if err == nil {
+			filter.StoreID = &id
+		}
+	}
+	if categoryIDStr := r.URL.Query().Get("category_id"); categoryIDStr != "" {
+		id, err := uuid.Parse(categoryIDStr)
+		if err == nil {
+			filter.CategoryID = &id
+		}
+	}
+	if minPriceStr := r.URL.Query().Get("min_price"); minPriceStr != "" {
+		minPrice, err := money.Parse(minPriceStr)
+		if err == nil {
+			filter.MinPrice = &minPrice
+		}
+	}
+	if maxPriceStr := r.URL.Query().Get("max_price"); maxPriceStr != "" {
+		maxPrice, err := money.Parse(maxPriceStr)
+		if err == nil {
+			filter.MaxPrice = &maxPrice
+		}
+	}
+	filter.InStock = r.URL.Query().Get("in_stock") == "true"
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	results, err := h.service.Search(r.Context(), tenantID, filter, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to search products")
+		return
+	}
+
+	responses := make([]restentities.SearchProductResponse, len(results))
+	for i, result := range results {
+		responses[i] = restentities.SearchProductResponse{
+			ProductResponse: h.withStock(r.Context(), tenantID, result.Product),
+			Rank:            result.Rank,
+		}
+	}
+
+	total := len(results)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.SearchProductsResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
@@ -3,7 +3,6 @@ package product
 import (
 	"encoding/json"
 	"motico-api/internal/domain/product"
-	"motico-api/internal/domain/product/entities"
 	restentities "motico-api/internal/rest/product/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
@@ -28,6 +27,7 @@ import (
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
 // @Failure      404          {object}  map[string]interface{}  "Product not found"
 // @Failure      409          {object}  map[string]interface{}  "Product SKU already exists"
+// @Failure      422          {object}  map[string]interface{}  "Store not found or archived, or attributes do not match the category schema"
 // @Security     BearerAuth
 // @Router       /products/{id} [put]
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
@@ -52,7 +52,7 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
@@ -65,32 +65,15 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		Description: req.Description,
 		SKU:         req.SKU,
 		Price:       req.Price,
+		Active:      req.Active,
+		Attributes:  req.Attributes,
 	}
 
 	product, err := h.service.Update(r.Context(), updateReq)
 	if err != nil {
-		if err == entities.ErrProductNotFound {
-			response.Error(w, http.StatusNotFound, "product not found", nil)
-			return
-		}
-		if err == entities.ErrProductSKUExists {
-			response.Error(w, http.StatusConflict, "product SKU already exists for this store", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to update product", nil)
+		response.HandleError(w, r, err, "failed to update product")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.ProductResponse{
-		ID:          product.ID,
-		TenantID:    product.TenantID,
-		StoreID:     product.StoreID,
-		CategoryID:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		SKU:         product.SKU,
-		Price:       product.Price,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toProductResponse(product))
 }
@@ -0,0 +1,56 @@
+package purchaseorder
+
+import (
+	"motico-api/internal/domain/purchaseorder"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Close
+// @Summary      Close purchase order
+// @Description  Close a received or partially received purchase order; outstanding quantities are no longer expected
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Purchase order ID"
+// @Success      200          {object}  restentities.PurchaseOrderResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Purchase order not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id}/close [patch]
+func (h *Handler) Close(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid purchase order ID", nil)
+		return
+	}
+
+	actionReq := purchaseorder.ActionRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+	}
+
+	order, err := h.service.Close(r.Context(), actionReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to close purchase order")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toPurchaseOrderResponse(order))
+}
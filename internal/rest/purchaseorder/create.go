@@ -0,0 +1,65 @@
+package purchaseorder
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/purchaseorder"
+	restentities "motico-api/internal/rest/purchaseorder/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create purchase order
+// @Description  Create a draft purchase order for a supplier, received into one store. Every product must be listed in that store
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                   true  "Tenant ID"
+// @Param        request      body      restentities.CreatePurchaseOrderRequest  true  "Purchase order data"
+// @Success      201          {object}  restentities.PurchaseOrderResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      422          {object}  map[string]interface{}  "Unknown supplier or store, or product not listed in the store"
+// @Security     BearerAuth
+// @Router       /purchase-orders [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreatePurchaseOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	createReq := purchaseorder.CreateRequest{
+		TenantID:   tenantID,
+		SupplierID: req.SupplierID,
+		StoreID:    req.StoreID,
+		Lines:      toLineRequests(req.Lines),
+		Notes:      req.Notes,
+		ExpectedAt: req.ExpectedAt,
+		CreatedBy:  context.GetUserID(r.Context()),
+	}
+
+	order, err := h.service.Create(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create purchase order")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toPurchaseOrderResponse(order))
+}
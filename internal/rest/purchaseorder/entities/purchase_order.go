@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PurchaseOrderLineRequest struct {
+	ProductID uuid.UUID    `json:"product_id" validate:"required"`
+	Quantity  int          `json:"quantity" validate:"required,gt=0"`
+	UnitCost  money.Amount `json:"unit_cost"`
+}
+
+type CreatePurchaseOrderRequest struct {
+	SupplierID uuid.UUID                  `json:"supplier_id" validate:"required"`
+	StoreID    uuid.UUID                  `json:"store_id" validate:"required"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+	Notes      *string                    `json:"notes,omitempty"`
+	ExpectedAt *time.Time                 `json:"expected_at,omitempty"`
+}
+
+type UpdatePurchaseOrderRequest struct {
+	SupplierID uuid.UUID                  `json:"supplier_id" validate:"required"`
+	StoreID    uuid.UUID                  `json:"store_id" validate:"required"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+	Notes      *string                    `json:"notes,omitempty"`
+	ExpectedAt *time.Time                 `json:"expected_at,omitempty"`
+}
+
+// ReceiptLineRequest registra unidades recibidas de un producto. unit_cost es
+// opcional y reemplaza el costo pactado cuando la factura del proveedor difiere.
+// lot_number es obligatorio para productos con seguimiento por lote y serials,
+// una por unidad, para los de seguimiento por serie.
+type ReceiptLineRequest struct {
+	ProductID uuid.UUID     `json:"product_id" validate:"required"`
+	Quantity  int           `json:"quantity" validate:"required,gt=0"`
+	UnitCost  *money.Amount `json:"unit_cost,omitempty"`
+	LotNumber *string       `json:"lot_number,omitempty" validate:"omitempty,max=100"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Serials   []string      `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
+}
+
+type ReceivePurchaseOrderRequest struct {
+	Lines []ReceiptLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+type PurchaseOrderLineResponse struct {
+	ID                  uuid.UUID    `json:"id"`
+	ProductID           uuid.UUID    `json:"product_id"`
+	Quantity            int          `json:"quantity"`
+	ReceivedQuantity    int          `json:"received_quantity"`
+	OutstandingQuantity int          `json:"outstanding_quantity"`
+	UnitCost            money.Amount `json:"unit_cost"`
+	Total               money.Amount `json:"total"`
+}
+
+type PurchaseOrderResponse struct {
+	ID          uuid.UUID                   `json:"id"`
+	TenantID    uuid.UUID                   `json:"tenant_id"`
+	SupplierID  uuid.UUID                   `json:"supplier_id"`
+	StoreID     uuid.UUID                   `json:"store_id"`
+	Status      string                      `json:"status"`
+	Currency    string                      `json:"currency"`
+	Total       money.Amount                `json:"total"`
+	Notes       *string                     `json:"notes,omitempty"`
+	ExpectedAt  *time.Time                  `json:"expected_at,omitempty"`
+	Lines       []PurchaseOrderLineResponse `json:"lines"`
+	CreatedBy   *string                     `json:"created_by,omitempty"`
+	SentAt      *time.Time                  `json:"sent_at,omitempty"`
+	SentBy      *string                     `json:"sent_by,omitempty"`
+	ReceivedAt  *time.Time                  `json:"received_at,omitempty"`
+	ReceivedBy  *string                     `json:"received_by,omitempty"`
+	ClosedAt    *time.Time                  `json:"closed_at,omitempty"`
+	ClosedBy    *string                     `json:"closed_by,omitempty"`
+	CancelledAt *time.Time                  `json:"cancelled_at,omitempty"`
+	CancelledBy *string                     `json:"cancelled_by,omitempty"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	UpdatedAt   time.Time                   `json:"updated_at"`
+}
+
+type ListPurchaseOrdersResponse struct {
+	Data       []PurchaseOrderResponse `json:"data"`
+	Pagination PaginationInfo          `json:"pagination"`
+}
+
+// ReceiptResponse es un movimiento de stock generado al recibir la orden.
+type ReceiptResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	ProductID uuid.UUID     `json:"product_id"`
+	Quantity  int           `json:"quantity"`
+	UnitCost  money.Amount  `json:"unit_cost"`
+	TotalCost money.Amount  `json:"total_cost"`
+	Lots      []LotResponse `json:"lots,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type LotResponse struct {
+	LotNumber string     `json:"lot_number"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Quantity  int        `json:"quantity"`
+}
+
+type ListReceiptsResponse struct {
+	Data []ReceiptResponse `json:"data"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
@@ -0,0 +1,68 @@
+package purchaseorder
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/purchaseorder"
+	"motico-api/internal/domain/purchaseorder/entities"
+	restentities "motico-api/internal/rest/purchaseorder/entities"
+)
+
+type Handler struct {
+	service *purchaseorder.Service
+	config  *config.Config
+}
+
+func NewHandler(service *purchaseorder.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toPurchaseOrderResponse(o *entities.PurchaseOrder) restentities.PurchaseOrderResponse {
+	lines := make([]restentities.PurchaseOrderLineResponse, len(o.Lines))
+	for i := range o.Lines {
+		line := &o.Lines[i]
+		lines[i] = restentities.PurchaseOrderLineResponse{
+			ID:                  line.ID,
+			ProductID:           line.ProductID,
+			Quantity:            line.Quantity,
+			ReceivedQuantity:    line.ReceivedQuantity,
+			OutstandingQuantity: line.OutstandingQuantity(),
+			UnitCost:            line.UnitCost,
+			Total:               line.Total(),
+		}
+	}
+
+	return restentities.PurchaseOrderResponse{
+		ID:          o.ID,
+		TenantID:    o.TenantID,
+		SupplierID:  o.SupplierID,
+		StoreID:     o.StoreID,
+		Status:      string(o.Status),
+		Currency:    string(o.Currency),
+		Total:       o.Total(),
+		Notes:       o.Notes,
+		ExpectedAt:  o.ExpectedAt,
+		Lines:       lines,
+		CreatedBy:   o.CreatedBy,
+		SentAt:      o.SentAt,
+		SentBy:      o.SentBy,
+		ReceivedAt:  o.ReceivedAt,
+		ReceivedBy:  o.ReceivedBy,
+		ClosedAt:    o.ClosedAt,
+		ClosedBy:    o.ClosedBy,
+		CancelledAt: o.CancelledAt,
+		CancelledBy: o.CancelledBy,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
+
+func toLineRequests(lines []restentities.PurchaseOrderLineRequest) []purchaseorder.LineRequest {
+	result := make([]purchaseorder.LineRequest, len(lines))
+	for i, line := range lines {
+		result[i] = purchaseorder.LineRequest{ProductID: line.ProductID, Quantity: line.Quantity, UnitCost: line.UnitCost}
+	}
+	return result
+}
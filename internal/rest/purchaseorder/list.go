@@ -0,0 +1,106 @@
+package purchaseorder
+
+import (
+	"motico-api/internal/domain/purchaseorder"
+	"motico-api/internal/domain/purchaseorder/entities"
+	restentities "motico-api/internal/rest/purchaseorder/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/query"
+)
+
+// List
+// @Summary      List purchase orders
+// @Description  Get paginated list of purchase orders for the tenant
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        status       query     string  false "Filter by status (draft, sent, partially_received, received, closed, cancelled)"
+// @Param        supplier_id  query     string  false "Filter by supplier ID"
+// @Param        store_id     query     string  false "Filter by store ID"
+// @Param        sort         query     string  false "Sort fields (status, expected_at, created_at, updated_at, received_at), prefix with - for descending"
+// @Param        created_after    query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before   query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since    query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        expected_before  query  string  false "Expected before (RFC 3339 or YYYY-MM-DD)"
+// @Success      200          {object}  restentities.ListPurchaseOrdersResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /purchase-orders [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var filter purchaseorder.ListFilter
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.PurchaseOrderStatus(statusStr)
+		if s.IsValid() {
+			filter.Status = &s
+		}
+	}
+
+	if supplierIDStr := r.URL.Query().Get("supplier_id"); supplierIDStr != "" {
+		id, err := uuid.Parse(supplierIDStr)
+		if err == nil {
+			filter.SupplierID = &id
+		}
+	}
+
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		id, err := uuid.Parse(storeIDStr)
+		if err == nil {
+			filter.StoreID = &id
+		}
+	}
+
+	filter.Query = query.Parse(r.URL.Query(), "page", "limit", "status", "supplier_id", "store_id")
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	orders, err := h.service.List(r.Context(), tenantID, filter, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list purchase orders")
+		return
+	}
+
+	responses := make([]restentities.PurchaseOrderResponse, len(orders))
+	for i, o := range orders {
+		responses[i] = toPurchaseOrderResponse(o)
+	}
+
+	total := len(orders)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListPurchaseOrdersResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package purchaseorder
+
+import (
+	restentities "motico-api/internal/rest/purchaseorder/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListReceipts
+// @Summary      List purchase order receipts
+// @Description  Get the stock movements recorded by the receipts of a purchase order, oldest first
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Purchase order ID"
+// @Success      200          {object}  restentities.ListReceiptsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Purchase order not found"
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id}/receipts [get]
+func (h *Handler) ListReceipts(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid purchase order ID", nil)
+		return
+	}
+
+	movements, err := h.service.ListReceipts(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list purchase order receipts")
+		return
+	}
+
+	receipts := make([]restentities.ReceiptResponse, len(movements))
+	for i, m := range movements {
+		var lots []restentities.LotResponse
+		for _, lot := range m.Lots {
+			lots = append(lots, restentities.LotResponse{LotNumber: lot.LotNumber, ExpiresAt: lot.ExpiresAt, Quantity: lot.Quantity})
+		}
+		receipts[i] = restentities.ReceiptResponse{
+			ID:        m.ID,
+			ProductID: m.ProductID,
+			Quantity:  m.Quantity,
+			UnitCost:  m.UnitCost,
+			TotalCost: m.TotalCost,
+			Lots:      lots,
+			CreatedAt: m.CreatedAt,
+		}
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListReceiptsResponse{Data: receipts})
+}
@@ -0,0 +1,81 @@
+package purchaseorder
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/purchaseorder"
+	stockentities "motico-api/internal/domain/stock/entities"
+	restentities "motico-api/internal/rest/purchaseorder/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Receive
+// @Summary      Receive purchase order
+// @Description  Record goods received against a sent purchase order. Stock is added to the order's store at the line cost (or the unit_cost given) and the order becomes partially_received or received. Serial-tracked products list one serial per unit received
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                    true  "Tenant ID"
+// @Param        id           path      string                                    true  "Purchase order ID"
+// @Param        request      body      restentities.ReceivePurchaseOrderRequest  true  "Received quantities"
+// @Success      200          {object}  restentities.PurchaseOrderResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request or quantity above the outstanding amount"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Purchase order not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status, serial already in stock, or product or store archived"
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id}/receive [patch]
+func (h *Handler) Receive(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid purchase order ID", nil)
+		return
+	}
+
+	var req restentities.ReceivePurchaseOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	lines := make([]purchaseorder.ReceiptLineRequest, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = purchaseorder.ReceiptLineRequest{ProductID: line.ProductID, Quantity: line.Quantity, UnitCost: line.UnitCost, Serials: line.Serials}
+		if line.LotNumber != nil {
+			lines[i].Lot = &stockentities.LotSpec{Number: *line.LotNumber, ExpiresAt: line.ExpiresAt}
+		}
+	}
+
+	receiveReq := purchaseorder.ReceiveRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+		Lines:    lines,
+	}
+
+	order, err := h.service.Receive(r.Context(), receiveReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to receive purchase order")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toPurchaseOrderResponse(order))
+}
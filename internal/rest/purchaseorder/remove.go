@@ -0,0 +1,49 @@
+package purchaseorder
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Remove
+// @Summary      Delete purchase order
+// @Description  Delete a purchase order by ID (only draft orders can be deleted; sent orders must be cancelled)
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Purchase order ID"
+// @Success      204          "No Content"
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Purchase order not found"
+// @Failure      409          {object}  map[string]interface{}  "Purchase order is not a draft"
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id} [delete]
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid purchase order ID", nil)
+		return
+	}
+
+	err = h.service.Delete(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to delete purchase order")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
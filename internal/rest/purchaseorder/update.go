@@ -0,0 +1,76 @@
+package purchaseorder
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/purchaseorder"
+	restentities "motico-api/internal/rest/purchaseorder/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Update
+// @Summary      Update purchase order
+// @Description  Replace supplier, store, lines and notes of a purchase order (only draft orders can be updated)
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                   true  "Tenant ID"
+// @Param        id           path      string                                   true  "Purchase order ID"
+// @Param        request      body      restentities.UpdatePurchaseOrderRequest  true  "Purchase order data"
+// @Success      200          {object}  restentities.PurchaseOrderResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Purchase order not found"
+// @Failure      409          {object}  map[string]interface{}  "Purchase order is not a draft"
+// @Failure      422          {object}  map[string]interface{}  "Unknown supplier or store, or product not listed in the store"
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid purchase order ID", nil)
+		return
+	}
+
+	var req restentities.UpdatePurchaseOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	updateReq := purchaseorder.UpdateRequest{
+		ID:         id,
+		TenantID:   tenantID,
+		SupplierID: &req.SupplierID,
+		StoreID:    &req.StoreID,
+		Lines:      toLineRequests(req.Lines),
+		Notes:      req.Notes,
+		ExpectedAt: req.ExpectedAt,
+	}
+
+	order, err := h.service.Update(r.Context(), updateReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to update purchase order")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toPurchaseOrderResponse(order))
+}
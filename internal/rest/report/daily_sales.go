@@ -0,0 +1,71 @@
+package report
+
+import (
+	"motico-api/internal/domain/report"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// DailySales
+// @Summary      Daily sales
+// @Description  Fulfilled sales of a store per day, with revenue, cost of goods sold and gross margin. from and to are YYYY-MM-DD (inclusive) and default to the last 30 days; days without sales are returned with zeros
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true   "Tenant ID"
+// @Param        store_id     query     string  true   "Store ID"
+// @Param        from         query     string  false  "First day (YYYY-MM-DD)"
+// @Param        to           query     string  false  "Last day (YYYY-MM-DD)"
+// @Success      200          {object}  restentities.DailySalesResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Security     BearerAuth
+// @Router       /reports/daily-sales [get]
+func (h *Handler) DailySales(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	storeID, err := uuid.Parse(r.URL.Query().Get("store_id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "store_id is required and must be a valid ID", nil)
+		return
+	}
+
+	to := time.Now().UTC()
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		if to, err = time.Parse(time.DateOnly, toStr); err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid to, expected YYYY-MM-DD", nil)
+			return
+		}
+	}
+
+	from := to.AddDate(0, 0, -29)
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		if from, err = time.Parse(time.DateOnly, fromStr); err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid from, expected YYYY-MM-DD", nil)
+			return
+		}
+	}
+
+	sales, err := h.service.DailySales(r.Context(), report.DailySalesRequest{
+		TenantID: tenantID,
+		StoreID:  storeID,
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to build daily sales")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toDailySalesResponse(sales))
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CategoryValuationResponse struct {
+	CategoryID uuid.UUID    `json:"category_id"`
+	Name       string       `json:"name"`
+	Quantity   int          `json:"quantity"`
+	Value      money.Amount `json:"value"`
+}
+
+type StoreValuationResponse struct {
+	StoreID    uuid.UUID                   `json:"store_id"`
+	Name       string                      `json:"name"`
+	Quantity   int                         `json:"quantity"`
+	Value      money.Amount                `json:"value"`
+	Categories []CategoryValuationResponse `json:"categories"`
+}
+
+type InventoryValuationResponse struct {
+	AsOf     time.Time                `json:"as_of"`
+	Currency string                   `json:"currency"`
+	Quantity int                      `json:"quantity"`
+	Value    money.Amount             `json:"value"`
+	Stores   []StoreValuationResponse `json:"stores"`
+}
+
+type DailySalesRowResponse struct {
+	Date        string       `json:"date,omitempty"`
+	Orders      int          `json:"orders"`
+	Units       int          `json:"units"`
+	Revenue     money.Amount `json:"revenue"`
+	Cost        money.Amount `json:"cost"`
+	GrossMargin money.Amount `json:"gross_margin"`
+}
+
+type DailySalesResponse struct {
+	StoreID  uuid.UUID               `json:"store_id"`
+	From     string                  `json:"from"`
+	To       string                  `json:"to"`
+	Currency string                  `json:"currency"`
+	Days     []DailySalesRowResponse `json:"days"`
+	Totals   DailySalesRowResponse   `json:"totals"`
+}
+
+type ExpiringLotResponse struct {
+	StoreID     uuid.UUID    `json:"store_id"`
+	StoreName   string       `json:"store_name"`
+	ProductID   uuid.UUID    `json:"product_id"`
+	ProductName string       `json:"product_name"`
+	SKU         *string      `json:"sku,omitempty"`
+	LotNumber   string       `json:"lot_number"`
+	ExpiresAt   string       `json:"expires_at"`
+	DaysLeft    int          `json:"days_left"`
+	Expired     bool         `json:"expired"`
+	Quantity    int          `json:"quantity"`
+	Value       money.Amount `json:"value"`
+}
+
+type ExpiringLotsResponse struct {
+	Until    string                `json:"until"`
+	Currency string                `json:"currency"`
+	Quantity int                   `json:"quantity"`
+	Value    money.Amount          `json:"value"`
+	Lots     []ExpiringLotResponse `json:"lots"`
+}
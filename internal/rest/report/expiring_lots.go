@@ -0,0 +1,63 @@
+package report
+
+import (
+	"motico-api/internal/domain/report"
+	"motico-api/internal/domain/report/entities"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ExpiringLots
+// @Summary      Expiring lots
+// @Description  Lots with stock that expire within the next days (30 by default), plus the ones already expired, ordered by expiry date and valued at the product average cost
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true   "Tenant ID"
+// @Param        days         query     int     false  "Days ahead to include (0-366)"
+// @Param        store_id     query     string  false  "Filter by store ID"
+// @Success      200          {object}  restentities.ExpiringLotsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Security     BearerAuth
+// @Router       /reports/expiring-lots [get]
+func (h *Handler) ExpiringLots(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	req := report.ExpiringLotsRequest{TenantID: tenantID, Days: entities.DefaultExpiringDays}
+
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		if req.Days, err = strconv.Atoi(daysStr); err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid days, expected a number", nil)
+			return
+		}
+	}
+
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		storeID, err := uuid.Parse(storeIDStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+			return
+		}
+		req.StoreID = &storeID
+	}
+
+	lots, err := h.service.ExpiringLots(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to build expiring lots report")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toExpiringLotsResponse(lots, time.Now()))
+}
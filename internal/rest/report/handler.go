@@ -0,0 +1,109 @@
+package report
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/report"
+	"motico-api/internal/domain/report/entities"
+	restentities "motico-api/internal/rest/report/entities"
+	"time"
+)
+
+type Handler struct {
+	service *report.Service
+	config  *config.Config
+}
+
+func NewHandler(service *report.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toInventoryValuationResponse(v *entities.InventoryValuation) restentities.InventoryValuationResponse {
+	stores := make([]restentities.StoreValuationResponse, len(v.Stores))
+	for i, store := range v.Stores {
+		categories := make([]restentities.CategoryValuationResponse, len(store.Categories))
+		for j, category := range store.Categories {
+			categories[j] = restentities.CategoryValuationResponse{
+				CategoryID: category.CategoryID,
+				Name:       category.Name,
+				Quantity:   category.Quantity,
+				Value:      category.Value,
+			}
+		}
+		stores[i] = restentities.StoreValuationResponse{
+			StoreID:    store.StoreID,
+			Name:       store.Name,
+			Quantity:   store.Quantity,
+			Value:      store.Value,
+			Categories: categories,
+		}
+	}
+
+	return restentities.InventoryValuationResponse{
+		AsOf:     v.AsOf,
+		Currency: string(v.Currency),
+		Quantity: v.Quantity,
+		Value:    v.Value,
+		Stores:   stores,
+	}
+}
+
+func toDailySalesResponse(s *entities.DailySales) restentities.DailySalesResponse {
+	days := make([]restentities.DailySalesRowResponse, len(s.Days))
+	for i := range s.Days {
+		days[i] = toDailySalesRowResponse(&s.Days[i])
+	}
+
+	totals := toDailySalesRowResponse(&s.Totals)
+	totals.Date = ""
+
+	return restentities.DailySalesResponse{
+		StoreID:  s.StoreID,
+		From:     s.From.Format(time.DateOnly),
+		To:       s.To.Format(time.DateOnly),
+		Currency: string(s.Currency),
+		Days:     days,
+		Totals:   totals,
+	}
+}
+
+func toDailySalesRowResponse(row *entities.DailySalesRow) restentities.DailySalesRowResponse {
+	return restentities.DailySalesRowResponse{
+		Date:        row.Date.Format(time.DateOnly),
+		Orders:      row.Orders,
+		Units:       row.Units,
+		Revenue:     row.Revenue,
+		Cost:        row.Cost,
+		GrossMargin: row.GrossMargin(),
+	}
+}
+
+func toExpiringLotsResponse(report *entities.ExpiringLots, now time.Time) restentities.ExpiringLotsResponse {
+	lots := make([]restentities.ExpiringLotResponse, len(report.Lots))
+	for i, lot := range report.Lots {
+		daysLeft := lot.DaysLeft(now)
+		lots[i] = restentities.ExpiringLotResponse{
+			StoreID:     lot.StoreID,
+			StoreName:   lot.StoreName,
+			ProductID:   lot.ProductID,
+			ProductName: lot.ProductName,
+			SKU:         lot.SKU,
+			LotNumber:   lot.LotNumber,
+			ExpiresAt:   lot.ExpiresAt.Format(time.DateOnly),
+			DaysLeft:    daysLeft,
+			Expired:     daysLeft < 0,
+			Quantity:    lot.Quantity,
+			Value:       lot.Value,
+		}
+	}
+
+	return restentities.ExpiringLotsResponse{
+		Until:    report.Until.Format(time.DateOnly),
+		Currency: string(report.Currency),
+		Quantity: report.Quantity,
+		Value:    report.Value,
+		Lots:     lots,
+	}
+}
@@ -0,0 +1,75 @@
+package report
+
+import (
+	"motico-api/internal/domain/report"
+	"motico-api/internal/rest/response"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// InventoryValuation
+// @Summary      Inventory valuation
+// @Description  Value of the inventory at a given date, grouped by store and category. as_of accepts RFC3339 or YYYY-MM-DD (end of that day) and defaults to now
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true   "Tenant ID"
+// @Param        as_of        query     string  false  "Valuation date"
+// @Param        store_id     query     string  false  "Filter by store ID"
+// @Success      200          {object}  restentities.InventoryValuationResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Security     BearerAuth
+// @Router       /reports/inventory-valuation [get]
+func (h *Handler) InventoryValuation(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	req := report.ValuationRequest{TenantID: tenantID, AsOf: time.Now()}
+
+	if asOfStr := r.URL.Query().Get("as_of"); asOfStr != "" {
+		asOf, err := parseAsOf(asOfStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid as_of, expected RFC3339 or YYYY-MM-DD", nil)
+			return
+		}
+		req.AsOf = asOf
+	}
+
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		storeID, err := uuid.Parse(storeIDStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+			return
+		}
+		req.StoreID = &storeID
+	}
+
+	valuation, err := h.service.InventoryValuation(r.Context(), req)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to build inventory valuation")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toInventoryValuationResponse(valuation))
+}
+
+// parseAsOf acepta una fecha y hora RFC3339 o un día, que se toma completo.
+func parseAsOf(raw string) (time.Time, error) {
+	if value, err := time.Parse(time.RFC3339, raw); err == nil {
+		return value, nil
+	}
+	day, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return day.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
@@ -2,21 +2,33 @@ package response
 
 import (
 	"encoding/json"
+	"motico-api/pkg/apperror"
 	"net/http"
+	"strings"
 )
 
-type ErrorResponse struct {
-	Error ErrorDetail `json:"error"`
-}
+// ProblemContentType es el tipo de contenido de las respuestas de error (RFC 7807).
+const ProblemContentType = "application/problem+json"
 
-type ErrorDetail struct {
-	Code    string      `json:"code"`
-	Message string      `json:"message"`
-	Details interface{} `json:"details,omitempty"`
+// Problem es el cuerpo de una respuesta de error según RFC 7807. Code es estable
+// y permite distinguir errores con el mismo estado HTTP; Errors lleva el detalle
+// por campo de las validaciones.
+type Problem struct {
+	Type     string      `json:"type"`
+	Title    string      `json:"title"`
+	Status   int         `json:"status"`
+	Detail   string      `json:"detail,omitempty"`
+	Instance string      `json:"instance,omitempty"`
+	Code     string      `json:"code"`
+	Errors   interface{} `json:"errors,omitempty"`
 }
 
 func JSON(w http.ResponseWriter, status int, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
+	writeJSON(w, "application/json", status, data)
+}
+
+func writeJSON(w http.ResponseWriter, contentType string, status int, data interface{}) {
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(status)
 	if err := json.NewEncoder(w).Encode(data); err != nil {
 		// If encoding fails, we can't send a proper error response
@@ -25,15 +37,53 @@ func JSON(w http.ResponseWriter, status int, data interface{}) {
 	}
 }
 
+// Error responde un error de la petición que no viene del dominio (un ID mal
+// formado, un cuerpo ilegible); el código se deriva del estado HTTP.
 func Error(w http.ResponseWriter, status int, message string, details interface{}) {
-	response := ErrorResponse{
-		Error: ErrorDetail{
-			Code:    http.StatusText(status),
-			Message: message,
-			Details: details,
-		},
+	writeProblem(w, Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: message,
+		Code:   statusCode(status),
+		Errors: details,
+	})
+}
+
+// HandleError traduce err a una respuesta: los errores de dominio responden con
+// su código y el estado que sugiere su clase; cualquier otro es un error interno
+// que se responde con fallback, sin exponer el mensaje original.
+func HandleError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	appErr, ok := apperror.As(err)
+	if !ok || appErr.Kind == apperror.KindInternal {
+		Error(w, http.StatusInternalServerError, fallback, nil)
+		return
+	}
+
+	status := appErr.Kind.Status()
+	writeProblem(w, Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   appErr.Message,
+		Instance: r.URL.Path,
+		Code:     appErr.Code,
+		Errors:   appErr.Details,
+	})
+}
+
+func writeProblem(w http.ResponseWriter, problem Problem) {
+	writeJSON(w, ProblemContentType, problem.Status, problem)
+}
+
+// statusCode convierte el texto del estado en un código: "Not Found" -> "not_found".
+func statusCode(status int) string {
+	text := strings.ToLower(http.StatusText(status))
+	text = strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text)
+	if text == "" {
+		return "error"
 	}
-	JSON(w, status, response)
+	return text
 }
 
 func Success(w http.ResponseWriter, status int, data interface{}) {
@@ -2,11 +2,20 @@ package rest
 
 import (
 	authdomain "motico-api/internal/domain/auth"
+	"motico-api/internal/rest/attachment"
 	authhandler "motico-api/internal/rest/auth"
+	"motico-api/internal/rest/catalog"
 	"motico-api/internal/rest/category"
+	"motico-api/internal/rest/countsession"
+	"motico-api/internal/rest/pricelist"
 	"motico-api/internal/rest/product"
+	"motico-api/internal/rest/purchaseorder"
+	"motico-api/internal/rest/report"
+	"motico-api/internal/rest/salesorder"
 	"motico-api/internal/rest/stock"
+	"motico-api/internal/rest/stockreturn"
 	"motico-api/internal/rest/store"
+	"motico-api/internal/rest/supplier"
 	"motico-api/internal/rest/transfer"
 
 	"github.com/go-chi/chi/v5"
@@ -14,12 +23,21 @@ import (
 )
 
 type RouterDependencies struct {
-	AuthService     *authdomain.Service
-	CategoryHandler *category.Handler
-	StoreHandler    *store.Handler
-	ProductHandler  *product.Handler
-	StockHandler    *stock.Handler
-	TransferHandler *transfer.Handler
+	AuthService          *authdomain.Service
+	CategoryHandler      *category.Handler
+	StoreHandler         *store.Handler
+	CatalogHandler       *catalog.Handler
+	PriceListHandler     *pricelist.Handler
+	ProductHandler       *product.Handler
+	AttachmentHandler    *attachment.Handler
+	StockHandler         *stock.Handler
+	TransferHandler      *transfer.Handler
+	SupplierHandler      *supplier.Handler
+	PurchaseOrderHandler *purchaseorder.Handler
+	SalesOrderHandler    *salesorder.Handler
+	ReturnHandler        *stockreturn.Handler
+	CountSessionHandler  *countsession.Handler
+	ReportHandler        *report.Handler
 }
 
 func NewRouter(deps RouterDependencies) *chi.Mux {
@@ -29,7 +47,7 @@ func NewRouter(deps RouterDependencies) *chi.Mux {
 	router.Use(chimiddleware.RealIP)
 	router.Use(LoggerMiddleware)
 	router.Use(RecoveryMiddleware)
-	router.Use(chimiddleware.AllowContentType("application/json"))
+	router.Use(chimiddleware.AllowContentType("application/json", "multipart/form-data"))
 
 	authHandler := authhandler.NewHandler(deps.AuthService)
 
@@ -39,6 +57,12 @@ func NewRouter(deps RouterDependencies) *chi.Mux {
 		r.Group(func(r chi.Router) {
 			r.Use(TenantMiddleware)
 			r.Use(AuthMiddleware(deps.AuthService))
+			r.Use(IncludeDeletedMiddleware)
+
+			// Solo los administradores recuperan lo borrado
+			requireAdmin := RequireRole(authdomain.RoleAdmin)
+			// Archivar o cerrar una sucursal es decisión de un encargado
+			requireManager := RequireRole(authdomain.RoleManager)
 
 			r.Route("/categories", func(r chi.Router) {
 				r.Get("/", deps.CategoryHandler.List)
@@ -47,6 +71,9 @@ func NewRouter(deps RouterDependencies) *chi.Mux {
 				r.Put("/{id}", deps.CategoryHandler.Update)
 				r.Patch("/{id}", deps.CategoryHandler.ParcialUpdate)
 				r.Delete("/{id}", deps.CategoryHandler.Remove)
+				r.With(requireAdmin).Post("/{id}/restore", deps.CategoryHandler.Restore)
+				r.Get("/{id}/attributes", deps.CategoryHandler.AttributeSchema)
+				r.Post("/{id}/move", deps.CategoryHandler.Move)
 			})
 
 			r.Route("/stores", func(r chi.Router) {
@@ -56,31 +83,151 @@ func NewRouter(deps RouterDependencies) *chi.Mux {
 				r.Put("/{id}", deps.StoreHandler.Update)
 				r.Patch("/{id}", deps.StoreHandler.ParcialUpdate)
 				r.Delete("/{id}", deps.StoreHandler.Remove)
+				r.With(requireAdmin).Post("/{id}/restore", deps.StoreHandler.Restore)
+				r.With(requireManager).Post("/{id}/archive", deps.StoreHandler.Archive)
+				r.With(requireManager).Post("/{id}/unarchive", deps.StoreHandler.Unarchive)
+				r.With(requireManager).Post("/{id}/close", deps.StoreHandler.Close)
+				r.Get("/{id}/locations", deps.StoreHandler.ListLocations)
+				r.Post("/{id}/locations", deps.StoreHandler.CreateLocation)
+				r.Get("/{id}/locations/{locationId}", deps.StoreHandler.GetLocation)
+				r.Patch("/{id}/locations/{locationId}", deps.StoreHandler.UpdateLocation)
+				r.Delete("/{id}/locations/{locationId}", deps.StoreHandler.RemoveLocation)
+			})
+
+			r.Route("/catalog", func(r chi.Router) {
+				r.Get("/", deps.CatalogHandler.List)
+				r.Get("/{id}", deps.CatalogHandler.GetByID)
+				r.Post("/", deps.CatalogHandler.Create)
+				r.Put("/{id}", deps.CatalogHandler.Update)
+				r.Patch("/{id}", deps.CatalogHandler.ParcialUpdate)
+				r.Delete("/{id}", deps.CatalogHandler.Remove)
+				r.Get("/{id}/listings", deps.CatalogHandler.ListListings)
+				r.Post("/{id}/listings", deps.CatalogHandler.CreateListing)
+				r.Get("/{id}/barcodes", deps.CatalogHandler.ListBarcodes)
+				r.Post("/{id}/barcodes", deps.CatalogHandler.AddBarcode)
+				r.Delete("/{id}/barcodes/{code}", deps.CatalogHandler.RemoveBarcode)
+				r.Post("/fitments/import", deps.CatalogHandler.ImportFitments)
+				r.Get("/{id}/fitments", deps.CatalogHandler.ListFitments)
+				r.Post("/{id}/fitments", deps.CatalogHandler.AddFitment)
+				r.Delete("/{id}/fitments/{fitmentId}", deps.CatalogHandler.RemoveFitment)
+			})
+
+			r.Route("/price-lists", func(r chi.Router) {
+				r.Get("/", deps.PriceListHandler.List)
+				r.Get("/{id}", deps.PriceListHandler.GetByID)
+				r.Post("/", deps.PriceListHandler.Create)
+				r.Put("/{id}", deps.PriceListHandler.Update)
+				r.Delete("/{id}", deps.PriceListHandler.Remove)
 			})
 
 			r.Route("/products", func(r chi.Router) {
 				r.Get("/", deps.ProductHandler.List)
+				r.Get("/search", deps.ProductHandler.Search)
+				r.Get("/lookup", deps.ProductHandler.Lookup)
 				r.Get("/{id}", deps.ProductHandler.GetByID)
 				r.Post("/", deps.ProductHandler.Create)
 				r.Put("/{id}", deps.ProductHandler.Update)
 				r.Patch("/{id}", deps.ProductHandler.ParcialUpdate)
 				r.Delete("/{id}", deps.ProductHandler.Remove)
+				r.With(requireAdmin).Post("/{id}/restore", deps.ProductHandler.Restore)
+				r.With(requireManager).Post("/{id}/archive", deps.ProductHandler.Archive)
+				r.With(requireManager).Post("/{id}/unarchive", deps.ProductHandler.Unarchive)
 
 				r.Route("/{id}/stock", func(r chi.Router) {
 					r.Get("/", deps.StockHandler.GetByID)
 					r.Put("/", deps.StockHandler.Update)
 					r.Patch("/", deps.StockHandler.Adjust)
 				})
+				r.Get("/{id}/reservations", deps.StockHandler.ListReservations)
+				r.Post("/{id}/reservations", deps.StockHandler.HoldForCart)
+				r.Delete("/{id}/reservations/cart/{cartId}", deps.StockHandler.ReleaseCartHold)
+				r.Get("/{id}/lots", deps.StockHandler.ListLots)
+				r.Get("/{id}/serials", deps.StockHandler.ListSerials)
+				r.Get("/{id}/bins", deps.StockHandler.ListBins)
+				r.Post("/{id}/putaway", deps.StockHandler.Putaway)
+				r.Post("/{id}/bin-moves", deps.StockHandler.MoveBetweenBins)
+				r.Get("/{id}/options", deps.ProductHandler.ListOptions)
+				r.Put("/{id}/options", deps.ProductHandler.ReplaceOptions)
+				r.Get("/{id}/variants", deps.ProductHandler.ListVariants)
+				r.Post("/{id}/variants", deps.ProductHandler.CreateVariant)
+				r.Post("/{id}/variants/generate", deps.ProductHandler.GenerateVariants)
+				r.Get("/{id}/attachments", deps.AttachmentHandler.List)
+				r.Post("/{id}/attachments", deps.AttachmentHandler.Upload)
+				r.Get("/{id}/attachments/{attachmentId}", deps.AttachmentHandler.GetByID)
+				r.Delete("/{id}/attachments/{attachmentId}", deps.AttachmentHandler.Remove)
 			})
 
+			r.Get("/serials/{serial}", deps.StockHandler.GetSerial)
+
 			r.Route("/transfers", func(r chi.Router) {
 				r.Get("/", deps.TransferHandler.List)
 				r.Get("/{id}", deps.TransferHandler.GetByID)
 				r.Post("/", deps.TransferHandler.Create)
 				r.Put("/{id}", deps.TransferHandler.Update)
+				r.Get("/{id}/discrepancies", deps.TransferHandler.ListDiscrepancies)
+				r.Get("/{id}/pick-list", deps.TransferHandler.PickList)
+				r.Patch("/{id}/approve", deps.TransferHandler.Approve)
+				r.Patch("/{id}/dispatch", deps.TransferHandler.Dispatch)
+				r.Patch("/{id}/receive", deps.TransferHandler.Receive)
 				r.Patch("/{id}/complete", deps.TransferHandler.Complete)
 				r.Patch("/{id}/cancel", deps.TransferHandler.Cancel)
 				r.Delete("/{id}", deps.TransferHandler.Remove)
+				r.With(requireAdmin).Post("/{id}/restore", deps.TransferHandler.Restore)
+			})
+
+			r.Route("/suppliers", func(r chi.Router) {
+				r.Get("/", deps.SupplierHandler.List)
+				r.Get("/{id}", deps.SupplierHandler.GetByID)
+				r.Post("/", deps.SupplierHandler.Create)
+				r.Put("/{id}", deps.SupplierHandler.Update)
+				r.Patch("/{id}", deps.SupplierHandler.ParcialUpdate)
+				r.Delete("/{id}", deps.SupplierHandler.Remove)
+			})
+
+			r.Route("/purchase-orders", func(r chi.Router) {
+				r.Get("/", deps.PurchaseOrderHandler.List)
+				r.Get("/{id}", deps.PurchaseOrderHandler.GetByID)
+				r.Post("/", deps.PurchaseOrderHandler.Create)
+				r.Put("/{id}", deps.PurchaseOrderHandler.Update)
+				r.Get("/{id}/receipts", deps.PurchaseOrderHandler.ListReceipts)
+				r.Patch("/{id}/send", deps.PurchaseOrderHandler.Send)
+				r.Patch("/{id}/receive", deps.PurchaseOrderHandler.Receive)
+				r.Patch("/{id}/close", deps.PurchaseOrderHandler.Close)
+				r.Patch("/{id}/cancel", deps.PurchaseOrderHandler.Cancel)
+				r.Delete("/{id}", deps.PurchaseOrderHandler.Remove)
+			})
+
+			r.Route("/sales-orders", func(r chi.Router) {
+				r.Get("/", deps.SalesOrderHandler.List)
+				r.Get("/{id}", deps.SalesOrderHandler.GetByID)
+				r.Post("/", deps.SalesOrderHandler.Create)
+				r.Patch("/{id}/fulfill", deps.SalesOrderHandler.Fulfill)
+				r.Patch("/{id}/cancel", deps.SalesOrderHandler.Cancel)
+			})
+
+			r.Route("/returns", func(r chi.Router) {
+				r.Get("/", deps.ReturnHandler.List)
+				r.Get("/{id}", deps.ReturnHandler.GetByID)
+				r.Post("/", deps.ReturnHandler.Create)
+				r.Patch("/{id}/resolve", deps.ReturnHandler.Resolve)
+			})
+
+			r.Route("/count-sessions", func(r chi.Router) {
+				r.Get("/", deps.CountSessionHandler.List)
+				r.Get("/{id}", deps.CountSessionHandler.GetByID)
+				r.Get("/{id}/variances", deps.CountSessionHandler.Variances)
+				r.Post("/", deps.CountSessionHandler.Create)
+				r.Post("/{id}/counts", deps.CountSessionHandler.RecordCounts)
+				r.Patch("/{id}/submit", deps.CountSessionHandler.Submit)
+				r.Patch("/{id}/reopen", deps.CountSessionHandler.Reopen)
+				r.Patch("/{id}/approve", deps.CountSessionHandler.Approve)
+				r.Patch("/{id}/cancel", deps.CountSessionHandler.Cancel)
+			})
+
+			r.Route("/reports", func(r chi.Router) {
+				r.Get("/inventory-valuation", deps.ReportHandler.InventoryValuation)
+				r.Get("/daily-sales", deps.ReportHandler.DailySales)
+				r.Get("/expiring-lots", deps.ReportHandler.ExpiringLots)
 			})
 		})
 	})
@@ -0,0 +1,56 @@
+package salesorder
+
+import (
+	"motico-api/internal/domain/salesorder"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Cancel
+// @Summary      Cancel sales order
+// @Description  Cancel a pending sales order and release its stock reservation
+// @Tags         sales-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Sales order ID"
+// @Success      200          {object}  restentities.SalesOrderResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Sales order not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
+// @Security     BearerAuth
+// @Router       /sales-orders/{id}/cancel [patch]
+func (h *Handler) Cancel(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid sales order ID", nil)
+		return
+	}
+
+	actionReq := salesorder.ActionRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+	}
+
+	order, err := h.service.Cancel(r.Context(), actionReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to cancel sales order")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toSalesOrderResponse(order))
+}
@@ -0,0 +1,70 @@
+package salesorder
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/salesorder"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/salesorder/entities"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create sales order
+// @Description  Create a pending sales order for a store. Each line is priced at the product's current price and its stock is reserved until the order is fulfilled or cancelled. Serial-tracked products must list one serial per unit, and those units are reserved
+// @Tags         sales-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                                true  "Tenant ID"
+// @Param        request      body      restentities.CreateSalesOrderRequest  true  "Sales order data"
+// @Success      201          {object}  restentities.SalesOrderResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient stock or serial not available"
+// @Failure      422          {object}  map[string]interface{}  "Unknown, closing or archived store, or product that cannot be sold in the store"
+// @Security     BearerAuth
+// @Router       /sales-orders [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreateSalesOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	lines := make([]salesorder.LineRequest, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = salesorder.LineRequest{ProductID: line.ProductID, Quantity: line.Quantity, Serials: line.Serials}
+	}
+
+	createReq := salesorder.CreateRequest{
+		TenantID:     tenantID,
+		StoreID:      req.StoreID,
+		Lines:        lines,
+		CustomerName: req.CustomerName,
+		Notes:        req.Notes,
+		CreatedBy:    context.GetUserID(r.Context()),
+	}
+
+	order, err := h.service.Create(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create sales order")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toSalesOrderResponse(order))
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SalesOrderLineRequest struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity" validate:"required,gt=0"`
+	// Serials es obligatorio en productos con seguimiento por serie: una por unidad.
+	Serials []string `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
+}
+
+// CreateSalesOrderRequest no lleva precios: cada línea toma el precio vigente del
+// producto en la sucursal al crear la orden.
+type CreateSalesOrderRequest struct {
+	StoreID      uuid.UUID               `json:"store_id" validate:"required"`
+	Lines        []SalesOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+	CustomerName *string                 `json:"customer_name,omitempty" validate:"omitempty,max=255"`
+	Notes        *string                 `json:"notes,omitempty"`
+}
+
+type SalesOrderLineResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	ProductID uuid.UUID    `json:"product_id"`
+	Quantity  int          `json:"quantity"`
+	UnitPrice money.Amount `json:"unit_price"`
+	Total     money.Amount `json:"total"`
+	Serials   []string     `json:"serials,omitempty"`
+}
+
+type SalesOrderResponse struct {
+	ID           uuid.UUID                `json:"id"`
+	TenantID     uuid.UUID                `json:"tenant_id"`
+	StoreID      uuid.UUID                `json:"store_id"`
+	Status       string                   `json:"status"`
+	Currency     string                   `json:"currency"`
+	Quantity     int                      `json:"quantity"`
+	Total        money.Amount             `json:"total"`
+	CustomerName *string                  `json:"customer_name,omitempty"`
+	Notes        *string                  `json:"notes,omitempty"`
+	Lines        []SalesOrderLineResponse `json:"lines"`
+	CreatedBy    *string                  `json:"created_by,omitempty"`
+	FulfilledAt  *time.Time               `json:"fulfilled_at,omitempty"`
+	FulfilledBy  *string                  `json:"fulfilled_by,omitempty"`
+	CancelledAt  *time.Time               `json:"cancelled_at,omitempty"`
+	CancelledBy  *string                  `json:"cancelled_by,omitempty"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+}
+
+type ListSalesOrdersResponse struct {
+	Data       []SalesOrderResponse `json:"data"`
+	Pagination PaginationInfo       `json:"pagination"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
@@ -0,0 +1,55 @@
+package salesorder
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/salesorder"
+	"motico-api/internal/domain/salesorder/entities"
+	restentities "motico-api/internal/rest/salesorder/entities"
+)
+
+type Handler struct {
+	service *salesorder.Service
+	config  *config.Config
+}
+
+func NewHandler(service *salesorder.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toSalesOrderResponse(o *entities.SalesOrder) restentities.SalesOrderResponse {
+	lines := make([]restentities.SalesOrderLineResponse, len(o.Lines))
+	for i := range o.Lines {
+		line := &o.Lines[i]
+		lines[i] = restentities.SalesOrderLineResponse{
+			ID:        line.ID,
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+			Total:     line.Total(),
+			Serials:   line.Serials,
+		}
+	}
+
+	return restentities.SalesOrderResponse{
+		ID:           o.ID,
+		TenantID:     o.TenantID,
+		StoreID:      o.StoreID,
+		Status:       string(o.Status),
+		Currency:     string(o.Currency),
+		Quantity:     o.TotalQuantity(),
+		Total:        o.Total(),
+		CustomerName: o.CustomerName,
+		Notes:        o.Notes,
+		Lines:        lines,
+		CreatedBy:    o.CreatedBy,
+		FulfilledAt:  o.FulfilledAt,
+		FulfilledBy:  o.FulfilledBy,
+		CancelledAt:  o.CancelledAt,
+		CancelledBy:  o.CancelledBy,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
@@ -0,0 +1,98 @@
+package salesorder
+
+import (
+	"motico-api/internal/domain/salesorder"
+	"motico-api/internal/domain/salesorder/entities"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/salesorder/entities"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/query"
+)
+
+// List
+// @Summary      List sales orders
+// @Description  Get paginated list of sales orders for the tenant
+// @Tags         sales-orders
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        status       query     string  false "Filter by status (pending, fulfilled, cancelled)"
+// @Param        store_id     query     string  false "Filter by store ID"
+// @Param        sort         query     string  false "Sort fields (status, created_at, updated_at, fulfilled_at), prefix with - for descending"
+// @Param        created_after    query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before   query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since    query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        fulfilled_after  query  string  false "Fulfilled after (RFC 3339 or YYYY-MM-DD)"
+// @Success      200          {object}  restentities.ListSalesOrdersResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /sales-orders [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var filter salesorder.ListFilter
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.SalesOrderStatus(statusStr)
+		if s.IsValid() {
+			filter.Status = &s
+		}
+	}
+
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		id, err := uuid.Parse(storeIDStr)
+		if err == nil {
+			filter.StoreID = &id
+		}
+	}
+
+	filter.Query = query.Parse(r.URL.Query(), "page", "limit", "status", "store_id")
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	orders, err := h.service.List(r.Context(), tenantID, filter, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list sales orders")
+		return
+	}
+
+	responses := make([]restentities.SalesOrderResponse, len(orders))
+	for i, o := range orders {
+		responses[i] = toSalesOrderResponse(o)
+	}
+
+	total := len(orders)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListSalesOrdersResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
@@ -3,7 +3,6 @@ package stock
 import (
 	"encoding/json"
 	"motico-api/internal/domain/stock"
-	"motico-api/internal/domain/stock/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/stock/entities"
 	"net/http"
@@ -16,7 +15,7 @@ import (
 
 // Adjust
 // @Summary      Adjust stock
-// @Description  Add or subtract quantity from stock (amount can be positive or negative)
+// @Description  Add or subtract quantity from stock (amount can be positive or negative). A positive amount with unit_cost is recorded as a receipt at that cost; removals are valued with the tenant costing method and taken from the product bins in pick order before the unplaced units. Serial-tracked products must list one serial per unit added or removed
 // @Tags         stock
 // @Accept       json
 // @Produce      json
@@ -26,7 +25,7 @@ import (
 // @Success      200          {object}  restentities.StockResponse
 // @Failure      400          {object}  map[string]interface{}  "Invalid request"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
-// @Failure      409          {object}  map[string]interface{}  "Insufficient stock"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient stock, or product or store is archived"
 // @Security     BearerAuth
 // @Router       /products/{id}/stock [patch]
 func (h *Handler) Adjust(w http.ResponseWriter, r *http.Request) {
@@ -51,7 +50,7 @@ func (h *Handler) Adjust(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
@@ -59,33 +58,27 @@ func (h *Handler) Adjust(w http.ResponseWriter, r *http.Request) {
 		TenantID:  tenantID,
 		ProductID: productID,
 		Amount:    req.Amount,
+		UnitCost:  req.UnitCost,
+		Lot:       toLotSpec(req.LotNumber, req.ExpiresAt),
+		Serials:   req.Serials,
 	}
 
 	stock, err := h.service.Adjust(r.Context(), adjustReq)
 	if err != nil {
-		if err == entities.ErrInvalidQuantity {
-			response.Error(w, http.StatusBadRequest, "invalid quantity", nil)
-			return
-		}
-		if err == entities.ErrInsufficientStock {
-			response.Error(w, http.StatusConflict, "insufficient stock available", nil)
-			return
-		}
-		if err == entities.ErrInvalidReservedAmount {
-			response.Error(w, http.StatusBadRequest, "reserved quantity cannot exceed total quantity", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to adjust stock", nil)
+		response.HandleError(w, r, err, "failed to adjust stock")
 		return
 	}
 
 	response.JSON(w, http.StatusOK, restentities.StockResponse{
-		ID:                stock.ID,
-		TenantID:          stock.TenantID,
-		ProductID:         stock.ProductID,
-		Quantity:          stock.Quantity,
-		ReservedQuantity:  stock.ReservedQuantity,
-		AvailableQuantity: stock.AvailableQuantity(),
-		UpdatedAt:         stock.UpdatedAt,
+		ID:                  stock.ID,
+		TenantID:            stock.TenantID,
+		ProductID:           stock.ProductID,
+		Quantity:            stock.Quantity,
+		ReservedQuantity:    stock.ReservedQuantity,
+		QuarantinedQuantity: stock.QuarantinedQuantity,
+		AvailableQuantity:   stock.AvailableQuantity(),
+		AverageCost:         stock.AverageCost(),
+		InventoryValue:      stock.InventoryValue,
+		UpdatedAt:           stock.UpdatedAt,
 	})
 }
@@ -0,0 +1,170 @@
+package stock
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stock/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// ListBins
+// @Summary      List product bins
+// @Description  Get the product stock broken down by bin, in pick order, and the units not yet placed in any bin
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200          {object}  restentities.BinSummaryResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /products/{id}/bins [get]
+func (h *Handler) ListBins(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	summary, err := h.service.ListBins(r.Context(), tenantID, productID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list bins")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toBinSummaryResponse(summary))
+}
+
+// Putaway
+// @Summary      Put away stock
+// @Description  Place units of the product that are not yet in any bin (for example just received) into a bin of the product store
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                       true  "Tenant ID"
+// @Param        id           path      string                       true  "Product ID"
+// @Param        request      body      restentities.PutawayRequest  true  "Putaway data"
+// @Success      200          {object}  restentities.BinSummaryResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Quantity exceeds the units not yet placed"
+// @Failure      422          {object}  map[string]interface{}  "Bin not found or not in the product store"
+// @Security     BearerAuth
+// @Router       /products/{id}/putaway [post]
+func (h *Handler) Putaway(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	var req restentities.PutawayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	putawayReq := stock.PutawayRequest{
+		TenantID:   tenantID,
+		ProductID:  productID,
+		LocationID: req.LocationID,
+		Quantity:   req.Quantity,
+		Actor:      context.GetUserID(r.Context()),
+	}
+
+	summary, err := h.service.Putaway(r.Context(), putawayReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to put away stock")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toBinSummaryResponse(summary))
+}
+
+// MoveBetweenBins
+// @Summary      Move stock between bins
+// @Description  Move units of the product from one bin to another bin of the same store
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                       true  "Tenant ID"
+// @Param        id           path      string                       true  "Product ID"
+// @Param        request      body      restentities.BinMoveRequest  true  "Move data"
+// @Success      200          {object}  restentities.BinSummaryResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient stock in the source bin"
+// @Failure      422          {object}  map[string]interface{}  "Bin not found or not in the product store"
+// @Security     BearerAuth
+// @Router       /products/{id}/bin-moves [post]
+func (h *Handler) MoveBetweenBins(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	var req restentities.BinMoveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	moveReq := stock.BinMoveRequest{
+		TenantID:       tenantID,
+		ProductID:      productID,
+		FromLocationID: req.FromLocationID,
+		ToLocationID:   req.ToLocationID,
+		Quantity:       req.Quantity,
+		Actor:          context.GetUserID(r.Context()),
+	}
+
+	summary, err := h.service.MoveBetweenBins(r.Context(), moveReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to move stock between bins")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toBinSummaryResponse(summary))
+}
@@ -1,25 +1,146 @@
 package entities
 
 import (
+	"motico-api/pkg/money"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// lot_number y expires_at solo aplican a productos con seguimiento por lote: en
+// una entrada indican el lote que recibe las unidades y, en una salida, el lote
+// del que salen (sin lote salen por FEFO). serials es obligatorio en productos con
+// seguimiento por serie y lleva un número por cada unidad que entra o sale.
 type UpdateStockRequest struct {
-	Quantity int `json:"quantity" validate:"required,gte=0"`
+	Quantity  int           `json:"quantity" validate:"required,gte=0"`
+	UnitCost  *money.Amount `json:"unit_cost,omitempty"`
+	LotNumber *string       `json:"lot_number,omitempty" validate:"omitempty,max=100"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Serials   []string      `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
 }
 
 type AdjustStockRequest struct {
-	Amount int `json:"amount" validate:"required"`
+	Amount    int           `json:"amount" validate:"required"`
+	UnitCost  *money.Amount `json:"unit_cost,omitempty"`
+	LotNumber *string       `json:"lot_number,omitempty" validate:"omitempty,max=100"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Serials   []string      `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
 }
 
 type StockResponse struct {
-	ID                uuid.UUID `json:"id"`
-	TenantID          uuid.UUID `json:"tenant_id"`
-	ProductID         uuid.UUID `json:"product_id"`
-	Quantity          int       `json:"quantity"`
-	ReservedQuantity  int       `json:"reserved_quantity"`
-	AvailableQuantity int       `json:"available_quantity"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                  uuid.UUID    `json:"id"`
+	TenantID            uuid.UUID    `json:"tenant_id"`
+	ProductID           uuid.UUID    `json:"product_id"`
+	Quantity            int          `json:"quantity"`
+	ReservedQuantity    int          `json:"reserved_quantity"`
+	QuarantinedQuantity int          `json:"quarantined_quantity"`
+	AvailableQuantity   int          `json:"available_quantity"`
+	AverageCost         money.Amount `json:"average_cost"`
+	InventoryValue      money.Amount `json:"inventory_value"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+}
+
+type ReservationOwnerResponse struct {
+	Type string    `json:"type"`
+	ID   uuid.UUID `json:"id"`
+}
+
+type ReservationResponse struct {
+	ID        uuid.UUID                `json:"id"`
+	ProductID uuid.UUID                `json:"product_id"`
+	Owner     ReservationOwnerResponse `json:"owner"`
+	Quantity  int                      `json:"quantity"`
+	ExpiresAt *time.Time               `json:"expires_at,omitempty"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+}
+
+type LotResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	LotNumber         string     `json:"lot_number"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+	Expired           bool       `json:"expired"`
+	Quantity          int        `json:"quantity"`
+	ReservedQuantity  int        `json:"reserved_quantity"`
+	AvailableQuantity int        `json:"available_quantity"`
+	ReceivedAt        time.Time  `json:"received_at"`
+}
+
+type ListLotsResponse struct {
+	Data     []LotResponse `json:"data"`
+	Quantity int           `json:"quantity"`
+}
+
+type SerialUnitResponse struct {
+	ID           uuid.UUID                 `json:"id"`
+	SerialNumber string                    `json:"serial_number"`
+	ProductID    uuid.UUID                 `json:"product_id"`
+	StoreID      uuid.UUID                 `json:"store_id"`
+	Status       string                    `json:"status"`
+	Owner        *ReservationOwnerResponse `json:"owner,omitempty"`
+	CreatedAt    time.Time                 `json:"created_at"`
+	UpdatedAt    time.Time                 `json:"updated_at"`
+}
+
+type ListSerialsResponse struct {
+	Data []SerialUnitResponse `json:"data"`
+}
+
+type MovementReferenceResponse struct {
+	Type string    `json:"type"`
+	ID   uuid.UUID `json:"id"`
+}
+
+type SerialEventResponse struct {
+	Status     string                     `json:"status"`
+	ProductID  uuid.UUID                  `json:"product_id"`
+	StoreID    uuid.UUID                  `json:"store_id"`
+	MovementID *uuid.UUID                 `json:"movement_id,omitempty"`
+	Reason     *string                    `json:"reason,omitempty"`
+	Reference  *MovementReferenceResponse `json:"reference,omitempty"`
+	CreatedAt  time.Time                  `json:"created_at"`
+}
+
+type SerialHistoryResponse struct {
+	SerialUnitResponse
+	History []SerialEventResponse `json:"history"`
+}
+
+// CartHoldRequest aparta stock para un carrito; la reserva vence sola si no se compra.
+type CartHoldRequest struct {
+	CartID   uuid.UUID `json:"cart_id" validate:"required"`
+	Quantity int       `json:"quantity" validate:"required,gt=0"`
+}
+
+type ListReservationsResponse struct {
+	Data             []ReservationResponse `json:"data"`
+	ReservedQuantity int                   `json:"reserved_quantity"`
+}
+
+// PutawayRequest guarda en un bin unidades que todavía no están en ningún bin.
+type PutawayRequest struct {
+	LocationID uuid.UUID `json:"location_id" validate:"required"`
+	Quantity   int       `json:"quantity" validate:"required,gt=0"`
+}
+
+type BinMoveRequest struct {
+	FromLocationID uuid.UUID `json:"from_location_id" validate:"required"`
+	ToLocationID   uuid.UUID `json:"to_location_id" validate:"required,nefield=FromLocationID"`
+	Quantity       int       `json:"quantity" validate:"required,gt=0"`
+}
+
+type BinStockResponse struct {
+	LocationID uuid.UUID `json:"location_id"`
+	Path       string    `json:"path"`
+	Quantity   int       `json:"quantity"`
+}
+
+// BinSummaryResponse es el stock del producto por bin. unplaced son las unidades
+// en stock que todavía no se guardaron en ningún bin.
+type BinSummaryResponse struct {
+	ProductID uuid.UUID          `json:"product_id"`
+	Quantity  int                `json:"quantity"`
+	Placed    int                `json:"placed"`
+	Unplaced  int                `json:"unplaced"`
+	Bins      []BinStockResponse `json:"bins"`
 }
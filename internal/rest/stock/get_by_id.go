@@ -1,7 +1,6 @@
 package stock
 
 import (
-	"motico-api/internal/domain/stock/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/stock/entities"
 	"net/http"
@@ -42,21 +41,20 @@ func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
 
 	stock, err := h.service.GetByProductID(r.Context(), tenantID, productID)
 	if err != nil {
-		if err == entities.ErrStockNotFound {
-			response.Error(w, http.StatusNotFound, "stock not found", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to get stock", nil)
+		response.HandleError(w, r, err, "failed to get stock")
 		return
 	}
 
 	response.JSON(w, http.StatusOK, restentities.StockResponse{
-		ID:                stock.ID,
-		TenantID:          stock.TenantID,
-		ProductID:         stock.ProductID,
-		Quantity:          stock.Quantity,
-		ReservedQuantity:  stock.ReservedQuantity,
-		AvailableQuantity: stock.AvailableQuantity(),
-		UpdatedAt:         stock.UpdatedAt,
+		ID:                  stock.ID,
+		TenantID:            stock.TenantID,
+		ProductID:           stock.ProductID,
+		Quantity:            stock.Quantity,
+		ReservedQuantity:    stock.ReservedQuantity,
+		QuarantinedQuantity: stock.QuarantinedQuantity,
+		AvailableQuantity:   stock.AvailableQuantity(),
+		AverageCost:         stock.AverageCost(),
+		InventoryValue:      stock.InventoryValue,
+		UpdatedAt:           stock.UpdatedAt,
 	})
 }
@@ -3,6 +3,9 @@ package stock
 import (
 	"motico-api/config"
 	"motico-api/internal/domain/stock"
+	"motico-api/internal/domain/stock/entities"
+	restentities "motico-api/internal/rest/stock/entities"
+	"time"
 )
 
 type Handler struct {
@@ -16,3 +19,55 @@ func NewHandler(service *stock.Service, cfg *config.Config) *Handler {
 		config:  cfg,
 	}
 }
+
+func toLotSpec(lotNumber *string, expiresAt *time.Time) *entities.LotSpec {
+	if lotNumber == nil {
+		return nil
+	}
+	return &entities.LotSpec{Number: *lotNumber, ExpiresAt: expiresAt}
+}
+
+func toSerialUnitResponse(unit *entities.SerialUnit) restentities.SerialUnitResponse {
+	resp := restentities.SerialUnitResponse{
+		ID:           unit.ID,
+		SerialNumber: unit.SerialNumber,
+		ProductID:    unit.ProductID,
+		StoreID:      unit.StoreID,
+		Status:       string(unit.Status),
+		CreatedAt:    unit.CreatedAt,
+		UpdatedAt:    unit.UpdatedAt,
+	}
+	if unit.Owner != nil {
+		resp.Owner = &restentities.ReservationOwnerResponse{Type: string(unit.Owner.Type), ID: unit.Owner.ID}
+	}
+	return resp
+}
+
+func toReservationResponse(reservation *entities.Reservation) restentities.ReservationResponse {
+	return restentities.ReservationResponse{
+		ID:        reservation.ID,
+		ProductID: reservation.ProductID,
+		Owner: restentities.ReservationOwnerResponse{
+			Type: string(reservation.Owner.Type),
+			ID:   reservation.Owner.ID,
+		},
+		Quantity:  reservation.Quantity,
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+func toBinSummaryResponse(summary *entities.BinSummary) restentities.BinSummaryResponse {
+	bins := make([]restentities.BinStockResponse, len(summary.Bins))
+	for i, bin := range summary.Bins {
+		bins[i] = restentities.BinStockResponse{LocationID: bin.LocationID, Path: bin.Path, Quantity: bin.Quantity}
+	}
+	return restentities.BinSummaryResponse{
+		ProductID: summary.ProductID,
+		Quantity:  summary.Quantity,
+		Placed:    summary.Placed,
+		Unplaced:  summary.Unplaced,
+		Bins:      bins,
+	}
+}
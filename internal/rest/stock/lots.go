@@ -0,0 +1,69 @@
+package stock
+
+import (
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stock/entities"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListLots
+// @Summary      List product lots
+// @Description  Get the lots in stock of a lot-tracked product in FEFO order (first expiring first), with their reserved and available units
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200         {object}  restentities.ListLotsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /products/{id}/lots [get]
+func (h *Handler) ListLots(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	lots, err := h.service.ListLots(r.Context(), tenantID, productID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list lots")
+		return
+	}
+
+	now := time.Now()
+	quantity := 0
+	responses := make([]restentities.LotResponse, len(lots))
+	for i, lot := range lots {
+		quantity += lot.Quantity
+		responses[i] = restentities.LotResponse{
+			ID:                lot.ID,
+			LotNumber:         lot.LotNumber,
+			ExpiresAt:         lot.ExpiresAt,
+			Expired:           lot.IsExpired(now),
+			Quantity:          lot.Quantity,
+			ReservedQuantity:  lot.ReservedQuantity,
+			AvailableQuantity: lot.AvailableQuantity(),
+			ReceivedAt:        lot.ReceivedAt,
+		}
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListLotsResponse{
+		Data:     responses,
+		Quantity: quantity,
+	})
+}
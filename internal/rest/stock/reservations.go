@@ -0,0 +1,157 @@
+package stock
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stock/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// ListReservations
+// @Summary      List product reservations
+// @Description  Get the active stock reservations of a product and who holds them
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Success      200         {object}  restentities.ListReservationsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /products/{id}/reservations [get]
+func (h *Handler) ListReservations(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	reservations, err := h.service.ListReservations(r.Context(), tenantID, productID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list reservations")
+		return
+	}
+
+	reserved := 0
+	responses := make([]restentities.ReservationResponse, len(reservations))
+	for i, reservation := range reservations {
+		reserved += reservation.Quantity
+		responses[i] = toReservationResponse(reservation)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListReservationsResponse{
+		Data:             responses,
+		ReservedQuantity: reserved,
+	})
+}
+
+// HoldForCart
+// @Summary      Hold stock for a cart
+// @Description  Reserve available units of a product for a shopping cart. The hold expires after the configured cart TTL (reservations.ttl.cart) and is released by the reservation sweeper unless the cart releases it first. Holding again for the same cart adds to the existing hold
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                        true  "Tenant ID"
+// @Param        id           path      string                        true  "Product ID"
+// @Param        request      body      restentities.CartHoldRequest  true  "Cart hold"
+// @Success      201          {object}  restentities.ReservationResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient stock"
+// @Security     BearerAuth
+// @Router       /products/{id}/reservations [post]
+func (h *Handler) HoldForCart(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	var req restentities.CartHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	reservation, err := h.service.HoldForCart(r.Context(), stock.CartHoldRequest{
+		TenantID:  tenantID,
+		ProductID: productID,
+		CartID:    req.CartID,
+		Quantity:  req.Quantity,
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to hold stock")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toReservationResponse(reservation))
+}
+
+// ReleaseCartHold
+// @Summary      Release a cart hold
+// @Description  Release everything a cart holds of a product
+// @Tags         stock
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Product ID"
+// @Param        cartId       path      string  true  "Cart ID"
+// @Success      204
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "The cart holds nothing of this product"
+// @Security     BearerAuth
+// @Router       /products/{id}/reservations/cart/{cartId} [delete]
+func (h *Handler) ReleaseCartHold(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	cartID, err := uuid.Parse(chi.URLParam(r, "cartId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid cart ID", nil)
+		return
+	}
+
+	if err := h.service.ReleaseCartHold(r.Context(), tenantID, productID, cartID); err != nil {
+		response.HandleError(w, r, err, "failed to release hold")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
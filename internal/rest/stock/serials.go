@@ -0,0 +1,123 @@
+package stock
+
+import (
+	"motico-api/internal/domain/stock/entities"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stock/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListSerials
+// @Summary      List product serials
+// @Description  Get the serial-tracked units of a product, optionally filtered by status. Units in stock, reserved or in transit make up the product stock quantity
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true   "Tenant ID"
+// @Param        id           path      string  true   "Product ID"
+// @Param        status       query     string  false  "Filter by status (in_stock, reserved, in_transit, sold, removed)"
+// @Success      200         {object}  restentities.ListSerialsResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /products/{id}/serials [get]
+func (h *Handler) ListSerials(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	productIDStr := chi.URLParam(r, "id")
+	productID, err := uuid.Parse(productIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid product ID", nil)
+		return
+	}
+
+	var status *entities.SerialStatus
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.SerialStatus(statusStr)
+		if !s.IsValid() {
+			response.Error(w, http.StatusBadRequest, "invalid status", nil)
+			return
+		}
+		status = &s
+	}
+
+	units, err := h.service.ListSerials(r.Context(), tenantID, productID, status)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list serials")
+		return
+	}
+
+	responses := make([]restentities.SerialUnitResponse, len(units))
+	for i, unit := range units {
+		responses[i] = toSerialUnitResponse(unit)
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListSerialsResponse{Data: responses})
+}
+
+// GetSerial
+// @Summary      Get serial history
+// @Description  Get a serial-tracked unit with its current status and store, and its full history of status changes and stock movements
+// @Tags         stock
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        serial       path      string  true  "Serial number"
+// @Success      200         {object}  restentities.SerialHistoryResponse
+// @Failure      400         {object}  map[string]interface{}  "Invalid request"
+// @Failure      401         {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404         {object}  map[string]interface{}  "Serial not found"
+// @Security     BearerAuth
+// @Router       /serials/{serial} [get]
+func (h *Handler) GetSerial(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	serial := chi.URLParam(r, "serial")
+	if serial == "" {
+		response.Error(w, http.StatusBadRequest, "invalid serial", nil)
+		return
+	}
+
+	history, err := h.service.GetSerialHistory(r.Context(), tenantID, serial)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get serial")
+		return
+	}
+
+	events := make([]restentities.SerialEventResponse, len(history.Events))
+	for i, event := range history.Events {
+		events[i] = restentities.SerialEventResponse{
+			Status:     string(event.Status),
+			ProductID:  event.ProductID,
+			StoreID:    event.StoreID,
+			MovementID: event.MovementID,
+			CreatedAt:  event.CreatedAt,
+		}
+		if event.Reason != nil {
+			reason := string(*event.Reason)
+			events[i].Reason = &reason
+		}
+		if event.Reference != nil {
+			events[i].Reference = &restentities.MovementReferenceResponse{Type: event.Reference.Type, ID: event.Reference.ID}
+		}
+	}
+
+	response.JSON(w, http.StatusOK, restentities.SerialHistoryResponse{
+		SerialUnitResponse: toSerialUnitResponse(history.Unit),
+		History:            events,
+	})
+}
@@ -3,7 +3,6 @@ package stock
 import (
 	"encoding/json"
 	"motico-api/internal/domain/stock"
-	"motico-api/internal/domain/stock/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/stock/entities"
 	"net/http"
@@ -16,7 +15,7 @@ import (
 
 // Update
 // @Summary      Update stock
-// @Description  Set the stock quantity for a product
+// @Description  Set the stock quantity for a product. Units added are valued at unit_cost or, without it, at the current average cost. Serial-tracked products must list the serials of the units added or removed
 // @Tags         stock
 // @Accept       json
 // @Produce      json
@@ -26,6 +25,7 @@ import (
 // @Success      200          {object}  restentities.StockResponse
 // @Failure      400          {object}  map[string]interface{}  "Invalid request"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Product or store is archived"
 // @Security     BearerAuth
 // @Router       /products/{id}/stock [put]
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
@@ -50,7 +50,7 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
@@ -58,29 +58,27 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 		TenantID:  tenantID,
 		ProductID: productID,
 		Quantity:  req.Quantity,
+		UnitCost:  req.UnitCost,
+		Lot:       toLotSpec(req.LotNumber, req.ExpiresAt),
+		Serials:   req.Serials,
 	}
 
 	stock, err := h.service.Update(r.Context(), updateReq)
 	if err != nil {
-		if err == entities.ErrInvalidQuantity {
-			response.Error(w, http.StatusBadRequest, "invalid quantity", nil)
-			return
-		}
-		if err == entities.ErrInvalidReservedAmount {
-			response.Error(w, http.StatusBadRequest, "reserved quantity cannot exceed total quantity", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to update stock", nil)
+		response.HandleError(w, r, err, "failed to update stock")
 		return
 	}
 
 	response.JSON(w, http.StatusOK, restentities.StockResponse{
-		ID:                stock.ID,
-		TenantID:          stock.TenantID,
-		ProductID:         stock.ProductID,
-		Quantity:          stock.Quantity,
-		ReservedQuantity:  stock.ReservedQuantity,
-		AvailableQuantity: stock.AvailableQuantity(),
-		UpdatedAt:         stock.UpdatedAt,
+		ID:                  stock.ID,
+		TenantID:            stock.TenantID,
+		ProductID:           stock.ProductID,
+		Quantity:            stock.Quantity,
+		ReservedQuantity:    stock.ReservedQuantity,
+		QuarantinedQuantity: stock.QuarantinedQuantity,
+		AvailableQuantity:   stock.AvailableQuantity(),
+		AverageCost:         stock.AverageCost(),
+		InventoryValue:      stock.InventoryValue,
+		UpdatedAt:           stock.UpdatedAt,
 	})
 }
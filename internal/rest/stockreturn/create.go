@@ -0,0 +1,79 @@
+package stockreturn
+
+import (
+	"encoding/json"
+	stockentities "motico-api/internal/domain/stock/entities"
+	"motico-api/internal/domain/stockreturn"
+	"motico-api/internal/domain/stockreturn/entities"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stockreturn/entities"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create return
+// @Description  Record a customer return, a return to supplier or a write-off. Customer returns put the units back in stock (at the unit_cost given, the cost of the linked sale or the current average cost). With quarantine the units stay out of the available stock until resolved; otherwise they are restocked, shipped to the supplier or scrapped right away. Serial-tracked products list one serial per unit returned
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                            true  "Tenant ID"
+// @Param        request      body      restentities.CreateReturnRequest  true  "Return data"
+// @Success      201          {object}  restentities.ReturnResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient available stock, serial not available, or product or store archived"
+// @Failure      422          {object}  map[string]interface{}  "Unknown store, supplier or sales order, or product not listed in the store"
+// @Security     BearerAuth
+// @Router       /returns [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreateReturnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	lines := make([]stockreturn.LineRequest, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = stockreturn.LineRequest{ProductID: line.ProductID, Quantity: line.Quantity, UnitCost: line.UnitCost, Serials: line.Serials}
+		if line.LotNumber != nil {
+			lines[i].Lot = &stockentities.LotSpec{Number: *line.LotNumber, ExpiresAt: line.ExpiresAt}
+		}
+	}
+
+	createReq := stockreturn.CreateRequest{
+		TenantID:     tenantID,
+		StoreID:      req.StoreID,
+		Type:         entities.ReturnType(req.Type),
+		Reason:       entities.ReturnReason(req.Reason),
+		Quarantine:   req.Quarantine,
+		SalesOrderID: req.SalesOrderID,
+		SupplierID:   req.SupplierID,
+		Lines:        lines,
+		Notes:        req.Notes,
+		CreatedBy:    context.GetUserID(r.Context()),
+	}
+
+	ret, err := h.service.Create(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create return")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toReturnResponse(ret))
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"motico-api/pkg/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReturnLineRequest struct {
+	ProductID uuid.UUID     `json:"product_id" validate:"required"`
+	Quantity  int           `json:"quantity" validate:"required,gt=0"`
+	UnitCost  *money.Amount `json:"unit_cost,omitempty"`
+	LotNumber *string       `json:"lot_number,omitempty" validate:"omitempty,max=100"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Serials   []string      `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
+}
+
+// CreateReturnRequest registra una devolución. Con quarantine=true las unidades
+// quedan fuera del stock disponible hasta resolverse; si no, se reingresan
+// (customer_return), se envían al proveedor (supplier_return) o se descartan (write_off).
+type CreateReturnRequest struct {
+	StoreID      uuid.UUID           `json:"store_id" validate:"required"`
+	Type         string              `json:"type" validate:"required,oneof=customer_return supplier_return write_off"`
+	Reason       string              `json:"reason" validate:"required,oneof=damaged defective wrong_item unwanted expired lost other"`
+	Quarantine   bool                `json:"quarantine,omitempty"`
+	SalesOrderID *uuid.UUID          `json:"sales_order_id,omitempty"`
+	SupplierID   *uuid.UUID          `json:"supplier_id,omitempty"`
+	Lines        []ReturnLineRequest `json:"lines" validate:"required,min=1,dive"`
+	Notes        *string             `json:"notes,omitempty"`
+}
+
+type ReturnDecisionRequest struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Decision  string    `json:"decision" validate:"required,oneof=restock scrap ship_to_supplier"`
+	Quantity  int       `json:"quantity" validate:"required,gt=0"`
+}
+
+type ResolveReturnRequest struct {
+	Decisions []ReturnDecisionRequest `json:"decisions" validate:"required,min=1,dive"`
+}
+
+type ReturnLineResponse struct {
+	ID                uuid.UUID     `json:"id"`
+	ProductID         uuid.UUID     `json:"product_id"`
+	Quantity          int           `json:"quantity"`
+	UnitCost          *money.Amount `json:"unit_cost,omitempty"`
+	LotNumber         *string       `json:"lot_number,omitempty"`
+	LotExpiresAt      *time.Time    `json:"lot_expires_at,omitempty"`
+	Serials           []string      `json:"serials,omitempty"`
+	RestockedQuantity int           `json:"restocked_quantity"`
+	ScrappedQuantity  int           `json:"scrapped_quantity"`
+	ShippedQuantity   int           `json:"shipped_quantity"`
+	PendingQuantity   int           `json:"pending_quantity"`
+}
+
+type ReturnResponse struct {
+	ID           uuid.UUID            `json:"id"`
+	TenantID     uuid.UUID            `json:"tenant_id"`
+	StoreID      uuid.UUID            `json:"store_id"`
+	Type         string               `json:"type"`
+	Reason       string               `json:"reason"`
+	Status       string               `json:"status"`
+	SalesOrderID *uuid.UUID           `json:"sales_order_id,omitempty"`
+	SupplierID   *uuid.UUID           `json:"supplier_id,omitempty"`
+	Notes        *string              `json:"notes,omitempty"`
+	Lines        []ReturnLineResponse `json:"lines"`
+	CreatedBy    *string              `json:"created_by,omitempty"`
+	CompletedAt  *time.Time           `json:"completed_at,omitempty"`
+	CompletedBy  *string              `json:"completed_by,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+type ListReturnsResponse struct {
+	Data       []ReturnResponse `json:"data"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
@@ -0,0 +1,58 @@
+package stockreturn
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/stockreturn"
+	"motico-api/internal/domain/stockreturn/entities"
+	restentities "motico-api/internal/rest/stockreturn/entities"
+)
+
+type Handler struct {
+	service *stockreturn.Service
+	config  *config.Config
+}
+
+func NewHandler(service *stockreturn.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toReturnResponse(ret *entities.StockReturn) restentities.ReturnResponse {
+	lines := make([]restentities.ReturnLineResponse, len(ret.Lines))
+	for i := range ret.Lines {
+		line := &ret.Lines[i]
+		lines[i] = restentities.ReturnLineResponse{
+			ID:                line.ID,
+			ProductID:         line.ProductID,
+			Quantity:          line.Quantity,
+			UnitCost:          line.UnitCost,
+			LotNumber:         line.LotNumber,
+			LotExpiresAt:      line.LotExpiresAt,
+			Serials:           line.Serials,
+			RestockedQuantity: line.RestockedQuantity,
+			ScrappedQuantity:  line.ScrappedQuantity,
+			ShippedQuantity:   line.ShippedQuantity,
+			PendingQuantity:   line.PendingQuantity(),
+		}
+	}
+
+	return restentities.ReturnResponse{
+		ID:           ret.ID,
+		TenantID:     ret.TenantID,
+		StoreID:      ret.StoreID,
+		Type:         string(ret.Type),
+		Reason:       string(ret.Reason),
+		Status:       string(ret.Status),
+		SalesOrderID: ret.SalesOrderID,
+		SupplierID:   ret.SupplierID,
+		Notes:        ret.Notes,
+		Lines:        lines,
+		CreatedBy:    ret.CreatedBy,
+		CompletedAt:  ret.CompletedAt,
+		CompletedBy:  ret.CompletedBy,
+		CreatedAt:    ret.CreatedAt,
+		UpdatedAt:    ret.UpdatedAt,
+	}
+}
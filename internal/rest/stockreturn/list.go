@@ -0,0 +1,113 @@
+package stockreturn
+
+import (
+	"motico-api/internal/domain/stockreturn"
+	"motico-api/internal/domain/stockreturn/entities"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stockreturn/entities"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/query"
+)
+
+// List
+// @Summary      List returns
+// @Description  Get paginated list of customer returns, supplier returns and write-offs for the tenant
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        type         query     string  false "Filter by type (customer_return, supplier_return, write_off)"
+// @Param        reason       query     string  false "Filter by reason (damaged, defective, wrong_item, unwanted, expired, lost, other)"
+// @Param        status       query     string  false "Filter by status (quarantined, completed)"
+// @Param        store_id     query     string  false "Filter by store ID"
+// @Param        sort         query     string  false "Sort fields (type, reason, status, created_at, updated_at, completed_at), prefix with - for descending"
+// @Param        created_after   query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before  query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since   query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Success      200          {object}  restentities.ListReturnsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /returns [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var filter stockreturn.ListFilter
+	if typeStr := r.URL.Query().Get("type"); typeStr != "" {
+		t := entities.ReturnType(typeStr)
+		if t.IsValid() {
+			filter.Type = &t
+		}
+	}
+
+	if reasonStr := r.URL.Query().Get("reason"); reasonStr != "" {
+		reason := entities.ReturnReason(reasonStr)
+		if reason.IsValid() {
+			filter.Reason = &reason
+		}
+	}
+
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.ReturnStatus(statusStr)
+		if s.IsValid() {
+			filter.Status = &s
+		}
+	}
+
+	if storeIDStr := r.URL.Query().Get("store_id"); storeIDStr != "" {
+		id, err := uuid.Parse(storeIDStr)
+		if err == nil {
+			filter.StoreID = &id
+		}
+	}
+
+	filter.Query = query.Parse(r.URL.Query(), "page", "limit", "type", "reason", "status", "store_id")
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	returns, err := h.service.List(r.Context(), tenantID, filter, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list returns")
+		return
+	}
+
+	responses := make([]restentities.ReturnResponse, len(returns))
+	for i, ret := range returns {
+		responses[i] = toReturnResponse(ret)
+	}
+
+	total := len(returns)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListReturnsResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
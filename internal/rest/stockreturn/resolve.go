@@ -0,0 +1,80 @@
+package stockreturn
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/stockreturn"
+	"motico-api/internal/domain/stockreturn/entities"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/stockreturn/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Resolve
+// @Summary      Resolve quarantined return
+// @Description  Record inspection decisions for quarantined units: restock makes them available again, scrap removes them from stock and ship_to_supplier (supplier returns only) sends them back. Serial-tracked units are decided in the order their serials were returned. The return is completed when no units remain in quarantine
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                             true  "Tenant ID"
+// @Param        id           path      string                             true  "Return ID"
+// @Param        request      body      restentities.ResolveReturnRequest  true  "Decisions"
+// @Success      200          {object}  restentities.ReturnResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request or decision"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Return not found"
+// @Failure      409          {object}  map[string]interface{}  "Return has no units in quarantine or serial not available"
+// @Security     BearerAuth
+// @Router       /returns/{id}/resolve [patch]
+func (h *Handler) Resolve(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid return ID", nil)
+		return
+	}
+
+	var req restentities.ResolveReturnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	decisions := make([]stockreturn.DecisionRequest, len(req.Decisions))
+	for i, decision := range req.Decisions {
+		decisions[i] = stockreturn.DecisionRequest{
+			ProductID: decision.ProductID,
+			Decision:  entities.Decision(decision.Decision),
+			Quantity:  decision.Quantity,
+		}
+	}
+
+	ret, err := h.service.Resolve(r.Context(), stockreturn.ResolveRequest{
+		ID:        id,
+		TenantID:  tenantID,
+		Actor:     context.GetUserID(r.Context()),
+		Decisions: decisions,
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to resolve return")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toReturnResponse(ret))
+}
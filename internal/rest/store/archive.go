@@ -0,0 +1,90 @@
+package store
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Archive
+// @Summary      Archive store
+// @Description  Retire a store together with its active products. Archived stores are hidden from default listings and accept no transfers or stock adjustments. The store must have no stock and no open transfers; close it to move the remaining stock first. Managers only
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Store ID"
+// @Success      200          {object}  restentities.StoreResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the manager role"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Failure      409          {object}  map[string]interface{}  "Store already archived, has stock or has open transfers"
+// @Security     BearerAuth
+// @Router       /stores/{id}/archive [post]
+func (h *Handler) Archive(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	store, err := h.service.Archive(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to archive store")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toStoreResponse(store))
+}
+
+// Unarchive
+// @Summary      Unarchive store
+// @Description  Reactivate an archived store together with the products archived with it. Products archived on their own stay archived. Managers only
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Store ID"
+// @Success      200          {object}  restentities.StoreResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the manager role"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Failure      409          {object}  map[string]interface{}  "Store is not archived"
+// @Security     BearerAuth
+// @Router       /stores/{id}/unarchive [post]
+func (h *Handler) Unarchive(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	store, err := h.service.Unarchive(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to unarchive store")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toStoreResponse(store))
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/transfer"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/store/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Close
+// @Summary      Close store
+// @Description  Close a store. A store without stock is archived right away. Otherwise a transfer with all its units (and serials) to to_store_id is created and the store becomes closing: it can't sell or take part in other transfers until the transfer is received, which archives it. Cancelling or deleting the transfer reopens the store. Reserved or quarantined stock must be released first. Managers only
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                          true  "Tenant ID"
+// @Param        id           path      string                          true  "Store ID"
+// @Param        request      body      restentities.CloseStoreRequest  true  "Closure data"
+// @Success      200          {object}  restentities.CloseStoreResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the manager role"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Failure      409          {object}  map[string]interface{}  "Store already closing or archived, has held stock or has open transfers"
+// @Failure      422          {object}  map[string]interface{}  "Target store not found, closing or archived, or a product is not listed in it"
+// @Security     BearerAuth
+// @Router       /stores/{id}/close [post]
+func (h *Handler) Close(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	var req restentities.CloseStoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	store, evacuation, err := h.transferService.CloseStore(r.Context(), transfer.CloseStoreRequest{
+		TenantID:    tenantID,
+		StoreID:     id,
+		ToStoreID:   req.ToStoreID,
+		Notes:       req.Notes,
+		RequestedBy: context.GetUserID(r.Context()),
+	})
+	if err != nil {
+		response.HandleError(w, r, err, "failed to close store")
+		return
+	}
+
+	resp := restentities.CloseStoreResponse{Store: toStoreResponse(store)}
+	if evacuation != nil {
+		resp.TransferID = &evacuation.ID
+	}
+	response.JSON(w, http.StatusOK, resp)
+}
@@ -3,7 +3,6 @@ package store
 import (
 	"encoding/json"
 	"motico-api/internal/domain/store"
-	"motico-api/internal/domain/store/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/store/entities"
 	"net/http"
@@ -42,7 +41,7 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
@@ -54,20 +53,9 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	store, err := h.service.Create(r.Context(), createReq)
 	if err != nil {
-		if err == entities.ErrStoreNameExists {
-			response.Error(w, http.StatusConflict, "store name already exists", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to create store", nil)
+		response.HandleError(w, r, err, "failed to create store")
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, restentities.StoreResponse{
-		ID:        store.ID,
-		TenantID:  store.TenantID,
-		Name:      store.Name,
-		Address:   store.Address,
-		CreatedAt: store.CreatedAt,
-		UpdatedAt: store.UpdatedAt,
-	})
+	response.JSON(w, http.StatusCreated, toStoreResponse(store))
 }
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateLocationRequest agrega una ubicación a la sucursal. Las zonas no llevan
+// parent_id, los pasillos cuelgan de una zona y los bins de un pasillo. code forma
+// el path de la ubicación (por ejemplo A-03-12) y no se puede cambiar.
+type CreateLocationRequest struct {
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	Type     string     `json:"type" validate:"required,oneof=zone aisle bin"`
+	Code     string     `json:"code" validate:"required,alphanum,max=20"`
+	Name     *string    `json:"name,omitempty" validate:"omitempty,max=255"`
+}
+
+type UpdateLocationRequest struct {
+	Name *string `json:"name,omitempty" validate:"omitempty,max=255"`
+}
+
+type LocationResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	StoreID   uuid.UUID  `json:"store_id"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	Type      string     `json:"type"`
+	Code      string     `json:"code"`
+	Name      *string    `json:"name,omitempty"`
+	Path      string     `json:"path"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// LocationNodeResponse es una ubicación con las ubicaciones que cuelgan de ella.
+type LocationNodeResponse struct {
+	LocationResponse
+	Children []LocationNodeResponse `json:"children"`
+}
+
+type ListLocationsResponse struct {
+	Data []LocationNodeResponse `json:"data"`
+}
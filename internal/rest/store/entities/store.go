@@ -22,12 +22,31 @@ type PartialUpdateStoreRequest struct {
 }
 
 type StoreResponse struct {
-	ID        uuid.UUID `json:"id"`
-	TenantID  uuid.UUID `json:"tenant_id"`
-	Name      string    `json:"name"`
-	Address   *string   `json:"address,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         uuid.UUID  `json:"id"`
+	TenantID   uuid.UUID  `json:"tenant_id"`
+	Name       string     `json:"name"`
+	Address    *string    `json:"address,omitempty"`
+	Status     string     `json:"status"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// ClosingTransferID es el traspaso de evacuación mientras la sucursal está en cierre
+	ClosingTransferID *uuid.UUID `json:"closing_transfer_id,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CloseStoreRequest indica a qué sucursal se traspasa el stock restante.
+type CloseStoreRequest struct {
+	ToStoreID uuid.UUID `json:"to_store_id" validate:"required"`
+	Notes     *string   `json:"notes,omitempty"`
+}
+
+// CloseStoreResponse es el resultado del cierre: si quedaba stock, TransferID es
+// el traspaso que lo lleva a la otra sucursal y la sucursal queda en cierre hasta
+// que se recibe; si no, la sucursal ya queda archivada.
+type CloseStoreResponse struct {
+	Store      StoreResponse `json:"store"`
+	TransferID *uuid.UUID    `json:"transfer_id,omitempty"`
 }
 
 type ListStoresResponse struct {
@@ -1,9 +1,7 @@
 package store
 
 import (
-	"motico-api/internal/domain/store/entities"
 	"motico-api/internal/rest/response"
-	restentities "motico-api/internal/rest/store/entities"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -42,20 +40,9 @@ func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
 
 	store, err := h.service.GetByID(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrStoreNotFound {
-			response.Error(w, http.StatusNotFound, "store not found", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to get store", nil)
+		response.HandleError(w, r, err, "failed to get store")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.StoreResponse{
-		ID:        store.ID,
-		TenantID:  store.TenantID,
-		Name:      store.Name,
-		Address:   store.Address,
-		CreatedAt: store.CreatedAt,
-		UpdatedAt: store.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toStoreResponse(store))
 }
@@ -3,16 +3,61 @@ package store
 import (
 	"motico-api/config"
 	"motico-api/internal/domain/store"
+	"motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transfer"
+	restentities "motico-api/internal/rest/store/entities"
 )
 
 type Handler struct {
-	service *store.Service
-	config  *config.Config
+	service         *store.Service
+	transferService *transfer.Service
+	config          *config.Config
 }
 
-func NewHandler(service *store.Service, cfg *config.Config) *Handler {
+func NewHandler(service *store.Service, transferService *transfer.Service, cfg *config.Config) *Handler {
 	return &Handler{
-		service: service,
-		config:  cfg,
+		service:         service,
+		transferService: transferService,
+		config:          cfg,
 	}
 }
+
+func toStoreResponse(store *entities.Store) restentities.StoreResponse {
+	return restentities.StoreResponse{
+		ID:                store.ID,
+		TenantID:          store.TenantID,
+		Name:              store.Name,
+		Address:           store.Address,
+		Status:            string(store.Status),
+		ArchivedAt:        store.ArchivedAt,
+		ClosingTransferID: store.ClosingTransferID,
+		CreatedAt:         store.CreatedAt,
+		UpdatedAt:         store.UpdatedAt,
+		DeletedAt:         store.DeletedAt,
+	}
+}
+
+func toLocationResponse(location *entities.Location) restentities.LocationResponse {
+	return restentities.LocationResponse{
+		ID:        location.ID,
+		StoreID:   location.StoreID,
+		ParentID:  location.ParentID,
+		Type:      string(location.Type),
+		Code:      location.Code,
+		Name:      location.Name,
+		Path:      location.Path,
+		CreatedAt: location.CreatedAt,
+		UpdatedAt: location.UpdatedAt,
+	}
+}
+
+func toLocationNodeResponses(nodes []*entities.LocationNode) []restentities.LocationNodeResponse {
+	responses := make([]restentities.LocationNodeResponse, len(nodes))
+	for i, node := range nodes {
+		responses[i] = restentities.LocationNodeResponse{
+			LocationResponse: toLocationResponse(node.Location),
+			Children:         toLocationNodeResponses(node.Children),
+		}
+	}
+	return responses
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"motico-api/internal/domain/store/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/store/entities"
 	"net/http"
@@ -8,20 +9,29 @@ import (
 
 	"github.com/google/uuid"
 	"motico-api/pkg/context"
+	"motico-api/pkg/query"
 )
 
 // List
 // @Summary      List stores
-// @Description  Get paginated list of stores for the tenant
+// @Description  Get paginated list of stores for the tenant. Archived stores are hidden unless requested by status
 // @Tags         stores
 // @Accept       json
 // @Produce      json
 // @Param        X-Tenant-ID  header    string  true  "Tenant ID"
 // @Param        page         query     int     false "Page number" default(1)
 // @Param        limit        query     int     false "Items per page" default(20)
+// @Param        status       query     string  false "Filter by status (active, closing, archived)" default(active)
+// @Param        sort         query     string  false "Sort fields (name, created_at, updated_at), prefix with - for descending"
+// @Param        name_contains   query  string  false "Filter by name substring"
+// @Param        created_after   query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before  query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since   query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        include_deleted query  bool    false "Include soft-deleted stores (admins only)"
 // @Success      200          {object}  restentities.ListStoresResponse
-// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID"
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "include_deleted requires the admin role"
 // @Security     BearerAuth
 // @Router       /stores [get]
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
@@ -32,6 +42,16 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	status := entities.StoreStatusActive
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		s := entities.StoreStatus(statusStr)
+		if s.IsValid() {
+			status = s
+		}
+	}
+
+	params := query.Parse(r.URL.Query(), "page", "limit", "status")
+
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	if page < 1 {
 		page = 1
@@ -42,22 +62,15 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	}
 	offset := (page - 1) * limit
 
-	stores, err := h.service.List(r.Context(), tenantID, limit, offset)
+	stores, err := h.service.List(r.Context(), tenantID, &status, params, limit, offset)
 	if err != nil {
-		response.Error(w, http.StatusInternalServerError, "failed to list stores", nil)
+		response.HandleError(w, r, err, "failed to list stores")
 		return
 	}
 
 	responses := make([]restentities.StoreResponse, len(stores))
 	for i, s := range stores {
-		responses[i] = restentities.StoreResponse{
-			ID:        s.ID,
-			TenantID:  s.TenantID,
-			Name:      s.Name,
-			Address:   s.Address,
-			CreatedAt: s.CreatedAt,
-			UpdatedAt: s.UpdatedAt,
-		}
+		responses[i] = toStoreResponse(s)
 	}
 
 	total := len(stores)
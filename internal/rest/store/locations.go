@@ -0,0 +1,272 @@
+package store
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/store"
+	"motico-api/internal/domain/store/entities"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/store/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// ListLocations
+// @Summary      List store locations
+// @Description  Get the location tree of a store: zones, their aisles and the bins in each aisle, ordered by path
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Store ID"
+// @Success      200          {object}  restentities.ListLocationsResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Security     BearerAuth
+// @Router       /stores/{id}/locations [get]
+func (h *Handler) ListLocations(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	storeIDStr := chi.URLParam(r, "id")
+	storeID, err := uuid.Parse(storeIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	nodes, err := h.service.ListLocations(r.Context(), tenantID, storeID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list locations")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListLocationsResponse{Data: toLocationNodeResponses(nodes)})
+}
+
+// GetLocation
+// @Summary      Get store location
+// @Description  Get a zone, aisle or bin of a store
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Store ID"
+// @Param        locationId   path      string  true  "Location ID"
+// @Success      200          {object}  restentities.LocationResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Location not found"
+// @Security     BearerAuth
+// @Router       /stores/{id}/locations/{locationId} [get]
+func (h *Handler) GetLocation(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	storeIDStr := chi.URLParam(r, "id")
+	storeID, err := uuid.Parse(storeIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	locationIDStr := chi.URLParam(r, "locationId")
+	locationID, err := uuid.Parse(locationIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid location ID", nil)
+		return
+	}
+
+	location, err := h.service.GetLocation(r.Context(), tenantID, storeID, locationID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get location")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toLocationResponse(location))
+}
+
+// CreateLocation
+// @Summary      Create store location
+// @Description  Add a zone, an aisle (under a zone) or a bin (under an aisle) to a store. The code is appended to the parent path (for example A-03-12) and cannot be changed later
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                              true  "Tenant ID"
+// @Param        id           path      string                              true  "Store ID"
+// @Param        request      body      restentities.CreateLocationRequest  true  "Location data"
+// @Success      201          {object}  restentities.LocationResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Store not found"
+// @Failure      409          {object}  map[string]interface{}  "Location code already exists under the same parent"
+// @Failure      422          {object}  map[string]interface{}  "Parent does not match the location type"
+// @Security     BearerAuth
+// @Router       /stores/{id}/locations [post]
+func (h *Handler) CreateLocation(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	storeIDStr := chi.URLParam(r, "id")
+	storeID, err := uuid.Parse(storeIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	var req restentities.CreateLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	createReq := store.CreateLocationRequest{
+		TenantID: tenantID,
+		StoreID:  storeID,
+		ParentID: req.ParentID,
+		Type:     entities.LocationType(req.Type),
+		Code:     req.Code,
+		Name:     req.Name,
+	}
+
+	location, err := h.service.CreateLocation(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create location")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toLocationResponse(location))
+}
+
+// UpdateLocation
+// @Summary      Update store location
+// @Description  Rename a zone, aisle or bin. Type, parent and code cannot be changed
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                              true  "Tenant ID"
+// @Param        id           path      string                              true  "Store ID"
+// @Param        locationId   path      string                              true  "Location ID"
+// @Param        request      body      restentities.UpdateLocationRequest  true  "Location data"
+// @Success      200          {object}  restentities.LocationResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Location not found"
+// @Security     BearerAuth
+// @Router       /stores/{id}/locations/{locationId} [patch]
+func (h *Handler) UpdateLocation(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	storeIDStr := chi.URLParam(r, "id")
+	storeID, err := uuid.Parse(storeIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	locationIDStr := chi.URLParam(r, "locationId")
+	locationID, err := uuid.Parse(locationIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid location ID", nil)
+		return
+	}
+
+	var req restentities.UpdateLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	updateReq := store.UpdateLocationRequest{
+		ID:       locationID,
+		TenantID: tenantID,
+		StoreID:  storeID,
+		Name:     req.Name,
+	}
+
+	location, err := h.service.UpdateLocation(r.Context(), updateReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to update location")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toLocationResponse(location))
+}
+
+// RemoveLocation
+// @Summary      Delete store location
+// @Description  Delete a zone, aisle or bin that has no child locations and no stock
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Store ID"
+// @Param        locationId   path      string  true  "Location ID"
+// @Success      204          "No Content"
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Location not found"
+// @Failure      409          {object}  map[string]interface{}  "Location has child locations or stock"
+// @Security     BearerAuth
+// @Router       /stores/{id}/locations/{locationId} [delete]
+func (h *Handler) RemoveLocation(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	storeIDStr := chi.URLParam(r, "id")
+	storeID, err := uuid.Parse(storeIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	locationIDStr := chi.URLParam(r, "locationId")
+	locationID, err := uuid.Parse(locationIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid location ID", nil)
+		return
+	}
+
+	err = h.service.DeleteLocation(r.Context(), tenantID, storeID, locationID)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to delete location")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -3,7 +3,6 @@ package store
 import (
 	"encoding/json"
 	"motico-api/internal/domain/store"
-	"motico-api/internal/domain/store/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/store/entities"
 	"net/http"
@@ -52,7 +51,7 @@ func (h *Handler) ParcialUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
@@ -65,24 +64,9 @@ func (h *Handler) ParcialUpdate(w http.ResponseWriter, r *http.Request) {
 
 	store, err := h.service.Update(r.Context(), updateReq)
 	if err != nil {
-		if err == entities.ErrStoreNotFound {
-			response.Error(w, http.StatusNotFound, "store not found", nil)
-			return
-		}
-		if err == entities.ErrStoreNameExists {
-			response.Error(w, http.StatusConflict, "store name already exists", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to update store", nil)
+		response.HandleError(w, r, err, "failed to update store")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.StoreResponse{
-		ID:        store.ID,
-		TenantID:  store.TenantID,
-		Name:      store.Name,
-		Address:   store.Address,
-		CreatedAt: store.CreatedAt,
-		UpdatedAt: store.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toStoreResponse(store))
 }
@@ -1,7 +1,6 @@
 package store
 
 import (
-	"motico-api/internal/domain/store/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
 
@@ -12,7 +11,7 @@ import (
 
 // Remove
 // @Summary      Delete store
-// @Description  Delete a store by ID
+// @Description  Delete a store by ID. A store with products cannot be deleted; archive or close it instead
 // @Tags         stores
 // @Accept       json
 // @Produce      json
@@ -42,15 +41,7 @@ func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.Delete(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrStoreNotFound {
-			response.Error(w, http.StatusNotFound, "store not found", nil)
-			return
-		}
-		if err == entities.ErrStoreHasProducts {
-			response.Error(w, http.StatusConflict, "store has associated products and cannot be deleted", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to delete store", nil)
+		response.HandleError(w, r, err, "failed to delete store")
 		return
 	}
 
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Restore
+// @Summary      Restore store
+// @Description  Restore a soft-deleted store. Fails if another store took its name in the meantime. Admins only
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Store ID"
+// @Success      200          {object}  restentities.StoreResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the admin role"
+// @Failure      404          {object}  map[string]interface{}  "Deleted store not found"
+// @Failure      409          {object}  map[string]interface{}  "Store name already exists"
+// @Security     BearerAuth
+// @Router       /stores/{id}/restore [post]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid store ID", nil)
+		return
+	}
+
+	store, err := h.service.Restore(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to restore store")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toStoreResponse(store))
+}
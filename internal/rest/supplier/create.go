@@ -0,0 +1,64 @@
+package supplier
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/supplier"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/supplier/entities"
+	"net/http"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Create
+// @Summary      Create supplier
+// @Description  Create a new supplier for the tenant
+// @Tags         suppliers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                              true  "Tenant ID"
+// @Param        request      body      restentities.CreateSupplierRequest  true  "Supplier data"
+// @Success      201          {object}  restentities.SupplierResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      409          {object}  map[string]interface{}  "Supplier name already exists"
+// @Security     BearerAuth
+// @Router       /suppliers [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	var req restentities.CreateSupplierRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	createReq := supplier.CreateRequest{
+		TenantID:    tenantID,
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+	}
+
+	supplier, err := h.service.Create(r.Context(), createReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to create supplier")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, toSupplierResponse(supplier))
+}
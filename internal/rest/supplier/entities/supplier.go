@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateSupplierRequest struct {
+	Name        string  `json:"name" validate:"required,max=255"`
+	ContactName *string `json:"contact_name,omitempty" validate:"omitempty,max=255"`
+	Email       *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Phone       *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+	Address     *string `json:"address,omitempty"`
+}
+
+type UpdateSupplierRequest struct {
+	Name        string  `json:"name" validate:"required,max=255"`
+	ContactName *string `json:"contact_name,omitempty" validate:"omitempty,max=255"`
+	Email       *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Phone       *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+	Address     *string `json:"address,omitempty"`
+}
+
+type PartialUpdateSupplierRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,max=255"`
+	ContactName *string `json:"contact_name,omitempty" validate:"omitempty,max=255"`
+	Email       *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Phone       *string `json:"phone,omitempty" validate:"omitempty,max=50"`
+	Address     *string `json:"address,omitempty"`
+}
+
+type SupplierResponse struct {
+	ID          uuid.UUID `json:"id"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	Name        string    `json:"name"`
+	ContactName *string   `json:"contact_name,omitempty"`
+	Email       *string   `json:"email,omitempty"`
+	Phone       *string   `json:"phone,omitempty"`
+	Address     *string   `json:"address,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListSuppliersResponse struct {
+	Data       []SupplierResponse `json:"data"`
+	Pagination PaginationInfo     `json:"pagination"`
+}
+
+type PaginationInfo struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
@@ -0,0 +1,34 @@
+package supplier
+
+import (
+	"motico-api/config"
+	"motico-api/internal/domain/supplier"
+	"motico-api/internal/domain/supplier/entities"
+	restentities "motico-api/internal/rest/supplier/entities"
+)
+
+type Handler struct {
+	service *supplier.Service
+	config  *config.Config
+}
+
+func NewHandler(service *supplier.Service, cfg *config.Config) *Handler {
+	return &Handler{
+		service: service,
+		config:  cfg,
+	}
+}
+
+func toSupplierResponse(s *entities.Supplier) restentities.SupplierResponse {
+	return restentities.SupplierResponse{
+		ID:          s.ID,
+		TenantID:    s.TenantID,
+		Name:        s.Name,
+		ContactName: s.ContactName,
+		Email:       s.Email,
+		Phone:       s.Phone,
+		Address:     s.Address,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
@@ -0,0 +1,80 @@
+package supplier
+
+import (
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/supplier/entities"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/query"
+)
+
+// List
+// @Summary      List suppliers
+// @Description  Get paginated list of suppliers for the tenant
+// @Tags         suppliers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        page         query     int     false "Page number" default(1)
+// @Param        limit        query     int     false "Items per page" default(20)
+// @Param        sort         query     string  false "Sort fields (name, created_at, updated_at), prefix with - for descending"
+// @Param        name_contains   query  string  false "Filter by name substring"
+// @Param        email_contains  query  string  false "Filter by email substring"
+// @Param        created_after   query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before  query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since   query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Success      200          {object}  restentities.ListSuppliersResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Security     BearerAuth
+// @Router       /suppliers [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	params := query.Parse(r.URL.Query(), "page", "limit")
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = h.config.Pagination.DefaultLimit
+	}
+	offset := (page - 1) * limit
+
+	suppliers, err := h.service.List(r.Context(), tenantID, params, limit, offset)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list suppliers")
+		return
+	}
+
+	responses := make([]restentities.SupplierResponse, len(suppliers))
+	for i, s := range suppliers {
+		responses[i] = toSupplierResponse(s)
+	}
+
+	total := len(suppliers)
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListSuppliersResponse{
+		Data: responses,
+		Pagination: restentities.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	})
+}
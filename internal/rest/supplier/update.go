@@ -0,0 +1,75 @@
+package supplier
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/supplier"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/supplier/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Update
+// @Summary      Update supplier
+// @Description  Update an existing supplier (full update)
+// @Tags         suppliers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Supplier ID"
+// @Param        request      body      restentities.UpdateSupplierRequest  true  "Supplier data"
+// @Success      200          {object}  restentities.SupplierResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Supplier not found"
+// @Failure      409          {object}  map[string]interface{}  "Supplier name already exists"
+// @Security     BearerAuth
+// @Router       /suppliers/{id} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid supplier ID", nil)
+		return
+	}
+
+	var req restentities.UpdateSupplierRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	updateReq := supplier.UpdateRequest{
+		ID:          id,
+		TenantID:    tenantID,
+		Name:        &req.Name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+	}
+
+	supplier, err := h.service.Update(r.Context(), updateReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to update supplier")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toSupplierResponse(supplier))
+}
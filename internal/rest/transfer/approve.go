@@ -0,0 +1,56 @@
+package transfer
+
+import (
+	"motico-api/internal/domain/transfer"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Approve
+// @Summary      Approve transfer
+// @Description  Approve a requested transfer so it can be dispatched
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Transfer ID"
+// @Success      200          {object}  restentities.TransferResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
+// @Security     BearerAuth
+// @Router       /transfers/{id}/approve [patch]
+func (h *Handler) Approve(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid transfer ID", nil)
+		return
+	}
+
+	actionReq := transfer.ActionRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+	}
+
+	transfer, err := h.service.Approve(r.Context(), actionReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to approve transfer")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
+}
@@ -1,9 +1,8 @@
 package transfer
 
 import (
-	"motico-api/internal/domain/transfer/entities"
+	"motico-api/internal/domain/transfer"
 	"motico-api/internal/rest/response"
-	restentities "motico-api/internal/rest/transfer/entities"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -13,7 +12,7 @@ import (
 
 // Cancel
 // @Summary      Cancel transfer
-// @Description  Cancel a transfer and release reserved stock
+// @Description  Cancel a pending or approved transfer and release reserved stock
 // @Tags         transfers
 // @Accept       json
 // @Produce      json
@@ -23,6 +22,7 @@ import (
 // @Failure      400          {object}  map[string]interface{}  "Invalid request"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
 // @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
 // @Security     BearerAuth
 // @Router       /transfers/{id}/cancel [patch]
 func (h *Handler) Cancel(w http.ResponseWriter, r *http.Request) {
@@ -40,34 +40,17 @@ func (h *Handler) Cancel(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transfer, err := h.service.Cancel(r.Context(), tenantID, id)
+	actionReq := transfer.ActionRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+	}
+
+	transfer, err := h.service.Cancel(r.Context(), actionReq)
 	if err != nil {
-		if err == entities.ErrTransferNotFound {
-			response.Error(w, http.StatusNotFound, "transfer not found", nil)
-			return
-		}
-		if err == entities.ErrTransferAlreadyCompleted {
-			response.Error(w, http.StatusBadRequest, "transfer is already completed", nil)
-			return
-		}
-		if err == entities.ErrTransferAlreadyCancelled {
-			response.Error(w, http.StatusBadRequest, "transfer is already cancelled", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to cancel transfer", nil)
+		response.HandleError(w, r, err, "failed to cancel transfer")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.TransferResponse{
-		ID:          transfer.ID,
-		TenantID:    transfer.TenantID,
-		ProductID:   transfer.ProductID,
-		FromStoreID: transfer.FromStoreID,
-		ToStoreID:   transfer.ToStoreID,
-		Quantity:    transfer.Quantity,
-		Status:      string(transfer.Status),
-		Notes:       transfer.Notes,
-		CreatedAt:   transfer.CreatedAt,
-		UpdatedAt:   transfer.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
 }
@@ -3,7 +3,6 @@ package transfer
 import (
 	"encoding/json"
 	"motico-api/internal/domain/transfer"
-	"motico-api/internal/domain/transfer/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/transfer/entities"
 	"net/http"
@@ -15,7 +14,7 @@ import (
 
 // Create
 // @Summary      Create transfer
-// @Description  Create a new transfer between stores
+// @Description  Create a transfer order between stores, either for a single product or with multiple lines. Each line reserves stock; serial-tracked products list the serials to send, which are reserved
 // @Tags         transfers
 // @Accept       json
 // @Produce      json
@@ -25,6 +24,7 @@ import (
 // @Failure      400         {object}  map[string]interface{}  "Invalid request"
 // @Failure      401         {object}  map[string]interface{}  "Unauthorized"
 // @Failure      409         {object}  map[string]interface{}  "Insufficient stock"
+// @Failure      422         {object}  map[string]interface{}  "Product not listed in the destination store, or store or product archived"
 // @Security     BearerAuth
 // @Router       /transfers [post]
 func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
@@ -42,47 +42,24 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
 	createReq := transfer.CreateRequest{
 		TenantID:    tenantID,
-		ProductID:   req.ProductID,
 		FromStoreID: req.FromStoreID,
 		ToStoreID:   req.ToStoreID,
-		Quantity:    req.Quantity,
+		Lines:       toLineRequests(req.ProductID, req.Quantity, req.Serials, req.Lines),
 		Notes:       req.Notes,
+		RequestedBy: context.GetUserID(r.Context()),
 	}
 
 	transfer, err := h.service.Create(r.Context(), createReq)
 	if err != nil {
-		if err == entities.ErrInvalidTransferStores {
-			response.Error(w, http.StatusBadRequest, "from_store and to_store must be different", nil)
-			return
-		}
-		if err == entities.ErrInvalidQuantity {
-			response.Error(w, http.StatusBadRequest, "quantity must be greater than zero", nil)
-			return
-		}
-		if err == entities.ErrInsufficientStock {
-			response.Error(w, http.StatusConflict, "insufficient stock available for transfer", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to create transfer", nil)
+		response.HandleError(w, r, err, "failed to create transfer")
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, restentities.TransferResponse{
-		ID:          transfer.ID,
-		TenantID:    transfer.TenantID,
-		ProductID:   transfer.ProductID,
-		FromStoreID: transfer.FromStoreID,
-		ToStoreID:   transfer.ToStoreID,
-		Quantity:    transfer.Quantity,
-		Status:      string(transfer.Status),
-		Notes:       transfer.Notes,
-		CreatedAt:   transfer.CreatedAt,
-		UpdatedAt:   transfer.UpdatedAt,
-	})
+	response.JSON(w, http.StatusCreated, toTransferResponse(transfer))
 }
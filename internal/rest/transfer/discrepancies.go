@@ -0,0 +1,63 @@
+package transfer
+
+import (
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/transfer/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// ListDiscrepancies
+// @Summary      List transfer discrepancies
+// @Description  Get the short and over receipts recorded when the transfer was received
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Transfer ID"
+// @Success      200          {object}  restentities.ListTransferDiscrepanciesResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Security     BearerAuth
+// @Router       /transfers/{id}/discrepancies [get]
+func (h *Handler) ListDiscrepancies(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid transfer ID", nil)
+		return
+	}
+
+	discrepancies, err := h.service.ListDiscrepancies(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to list transfer discrepancies")
+		return
+	}
+
+	responses := make([]restentities.TransferDiscrepancyResponse, len(discrepancies))
+	for i, d := range discrepancies {
+		responses[i] = restentities.TransferDiscrepancyResponse{
+			ID:               d.ID,
+			TransferLineID:   d.TransferLineID,
+			ProductID:        d.ProductID,
+			ExpectedQuantity: d.ExpectedQuantity,
+			ReceivedQuantity: d.ReceivedQuantity,
+			Variance:         d.Variance,
+			RecordedBy:       d.RecordedBy,
+			CreatedAt:        d.CreatedAt,
+		}
+	}
+
+	response.JSON(w, http.StatusOK, restentities.ListTransferDiscrepanciesResponse{Data: responses})
+}
@@ -0,0 +1,56 @@
+package transfer
+
+import (
+	"motico-api/internal/domain/transfer"
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Dispatch
+// @Summary      Dispatch transfer
+// @Description  Mark an approved transfer as in transit. The units are taken out of the origin bins following the pick list and reserved serial-tracked units move to in_transit
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Transfer ID"
+// @Success      200          {object}  restentities.TransferResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
+// @Security     BearerAuth
+// @Router       /transfers/{id}/dispatch [patch]
+func (h *Handler) Dispatch(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid transfer ID", nil)
+		return
+	}
+
+	actionReq := transfer.ActionRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+	}
+
+	transfer, err := h.service.Dispatch(r.Context(), actionReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to dispatch transfer")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
+}
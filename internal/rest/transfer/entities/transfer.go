@@ -6,20 +6,34 @@ import (
 	"github.com/google/uuid"
 )
 
+// TransferLineRequest lleva en serials una unidad por cada una que se envía o se
+// recibe; es obligatorio en productos con seguimiento por serie.
+type TransferLineRequest struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity" validate:"required,gt=0"`
+	Serials   []string  `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
+}
+
+// CreateTransferRequest acepta un único producto (product_id + quantity, con sus
+// serials si corresponde) o una orden con varias líneas, nunca ambas formas a la vez.
 type CreateTransferRequest struct {
-	ProductID   uuid.UUID `json:"product_id" validate:"required"`
-	FromStoreID uuid.UUID `json:"from_store_id" validate:"required"`
-	ToStoreID   uuid.UUID `json:"to_store_id" validate:"required"`
-	Quantity    int       `json:"quantity" validate:"required,gt=0"`
-	Notes       *string   `json:"notes,omitempty"`
+	ProductID   uuid.UUID             `json:"product_id,omitempty" validate:"required_without=Lines,excluded_with=Lines"`
+	FromStoreID uuid.UUID             `json:"from_store_id" validate:"required"`
+	ToStoreID   uuid.UUID             `json:"to_store_id" validate:"required"`
+	Quantity    int                   `json:"quantity,omitempty" validate:"required_without=Lines,gte=0"`
+	Serials     []string              `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
+	Lines       []TransferLineRequest `json:"lines,omitempty" validate:"required_without=ProductID,dive"`
+	Notes       *string               `json:"notes,omitempty"`
 }
 
 type UpdateTransferRequest struct {
-	ProductID   uuid.UUID `json:"product_id" validate:"required"`
-	FromStoreID uuid.UUID `json:"from_store_id" validate:"required"`
-	ToStoreID   uuid.UUID `json:"to_store_id" validate:"required"`
-	Quantity    int       `json:"quantity" validate:"required,gt=0"`
-	Notes       *string   `json:"notes,omitempty"`
+	ProductID   uuid.UUID             `json:"product_id,omitempty" validate:"required_without=Lines,excluded_with=Lines"`
+	FromStoreID uuid.UUID             `json:"from_store_id" validate:"required"`
+	ToStoreID   uuid.UUID             `json:"to_store_id" validate:"required"`
+	Quantity    int                   `json:"quantity,omitempty" validate:"required_without=Lines,gte=0"`
+	Serials     []string              `json:"serials,omitempty" validate:"omitempty,dive,required,max=100"`
+	Lines       []TransferLineRequest `json:"lines,omitempty" validate:"required_without=ProductID,dive"`
+	Notes       *string               `json:"notes,omitempty"`
 }
 
 type PartialUpdateTransferRequest struct {
@@ -30,17 +44,61 @@ type PartialUpdateTransferRequest struct {
 	Notes       *string    `json:"notes,omitempty"`
 }
 
+// ReceiveTransferRequest registra las cantidades recibidas. Con close=true la
+// recepción se cierra y las diferencias con lo enviado se ajustan en el stock.
+type ReceiveTransferRequest struct {
+	Lines []TransferLineRequest `json:"lines" validate:"required_without=Close,dive"`
+	Close bool                  `json:"close,omitempty"`
+}
+
+type TransferLineResponse struct {
+	ID               uuid.UUID `json:"id"`
+	ProductID        uuid.UUID `json:"product_id"`
+	Quantity         int       `json:"quantity"`
+	ReceivedQuantity int       `json:"received_quantity"`
+	Serials          []string  `json:"serials,omitempty"`
+	ReceivedSerials  []string  `json:"received_serials,omitempty"`
+}
+
+// TransferResponse mantiene product_id para las órdenes de una sola línea;
+// quantity es siempre el total de unidades de la orden.
 type TransferResponse struct {
-	ID          uuid.UUID `json:"id"`
-	TenantID    uuid.UUID `json:"tenant_id"`
-	ProductID   uuid.UUID `json:"product_id"`
-	FromStoreID uuid.UUID `json:"from_store_id"`
-	ToStoreID   uuid.UUID `json:"to_store_id"`
-	Quantity    int       `json:"quantity"`
-	Status      string    `json:"status"`
-	Notes       *string   `json:"notes,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID           uuid.UUID              `json:"id"`
+	TenantID     uuid.UUID              `json:"tenant_id"`
+	ProductID    *uuid.UUID             `json:"product_id,omitempty"`
+	FromStoreID  uuid.UUID              `json:"from_store_id"`
+	ToStoreID    uuid.UUID              `json:"to_store_id"`
+	Quantity     int                    `json:"quantity"`
+	Status       string                 `json:"status"`
+	Notes        *string                `json:"notes,omitempty"`
+	Lines        []TransferLineResponse `json:"lines"`
+	RequestedBy  *string                `json:"requested_by,omitempty"`
+	ApprovedAt   *time.Time             `json:"approved_at,omitempty"`
+	ApprovedBy   *string                `json:"approved_by,omitempty"`
+	DispatchedAt *time.Time             `json:"dispatched_at,omitempty"`
+	DispatchedBy *string                `json:"dispatched_by,omitempty"`
+	ReceivedAt   *time.Time             `json:"received_at,omitempty"`
+	ReceivedBy   *string                `json:"received_by,omitempty"`
+	CancelledAt  *time.Time             `json:"cancelled_at,omitempty"`
+	CancelledBy  *string                `json:"cancelled_by,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+	DeletedAt    *time.Time             `json:"deleted_at,omitempty"`
+}
+
+type TransferDiscrepancyResponse struct {
+	ID               uuid.UUID `json:"id"`
+	TransferLineID   uuid.UUID `json:"transfer_line_id"`
+	ProductID        uuid.UUID `json:"product_id"`
+	ExpectedQuantity int       `json:"expected_quantity"`
+	ReceivedQuantity int       `json:"received_quantity"`
+	Variance         int       `json:"variance"`
+	RecordedBy       *string   `json:"recorded_by,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type ListTransferDiscrepanciesResponse struct {
+	Data []TransferDiscrepancyResponse `json:"data"`
 }
 
 type ListTransfersResponse struct {
@@ -54,3 +112,24 @@ type PaginationInfo struct {
 	Total      int `json:"total"`
 	TotalPages int `json:"total_pages"`
 }
+
+type PickBinResponse struct {
+	LocationID uuid.UUID `json:"location_id"`
+	Path       string    `json:"path"`
+	Quantity   int       `json:"quantity"`
+}
+
+// PickLineResponse indica de qué bins tomar las unidades de una línea. unplaced
+// son las unidades que no están en ningún bin.
+type PickLineResponse struct {
+	ProductID uuid.UUID         `json:"product_id"`
+	Quantity  int               `json:"quantity"`
+	Bins      []PickBinResponse `json:"bins"`
+	Unplaced  int               `json:"unplaced"`
+}
+
+type PickListResponse struct {
+	TransferID  uuid.UUID          `json:"transfer_id"`
+	FromStoreID uuid.UUID          `json:"from_store_id"`
+	Lines       []PickLineResponse `json:"lines"`
+}
@@ -1,9 +1,7 @@
 package transfer
 
 import (
-	"motico-api/internal/domain/transfer/entities"
 	"motico-api/internal/rest/response"
-	restentities "motico-api/internal/rest/transfer/entities"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -42,24 +40,9 @@ func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
 
 	transfer, err := h.service.GetByID(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrTransferNotFound {
-			response.Error(w, http.StatusNotFound, "transfer not found", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to get transfer", nil)
+		response.HandleError(w, r, err, "failed to get transfer")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.TransferResponse{
-		ID:          transfer.ID,
-		TenantID:    transfer.TenantID,
-		ProductID:   transfer.ProductID,
-		FromStoreID: transfer.FromStoreID,
-		ToStoreID:   transfer.ToStoreID,
-		Quantity:    transfer.Quantity,
-		Status:      string(transfer.Status),
-		Notes:       transfer.Notes,
-		CreatedAt:   transfer.CreatedAt,
-		UpdatedAt:   transfer.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
 }
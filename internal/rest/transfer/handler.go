@@ -3,6 +3,10 @@ package transfer
 import (
 	"motico-api/config"
 	"motico-api/internal/domain/transfer"
+	"motico-api/internal/domain/transfer/entities"
+	restentities "motico-api/internal/rest/transfer/entities"
+
+	"github.com/google/uuid"
 )
 
 type Handler struct {
@@ -16,3 +20,58 @@ func NewHandler(service *transfer.Service, cfg *config.Config) *Handler {
 		config:  cfg,
 	}
 }
+
+func toTransferResponse(t *entities.Transfer) restentities.TransferResponse {
+	lines := make([]restentities.TransferLineResponse, len(t.Lines))
+	for i, line := range t.Lines {
+		lines[i] = restentities.TransferLineResponse{
+			ID:               line.ID,
+			ProductID:        line.ProductID,
+			Quantity:         line.Quantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			Serials:          line.Serials,
+			ReceivedSerials:  line.ReceivedSerials,
+		}
+	}
+
+	resp := restentities.TransferResponse{
+		ID:           t.ID,
+		TenantID:     t.TenantID,
+		FromStoreID:  t.FromStoreID,
+		ToStoreID:    t.ToStoreID,
+		Quantity:     t.TotalQuantity(),
+		Status:       string(t.Status),
+		Notes:        t.Notes,
+		Lines:        lines,
+		RequestedBy:  t.RequestedBy,
+		ApprovedAt:   t.ApprovedAt,
+		ApprovedBy:   t.ApprovedBy,
+		DispatchedAt: t.DispatchedAt,
+		DispatchedBy: t.DispatchedBy,
+		ReceivedAt:   t.ReceivedAt,
+		ReceivedBy:   t.ReceivedBy,
+		CancelledAt:  t.CancelledAt,
+		CancelledBy:  t.CancelledBy,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+		DeletedAt:    t.DeletedAt,
+	}
+	if len(t.Lines) == 1 {
+		productID := t.Lines[0].ProductID
+		resp.ProductID = &productID
+	}
+
+	return resp
+}
+
+func toLineRequests(productID uuid.UUID, quantity int, serials []string, lines []restentities.TransferLineRequest) []transfer.LineRequest {
+	if len(lines) == 0 {
+		return []transfer.LineRequest{{ProductID: productID, Quantity: quantity, Serials: serials}}
+	}
+
+	result := make([]transfer.LineRequest, len(lines))
+	for i, line := range lines {
+		result[i] = transfer.LineRequest{ProductID: line.ProductID, Quantity: line.Quantity, Serials: line.Serials}
+	}
+	return result
+}
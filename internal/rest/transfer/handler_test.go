@@ -0,0 +1,243 @@
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"motico-api/config"
+	productdomain "motico-api/internal/domain/product"
+	productentities "motico-api/internal/domain/product/entities"
+	"motico-api/internal/domain/stock"
+	"motico-api/internal/domain/stock/stocktest"
+	storedomain "motico-api/internal/domain/store"
+	storeentities "motico-api/internal/domain/store/entities"
+	"motico-api/internal/domain/transaction/transactiontest"
+	"motico-api/internal/domain/transfer"
+	"motico-api/internal/domain/transfer/entities"
+	restentities "motico-api/internal/rest/transfer/entities"
+	ctxpkg "motico-api/pkg/context"
+	"motico-api/pkg/logger"
+	"motico-api/pkg/money"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func TestToLineRequests(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name      string
+		productID uuid.UUID
+		quantity  int
+		serials   []string
+		lines     []restentities.TransferLineRequest
+		want      []transfer.LineRequest
+	}{
+		{
+			name:      "legacy single product",
+			productID: a,
+			quantity:  2,
+			serials:   []string{"E1", "E2"},
+			want:      []transfer.LineRequest{{ProductID: a, Quantity: 2, Serials: []string{"E1", "E2"}}},
+		},
+		{
+			name:  "lines",
+			lines: []restentities.TransferLineRequest{{ProductID: a, Quantity: 1}, {ProductID: b, Quantity: 4, Serials: []string{"E9"}}},
+			want:  []transfer.LineRequest{{ProductID: a, Quantity: 1}, {ProductID: b, Quantity: 4, Serials: []string{"E9"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toLineRequests(tt.productID, tt.quantity, tt.serials, tt.lines)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toLineRequests() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToTransferResponseLegacyProduct(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	single := toTransferResponse(&entities.Transfer{Lines: []entities.TransferLine{{ProductID: a, Quantity: 3}}})
+	if single.ProductID == nil || *single.ProductID != a || single.Quantity != 3 {
+		t.Errorf("single line: got product %v quantity %d, want %s and 3", single.ProductID, single.Quantity, a)
+	}
+
+	multi := toTransferResponse(&entities.Transfer{Lines: []entities.TransferLine{{ProductID: a, Quantity: 3}, {ProductID: b, Quantity: 2}}})
+	if multi.ProductID != nil || multi.Quantity != 5 || len(multi.Lines) != 2 {
+		t.Errorf("multiple lines: got product %v quantity %d lines %d, want no product, 5 and 2", multi.ProductID, multi.Quantity, len(multi.Lines))
+	}
+}
+
+// transferRepo guarda los traspasos en memoria y devuelve copias, como la base.
+type transferRepo struct {
+	transfer.Repository
+	transfers map[uuid.UUID]*entities.Transfer
+}
+
+func (r *transferRepo) Create(ctx context.Context, t *entities.Transfer) error {
+	t.ID = uuid.New()
+	for i := range t.Lines {
+		t.Lines[i].ID = uuid.New()
+		t.Lines[i].TransferID = t.ID
+	}
+	r.transfers[t.ID] = copyTransfer(t)
+	return nil
+}
+
+func (r *transferRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	t, ok := r.transfers[id]
+	if !ok || t.TenantID != tenantID {
+		return nil, entities.ErrTransferNotFound
+	}
+	return copyTransfer(t), nil
+}
+
+func (r *transferRepo) GetByIDForUpdate(ctx context.Context, tenantID, id uuid.UUID) (*entities.Transfer, error) {
+	return r.GetByID(ctx, tenantID, id)
+}
+
+func (r *transferRepo) Update(ctx context.Context, t *entities.Transfer) error {
+	r.transfers[t.ID] = copyTransfer(t)
+	return nil
+}
+
+func copyTransfer(t *entities.Transfer) *entities.Transfer {
+	copied := *t
+	copied.Lines = append([]entities.TransferLine(nil), t.Lines...)
+	return &copied
+}
+
+type storeRepo struct {
+	storedomain.Repository
+	tenantID uuid.UUID
+}
+
+func (r *storeRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*storeentities.Store, error) {
+	if tenantID != r.tenantID {
+		return nil, storeentities.ErrStoreNotFound
+	}
+	return &storeentities.Store{ID: id, TenantID: tenantID, Status: storeentities.StoreStatusActive}, nil
+}
+
+type productRepo struct {
+	productdomain.Repository
+	products map[uuid.UUID]*productentities.Product
+}
+
+func (r *productRepo) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*productentities.Product, error) {
+	product, ok := r.products[id]
+	if !ok || product.TenantID != tenantID {
+		return nil, productentities.ErrProductNotFound
+	}
+	found := *product
+	return &found, nil
+}
+
+func (r *productRepo) List(ctx context.Context, tenantID uuid.UUID, filter productdomain.ListFilter, limit, offset int) ([]*productentities.Product, error) {
+	var products []*productentities.Product
+	for _, product := range r.products {
+		if product.TenantID == tenantID && product.StoreID == *filter.StoreID && product.CatalogItemID == *filter.CatalogItemID {
+			found := *product
+			products = append(products, &found)
+		}
+	}
+	return products, nil
+}
+
+// TestCompleteLegacyTransfer crea un traspaso con el cuerpo de un solo producto y
+// lo completa directamente desde pendiente, como lo hacían los clientes del flujo
+// anterior a la aprobación y el despacho.
+func TestCompleteLegacyTransfer(t *testing.T) {
+	ctx := context.Background()
+	tenantID, fromStore, toStore := uuid.New(), uuid.New(), uuid.New()
+	source, target, catalogItemID := uuid.New(), uuid.New(), uuid.New()
+
+	stockRepo := stocktest.NewRepository()
+	stockRepo.Stores[source] = fromStore
+	stockRepo.Stores[target] = toStore
+	stores := &storeRepo{tenantID: tenantID}
+	products := &productRepo{products: map[uuid.UUID]*productentities.Product{
+		source: {ID: source, TenantID: tenantID, StoreID: fromStore, CatalogItemID: catalogItemID, Status: productentities.ProductStatusActive},
+		target: {ID: target, TenantID: tenantID, StoreID: toStore, CatalogItemID: catalogItemID, Status: productentities.ProductStatusActive},
+	}}
+
+	cfg := &config.Config{}
+	stockService := stock.NewService(stockRepo, transactiontest.Manager{}, cfg, logger.NewNop())
+	storeService := storedomain.NewService(stores, transactiontest.Manager{}, cfg, logger.NewNop())
+	service := transfer.NewService(&transferRepo{transfers: map[uuid.UUID]*entities.Transfer{}}, stockService, storeService, stores, products, transactiontest.Manager{}, cfg, logger.NewNop())
+
+	unitCost := money.FromInt(100)
+	if _, err := stockService.Adjust(ctx, stock.AdjustRequest{TenantID: tenantID, ProductID: source, Amount: 10, UnitCost: &unitCost}); err != nil {
+		t.Fatalf("seed stock: %v", err)
+	}
+
+	h := NewHandler(service, cfg)
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), ctxpkg.TenantIDKey, tenantID.String())
+			ctx = context.WithValue(ctx, ctxpkg.UserIDKey, "clerk")
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	router.Post("/transfers", h.Create)
+	router.Patch("/transfers/{id}/complete", h.Complete)
+
+	serve := func(method, path string, body interface{}) (int, restentities.TransferResponse) {
+		t.Helper()
+		payload, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("marshal body: %v", err)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewReader(payload)))
+		var resp restentities.TransferResponse
+		if rec.Code < http.StatusBadRequest {
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode %s %s: %v", method, path, err)
+			}
+		}
+		return rec.Code, resp
+	}
+
+	code, created := serve(http.MethodPost, "/transfers", map[string]interface{}{
+		"product_id":    source,
+		"from_store_id": fromStore,
+		"to_store_id":   toStore,
+		"quantity":      3,
+	})
+	if code != http.StatusCreated || created.Status != string(entities.TransferStatusPending) {
+		t.Fatalf("create: got %d with status %q, want 201 and pending", code, created.Status)
+	}
+
+	code, completed := serve(http.MethodPatch, "/transfers/"+created.ID.String()+"/complete", nil)
+	if code != http.StatusOK {
+		t.Fatalf("complete: got %d, want 200", code)
+	}
+	if completed.Status != string(entities.TransferStatusReceived) || completed.ProductID == nil || *completed.ProductID != source {
+		t.Errorf("complete: got status %q product %v, want received and %s", completed.Status, completed.ProductID, source)
+	}
+	for step, by := range map[string]*string{"approved": completed.ApprovedBy, "dispatched": completed.DispatchedBy, "received": completed.ReceivedBy} {
+		if by == nil || *by != "clerk" {
+			t.Errorf("%s by: got %v, want clerk", step, by)
+		}
+	}
+	if completed.ApprovedAt == nil || completed.DispatchedAt == nil || completed.ReceivedAt == nil {
+		t.Errorf("step timestamps: got approved %v dispatched %v received %v, want all set", completed.ApprovedAt, completed.DispatchedAt, completed.ReceivedAt)
+	}
+
+	if st := stockRepo.Stock(tenantID, source); st.Quantity != 7 || st.ReservedQuantity != 0 {
+		t.Errorf("origin stock: got quantity %d reserved %d, want 7 and 0", st.Quantity, st.ReservedQuantity)
+	}
+	if st := stockRepo.Stock(tenantID, target); st.Quantity != 3 {
+		t.Errorf("destination stock: got %d, want 3", st.Quantity)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"motico-api/pkg/context"
+	"motico-api/pkg/query"
 )
 
 // List
@@ -20,11 +21,18 @@ import (
 // @Param        X-Tenant-ID  header    string  true  "Tenant ID"
 // @Param        page         query     int     false "Page number" default(1)
 // @Param        limit        query     int     false "Items per page" default(20)
-// @Param        status       query     string  false "Filter by status (pending, completed, cancelled)"
+// @Param        status       query     string  false "Filter by status (pending, approved, in_transit, partially_received, received, cancelled)"
 // @Param        store_id     query     string  false "Filter by store ID"
+// @Param        sort         query     string  false "Sort fields (status, created_at, updated_at, received_at), prefix with - for descending"
+// @Param        created_after   query  string  false "Created after (RFC 3339 or YYYY-MM-DD)"
+// @Param        created_before  query  string  false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param        updated_since   query  string  false "Updated since (RFC 3339 or YYYY-MM-DD)"
+// @Param        received_after  query  string  false "Received after (RFC 3339 or YYYY-MM-DD)"
+// @Param        include_deleted query  bool    false "Include soft-deleted transfers (admins only)"
 // @Success      200          {object}  restentities.ListTransfersResponse
-// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID"
+// @Failure      400          {object}  map[string]interface{}  "Invalid tenant ID or unknown sort/filter field"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "include_deleted requires the admin role"
 // @Security     BearerAuth
 // @Router       /transfers [get]
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
@@ -38,7 +46,7 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	var status *entities.TransferStatus
 	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
 		s := entities.TransferStatus(statusStr)
-		if s == entities.TransferStatusPending || s == entities.TransferStatusCompleted || s == entities.TransferStatusCancelled {
+		if s.IsValid() {
 			status = &s
 		}
 	}
@@ -51,6 +59,8 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	params := query.Parse(r.URL.Query(), "page", "limit", "status", "store_id")
+
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	if page < 1 {
 		page = 1
@@ -61,26 +71,15 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	}
 	offset := (page - 1) * limit
 
-	transfers, err := h.service.List(r.Context(), tenantID, status, storeID, limit, offset)
+	transfers, err := h.service.List(r.Context(), tenantID, status, storeID, params, limit, offset)
 	if err != nil {
-		response.Error(w, http.StatusInternalServerError, "failed to list transfers", nil)
+		response.HandleError(w, r, err, "failed to list transfers")
 		return
 	}
 
 	responses := make([]restentities.TransferResponse, len(transfers))
 	for i, t := range transfers {
-		responses[i] = restentities.TransferResponse{
-			ID:          t.ID,
-			TenantID:    t.TenantID,
-			ProductID:   t.ProductID,
-			FromStoreID: t.FromStoreID,
-			ToStoreID:   t.ToStoreID,
-			Quantity:    t.Quantity,
-			Status:      string(t.Status),
-			Notes:       t.Notes,
-			CreatedAt:   t.CreatedAt,
-			UpdatedAt:   t.UpdatedAt,
-		}
+		responses[i] = toTransferResponse(t)
 	}
 
 	total := len(transfers)
@@ -0,0 +1,68 @@
+package transfer
+
+import (
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/transfer/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// PickList
+// @Summary      Get transfer pick list
+// @Description  Get which bins of the origin store to pull each line from, in pick order. Only available before the transfer is dispatched; dispatching takes the units out of those bins
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Transfer ID"
+// @Success      200          {object}  restentities.PickListResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Transfer was already dispatched or cancelled"
+// @Security     BearerAuth
+// @Router       /transfers/{id}/pick-list [get]
+func (h *Handler) PickList(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid transfer ID", nil)
+		return
+	}
+
+	pickList, err := h.service.PickList(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to get pick list")
+		return
+	}
+
+	lines := make([]restentities.PickLineResponse, len(pickList.Lines))
+	for i, line := range pickList.Lines {
+		bins := make([]restentities.PickBinResponse, len(line.Bins))
+		for j, bin := range line.Bins {
+			bins[j] = restentities.PickBinResponse{LocationID: bin.LocationID, Path: bin.Path, Quantity: bin.Quantity}
+		}
+		lines[i] = restentities.PickLineResponse{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			Bins:      bins,
+			Unplaced:  line.Unplaced,
+		}
+	}
+
+	response.JSON(w, http.StatusOK, restentities.PickListResponse{
+		TransferID:  pickList.TransferID,
+		FromStoreID: pickList.FromStoreID,
+		Lines:       lines,
+	})
+}
@@ -0,0 +1,76 @@
+package transfer
+
+import (
+	"encoding/json"
+	"motico-api/internal/domain/transfer"
+	"motico-api/internal/rest/response"
+	restentities "motico-api/internal/rest/transfer/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+	"motico-api/pkg/validator"
+)
+
+// Receive
+// @Summary      Receive transfer lines
+// @Description  Register the quantities received at the destination store. The transfer is received once every line is complete or when close is true; short and over receipts adjust stock and are logged as discrepancies. Serial-tracked lines list the serials received
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string                               true  "Tenant ID"
+// @Param        id           path      string                               true  "Transfer ID"
+// @Param        request      body      restentities.ReceiveTransferRequest  true  "Received quantities per product"
+// @Success      200          {object}  restentities.TransferResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Action not allowed for the current status"
+// @Failure      422          {object}  map[string]interface{}  "Product not listed in the destination store"
+// @Security     BearerAuth
+// @Router       /transfers/{id}/receive [patch]
+func (h *Handler) Receive(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid transfer ID", nil)
+		return
+	}
+
+	var req restentities.ReceiveTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid request body", nil)
+		return
+	}
+
+	if err := validator.ValidateRequest(r, &req); err != nil {
+		response.HandleError(w, r, err, "failed to validate request")
+		return
+	}
+
+	receiveReq := transfer.ReceiveRequest{
+		ID:       id,
+		TenantID: tenantID,
+		Actor:    context.GetUserID(r.Context()),
+		Close:    req.Close,
+	}
+	if len(req.Lines) > 0 {
+		receiveReq.Lines = toLineRequests(uuid.Nil, 0, nil, req.Lines)
+	}
+
+	transfer, err := h.service.Receive(r.Context(), receiveReq)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to receive transfer")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
+}
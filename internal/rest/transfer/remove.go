@@ -1,7 +1,6 @@
 package transfer
 
 import (
-	"motico-api/internal/domain/transfer/entities"
 	"motico-api/internal/rest/response"
 	"net/http"
 
@@ -12,7 +11,7 @@ import (
 
 // Remove
 // @Summary      Delete transfer
-// @Description  Delete a transfer by ID (only pending transfers can be deleted)
+// @Description  Delete a transfer by ID and release its stock reservation (only pending transfers can be deleted)
 // @Tags         transfers
 // @Accept       json
 // @Produce      json
@@ -41,15 +40,7 @@ func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.Delete(r.Context(), tenantID, id)
 	if err != nil {
-		if err == entities.ErrTransferNotFound {
-			response.Error(w, http.StatusNotFound, "transfer not found", nil)
-			return
-		}
-		if err == entities.ErrTransferNotPending {
-			response.Error(w, http.StatusBadRequest, "transfer is not in pending status", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to delete transfer", nil)
+		response.HandleError(w, r, err, "failed to delete transfer")
 		return
 	}
 
@@ -0,0 +1,51 @@
+package transfer
+
+import (
+	"motico-api/internal/rest/response"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"motico-api/pkg/context"
+)
+
+// Restore
+// @Summary      Restore transfer
+// @Description  Restore a soft-deleted transfer and reserve its stock at the origin store again. Its stores and products must not be deleted and the origin store must still have enough available stock. Admins only
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        X-Tenant-ID  header    string  true  "Tenant ID"
+// @Param        id           path      string  true  "Transfer ID"
+// @Success      200          {object}  restentities.TransferResponse
+// @Failure      400          {object}  map[string]interface{}  "Invalid request"
+// @Failure      401          {object}  map[string]interface{}  "Unauthorized"
+// @Failure      403          {object}  map[string]interface{}  "Requires the admin role"
+// @Failure      404          {object}  map[string]interface{}  "Deleted transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient stock or serial no longer available"
+// @Failure      422          {object}  map[string]interface{}  "Store or product is deleted or archived"
+// @Security     BearerAuth
+// @Router       /transfers/{id}/restore [post]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	tenantIDStr := context.GetTenantID(r.Context())
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid tenant ID", nil)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "invalid transfer ID", nil)
+		return
+	}
+
+	transfer, err := h.service.Restore(r.Context(), tenantID, id)
+	if err != nil {
+		response.HandleError(w, r, err, "failed to restore transfer")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
+}
@@ -3,7 +3,6 @@ package transfer
 import (
 	"encoding/json"
 	"motico-api/internal/domain/transfer"
-	"motico-api/internal/domain/transfer/entities"
 	"motico-api/internal/rest/response"
 	restentities "motico-api/internal/rest/transfer/entities"
 	"net/http"
@@ -16,7 +15,7 @@ import (
 
 // Update
 // @Summary      Update transfer
-// @Description  Update an existing transfer (only pending transfers can be updated)
+// @Description  Update an existing transfer (only pending transfers can be updated). Changing the lines moves the stock reservation to the new lines
 // @Tags         transfers
 // @Accept       json
 // @Produce      json
@@ -27,6 +26,8 @@ import (
 // @Failure      400          {object}  map[string]interface{}  "Invalid request"
 // @Failure      401          {object}  map[string]interface{}  "Unauthorized"
 // @Failure      404          {object}  map[string]interface{}  "Transfer not found"
+// @Failure      409          {object}  map[string]interface{}  "Insufficient stock"
+// @Failure      422          {object}  map[string]interface{}  "Product not listed in the destination store, or store or product archived"
 // @Security     BearerAuth
 // @Router       /transfers/{id} [put]
 func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
@@ -51,52 +52,24 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validator.ValidateRequest(r, &req); err != nil {
-		validator.HandleValidationError(w, err)
+		response.HandleError(w, r, err, "failed to validate request")
 		return
 	}
 
 	updateReq := transfer.UpdateRequest{
 		ID:          id,
 		TenantID:    tenantID,
-		ProductID:   &req.ProductID,
 		FromStoreID: &req.FromStoreID,
 		ToStoreID:   &req.ToStoreID,
-		Quantity:    &req.Quantity,
+		Lines:       toLineRequests(req.ProductID, req.Quantity, req.Serials, req.Lines),
 		Notes:       req.Notes,
 	}
 
 	transfer, err := h.service.Update(r.Context(), updateReq)
 	if err != nil {
-		if err == entities.ErrTransferNotFound {
-			response.Error(w, http.StatusNotFound, "transfer not found", nil)
-			return
-		}
-		if err == entities.ErrTransferNotPending {
-			response.Error(w, http.StatusBadRequest, "transfer is not in pending status", nil)
-			return
-		}
-		if err == entities.ErrInvalidTransferStores {
-			response.Error(w, http.StatusBadRequest, "from_store and to_store must be different", nil)
-			return
-		}
-		if err == entities.ErrInvalidQuantity {
-			response.Error(w, http.StatusBadRequest, "quantity must be greater than zero", nil)
-			return
-		}
-		response.Error(w, http.StatusInternalServerError, "failed to update transfer", nil)
+		response.HandleError(w, r, err, "failed to update transfer")
 		return
 	}
 
-	response.JSON(w, http.StatusOK, restentities.TransferResponse{
-		ID:          transfer.ID,
-		TenantID:    transfer.TenantID,
-		ProductID:   transfer.ProductID,
-		FromStoreID: transfer.FromStoreID,
-		ToStoreID:   transfer.ToStoreID,
-		Quantity:    transfer.Quantity,
-		Status:      string(transfer.Status),
-		Notes:       transfer.Notes,
-		CreatedAt:   transfer.CreatedAt,
-		UpdatedAt:   transfer.UpdatedAt,
-	})
+	response.JSON(w, http.StatusOK, toTransferResponse(transfer))
 }
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"motico-api/config"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewLocalStorage(root, "http://localhost:8080/media/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := "tenant/products/product/photo.png"
+	if err := store.Put(ctx, key, "image/png", strings.NewReader("png data"), 8); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(root, "tenant", "products", "product", "photo.png"))
+	if err != nil || string(data) != "png data" {
+		t.Fatalf("stored file = %q, %v", data, err)
+	}
+	if got := store.URL(key); got != "http://localhost:8080/media/"+key {
+		t.Errorf("URL() = %s", got)
+	}
+
+	server := httptest.NewServer(http.StripPrefix("/media", store.Handler()))
+	defer server.Close()
+	for path, want := range map[string]int{"/media/" + key: http.StatusOK, "/media/tenant/products/": http.StatusNotFound} {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("GET %s status = %d, want %d", path, resp.StatusCode, want)
+		}
+	}
+
+	for _, bad := range []string{"", "../outside.png", "tenant/../../outside.png", "/absolute.png"} {
+		if err := store.Put(ctx, bad, "image/png", strings.NewReader("x"), 1); err != ErrInvalidKey {
+			t.Errorf("Put(%q) error = %v, want %v", bad, err, ErrInvalidKey)
+		}
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() of a missing file error = %v", err)
+	}
+}
+
+// fakeS3 es un reemplazo local de S3 que guarda los objetos en memoria y exige
+// peticiones firmadas con Signature V4 sobre la ruta del bucket.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]string
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20240102/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") ||
+		r.Header.Get("X-Amz-Date") != "20240102T030405Z" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = string(body)
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: map[string]string{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := NewS3Storage(config.S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "media",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	key := "tenant/products/product/photo 1.jpg"
+	if err := store.Put(ctx, key, "image/jpeg", strings.NewReader("jpeg data"), 9); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if got := fake.objects["/media/"+key]; got != "jpeg data" || fake.types["/media/"+key] != "image/jpeg" {
+		t.Errorf("stored object = %q (%s)", got, fake.types["/media/"+key])
+	}
+	if got := store.URL(key); got != server.URL+"/media/tenant/products/product/photo%201.jpg" {
+		t.Errorf("URL() = %s", got)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, ok := fake.objects["/media/"+key]; ok {
+		t.Error("object still stored after Delete()")
+	}
+
+	store.secretKey, store.accessKey = "other", "OTHER"
+	if err := store.Put(ctx, key, "image/jpeg", strings.NewReader("x"), 1); err == nil {
+		t.Error("Put() with rejected credentials error = nil")
+	}
+}
+
+func TestS3SigningKey(t *testing.T) {
+	// Ejemplo de la documentación de AWS para la derivación de la clave de firma
+	key := signingKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam")
+	if got := hex.EncodeToString(key); got != "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d" {
+		t.Errorf("signing key = %s", got)
+	}
+}
@@ -0,0 +1,32 @@
+-- Líneas de traspaso: un traspaso pasa a ser una orden con cabecera y N productos
+CREATE TABLE IF NOT EXISTS transfer_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    transfer_id UUID NOT NULL REFERENCES transfers(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    received_quantity INTEGER NOT NULL DEFAULT 0 CHECK (received_quantity >= 0),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(transfer_id, product_id),
+    CHECK (received_quantity <= quantity)
+);
+
+-- Los traspasos existentes se convierten en órdenes de una sola línea
+INSERT INTO transfer_lines (tenant_id, transfer_id, product_id, position, quantity, received_quantity, created_at, updated_at)
+SELECT tenant_id, id, product_id, 0, quantity,
+       CASE WHEN status = 'completed' THEN quantity ELSE 0 END,
+       created_at, updated_at
+FROM transfers
+WHERE NOT EXISTS (SELECT 1 FROM transfer_lines tl WHERE tl.transfer_id = transfers.id);
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS product_id;
+ALTER TABLE transfers DROP COLUMN IF EXISTS quantity;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+    CHECK (status IN ('pending', 'partially_received', 'completed', 'cancelled'));
+
+CREATE INDEX IF NOT EXISTS idx_transfer_lines_transfer ON transfer_lines(transfer_id);
+CREATE INDEX IF NOT EXISTS idx_transfer_lines_product ON transfer_lines(tenant_id, product_id);
@@ -0,0 +1,37 @@
+-- Flujo de traspasos: solicitud → aprobación → despacho → recepción
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+
+UPDATE transfers SET status = 'received' WHERE status = 'completed';
+
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+    CHECK (status IN ('pending', 'approved', 'in_transit', 'partially_received', 'received', 'cancelled'));
+
+ALTER TABLE transfers
+    ADD COLUMN IF NOT EXISTS requested_by VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS approved_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS approved_by VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS dispatched_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS dispatched_by VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS received_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS received_by VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS cancelled_by VARCHAR(255);
+
+-- La sucursal de destino puede recibir más de lo enviado (sobre-recepción)
+ALTER TABLE transfer_lines DROP CONSTRAINT IF EXISTS transfer_lines_check;
+
+-- Diferencias entre lo enviado y lo recibido
+CREATE TABLE IF NOT EXISTS transfer_discrepancies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    transfer_id UUID NOT NULL REFERENCES transfers(id) ON DELETE CASCADE,
+    transfer_line_id UUID NOT NULL REFERENCES transfer_lines(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    expected_quantity INTEGER NOT NULL,
+    received_quantity INTEGER NOT NULL,
+    variance INTEGER NOT NULL CHECK (variance != 0),
+    recorded_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_discrepancies_transfer ON transfer_discrepancies(tenant_id, transfer_id);
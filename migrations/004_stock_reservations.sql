@@ -0,0 +1,27 @@
+-- Reservas de stock: cada retención queda registrada con su dueño y vencimiento.
+-- stock.reserved_quantity se mantiene como la suma de las reservas del producto.
+CREATE TABLE IF NOT EXISTS stock_reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    owner_type VARCHAR(50) NOT NULL CHECK (owner_type IN ('transfer', 'sales_order', 'cart')),
+    owner_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(tenant_id, product_id, owner_type, owner_id)
+);
+
+-- Las reservas de los traspasos abiertos pasan a ser registros
+INSERT INTO stock_reservations (tenant_id, product_id, owner_type, owner_id, quantity, created_at, updated_at)
+SELECT tl.tenant_id, tl.product_id, 'transfer', tl.transfer_id, tl.quantity - tl.received_quantity, t.created_at, NOW()
+FROM transfer_lines tl
+JOIN transfers t ON t.id = tl.transfer_id
+WHERE t.status IN ('pending', 'approved', 'in_transit', 'partially_received')
+  AND tl.quantity > tl.received_quantity
+ON CONFLICT (tenant_id, product_id, owner_type, owner_id) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_stock_reservations_product ON stock_reservations(tenant_id, product_id);
+CREATE INDEX IF NOT EXISTS idx_stock_reservations_owner ON stock_reservations(tenant_id, owner_type, owner_id);
+CREATE INDEX IF NOT EXISTS idx_stock_reservations_expires ON stock_reservations(expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,22 @@
+-- Variantes de producto: una variante es un producto hijo (con su SKU, precio y
+-- stock propios) que fija un valor por cada eje de opción del padre.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES products(id) ON DELETE RESTRICT;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS option_values JSONB;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_variant_options ON products(parent_id, option_values) WHERE parent_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_parent ON products(parent_id);
+
+-- Ejes de opción del producto padre (talle, color...)
+CREATE TABLE IF NOT EXISTS product_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    allowed_values TEXT[] NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(product_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_options_product ON product_options(tenant_id, product_id);
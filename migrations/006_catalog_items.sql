@@ -0,0 +1,65 @@
+-- Catálogo maestro del tenant: un artículo por SKU con nombre, categoría y precio
+-- por defecto. Cada fila de products pasa a ser la publicación del artículo en una
+-- sucursal, con precio local opcional (price NULL = precio del catálogo) y estado activo.
+CREATE TABLE IF NOT EXISTS catalog_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE RESTRICT,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    sku VARCHAR(100),
+    default_price DECIMAL(10,2),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(tenant_id, sku)
+);
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS catalog_item_id UUID REFERENCES catalog_items(id) ON DELETE RESTRICT;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS active BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Productos con SKU: un artículo por (tenant, sku) tomando los datos de la publicación más antigua
+INSERT INTO catalog_items (tenant_id, category_id, name, description, sku, default_price, created_at, updated_at)
+SELECT DISTINCT ON (p.tenant_id, p.sku)
+       p.tenant_id, p.category_id, p.name, p.description, p.sku, p.price, p.created_at, NOW()
+FROM products p
+WHERE p.sku IS NOT NULL AND p.catalog_item_id IS NULL
+ORDER BY p.tenant_id, p.sku, p.created_at
+ON CONFLICT (tenant_id, sku) DO NOTHING;
+
+UPDATE products p
+SET catalog_item_id = c.id
+FROM catalog_items c
+WHERE p.catalog_item_id IS NULL AND p.sku IS NOT NULL
+  AND c.tenant_id = p.tenant_id AND c.sku = p.sku;
+
+-- Productos sin SKU: no hay forma segura de agruparlos, cada uno queda como su propio artículo
+CREATE TEMP TABLE products_without_sku AS
+SELECT p.id AS product_id, gen_random_uuid() AS catalog_item_id
+FROM products p
+WHERE p.catalog_item_id IS NULL;
+
+INSERT INTO catalog_items (id, tenant_id, category_id, name, description, sku, default_price, created_at, updated_at)
+SELECT t.catalog_item_id, p.tenant_id, p.category_id, p.name, p.description, NULL, p.price, p.created_at, NOW()
+FROM products_without_sku t
+JOIN products p ON p.id = t.product_id;
+
+UPDATE products p
+SET catalog_item_id = t.catalog_item_id
+FROM products_without_sku t
+WHERE p.id = t.product_id;
+
+DROP TABLE products_without_sku;
+
+-- Las publicaciones toman el nombre maestro y solo conservan el precio si difiere del catálogo
+UPDATE products p
+SET name = c.name,
+    description = c.description,
+    category_id = c.category_id,
+    price = CASE WHEN p.price IS NOT DISTINCT FROM c.default_price THEN NULL ELSE p.price END
+FROM catalog_items c
+WHERE c.id = p.catalog_item_id;
+
+ALTER TABLE products ALTER COLUMN catalog_item_id SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_store_catalog_item ON products(store_id, catalog_item_id);
+CREATE INDEX IF NOT EXISTS idx_catalog_items_tenant ON catalog_items(tenant_id);
@@ -0,0 +1,15 @@
+-- Códigos de barras por artículo del catálogo: un artículo puede tener varios y
+-- cada código identifica un único artículo dentro del tenant
+CREATE TABLE IF NOT EXISTS catalog_barcodes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    catalog_item_id UUID NOT NULL REFERENCES catalog_items(id) ON DELETE CASCADE,
+    code VARCHAR(80) NOT NULL,
+    -- UPC-A se guarda normalizado a EAN-13 para que ambas lecturas coincidan
+    normalized_code VARCHAR(80) NOT NULL,
+    format VARCHAR(20) NOT NULL CHECK (format IN ('ean13', 'upc_a', 'code128')),
+    created_at TIMESTAMP DEFAULT NOW(),
+    CONSTRAINT catalog_barcodes_tenant_code_key UNIQUE (tenant_id, normalized_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_catalog_barcodes_item ON catalog_barcodes(catalog_item_id);
@@ -0,0 +1,15 @@
+-- Búsqueda de productos: texto completo con tsvector y tolerancia a errores con pg_trgm
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Se usa la configuración 'simple' para no aplicar stemming a SKUs ni nombres de
+-- marca; la similitud de trigramas cubre plurales y errores de tipeo
+ALTER TABLE products ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(sku, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_products_name_trgm ON products USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_products_sku_trgm ON products USING GIN (sku gin_trgm_ops);
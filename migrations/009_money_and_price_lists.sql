@@ -0,0 +1,36 @@
+-- Importes con cuatro decimales para monedas de tres decimales y costos unitarios
+ALTER TABLE products ALTER COLUMN price TYPE NUMERIC(14,4);
+ALTER TABLE catalog_items ALTER COLUMN default_price TYPE NUMERIC(14,4);
+
+-- Moneda por tenant y por artículo del catálogo; el artículo toma la del tenant al crearse
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE catalog_items ADD COLUMN IF NOT EXISTS currency CHAR(3);
+UPDATE catalog_items c SET currency = t.currency FROM tenants t WHERE t.id = c.tenant_id AND c.currency IS NULL;
+ALTER TABLE catalog_items ALTER COLUMN currency SET NOT NULL;
+
+-- Listas de precios por sucursal con vigencia. Si varias listas están vigentes a
+-- la vez, manda la de valid_from más reciente
+CREATE TABLE IF NOT EXISTS price_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    CHECK (valid_to IS NULL OR valid_to > valid_from)
+);
+
+CREATE TABLE IF NOT EXISTS price_list_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    price_list_id UUID NOT NULL REFERENCES price_lists(id) ON DELETE CASCADE,
+    catalog_item_id UUID NOT NULL REFERENCES catalog_items(id) ON DELETE CASCADE,
+    price NUMERIC(14,4) NOT NULL CHECK (price >= 0),
+    UNIQUE(price_list_id, catalog_item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_lists_store ON price_lists(tenant_id, store_id, valid_from);
+CREATE INDEX IF NOT EXISTS idx_price_list_items_item ON price_list_items(catalog_item_id);
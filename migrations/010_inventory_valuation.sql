@@ -0,0 +1,60 @@
+-- Costeo de inventario: cada fila de stock guarda el valor total de sus unidades
+-- (costo promedio = valor / cantidad). El método de costeo es por tenant: con
+-- 'average' las salidas se valorizan al promedio y con 'fifo' consumen las capas
+-- de costo de las entradas más antiguas
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS costing_method VARCHAR(20) NOT NULL DEFAULT 'average';
+ALTER TABLE tenants DROP CONSTRAINT IF EXISTS tenants_costing_method_check;
+ALTER TABLE tenants ADD CONSTRAINT tenants_costing_method_check
+    CHECK (costing_method IN ('average', 'fifo'));
+
+ALTER TABLE stock ADD COLUMN IF NOT EXISTS inventory_value NUMERIC(18,4) NOT NULL DEFAULT 0;
+
+-- Capas de costo: una por entrada, con las unidades que aún no salieron. Se mantienen
+-- con cualquier método para poder pasar a FIFO sin perder la antigüedad del stock
+CREATE TABLE IF NOT EXISTS stock_cost_layers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    unit_cost NUMERIC(14,4) NOT NULL CHECK (unit_cost >= 0),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    remaining_quantity INTEGER NOT NULL CHECK (remaining_quantity >= 0),
+    received_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (remaining_quantity <= quantity)
+);
+
+-- Libro de movimientos: cada cambio de cantidad con su costo y el saldo resultante,
+-- para valorizar el inventario a cualquier fecha. seq ordena los movimientos de una
+-- misma transacción, que comparten NOW()
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    seq BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    reason VARCHAR(30) NOT NULL,
+    quantity INTEGER NOT NULL,
+    unit_cost NUMERIC(14,4) NOT NULL,
+    total_cost NUMERIC(18,4) NOT NULL,
+    quantity_after INTEGER NOT NULL,
+    value_after NUMERIC(18,4) NOT NULL,
+    reference_type VARCHAR(30),
+    reference_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Saldo inicial: el stock existente entra con costo cero hasta que se registren
+-- entradas con costo
+INSERT INTO stock_cost_layers (tenant_id, product_id, unit_cost, quantity, remaining_quantity, received_at)
+SELECT s.tenant_id, s.product_id, 0, s.quantity, s.quantity, COALESCE(s.updated_at, NOW())
+FROM stock s
+WHERE s.quantity > 0
+  AND NOT EXISTS (SELECT 1 FROM stock_cost_layers l WHERE l.product_id = s.product_id);
+
+INSERT INTO stock_movements (tenant_id, product_id, reason, quantity, unit_cost, total_cost, quantity_after, value_after, created_at)
+SELECT s.tenant_id, s.product_id, 'opening', s.quantity, 0, 0, s.quantity, 0, COALESCE(s.updated_at, NOW())
+FROM stock s
+WHERE s.quantity > 0
+  AND NOT EXISTS (SELECT 1 FROM stock_movements m WHERE m.product_id = s.product_id);
+
+CREATE INDEX IF NOT EXISTS idx_stock_cost_layers_open ON stock_cost_layers(tenant_id, product_id, received_at)
+    WHERE remaining_quantity > 0;
+CREATE INDEX IF NOT EXISTS idx_stock_movements_product ON stock_movements(tenant_id, product_id, created_at, seq);
@@ -0,0 +1,38 @@
+-- Órdenes de venta: al crearse reservan el stock de cada línea; al cumplirse la
+-- reserva se convierte en una salida de stock y al cancelarse se libera. Los
+-- precios se fijan al crear la orden con el precio vigente de cada publicación
+CREATE TABLE IF NOT EXISTS sales_orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE RESTRICT,
+    status VARCHAR(30) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'fulfilled', 'cancelled')),
+    currency CHAR(3) NOT NULL,
+    customer_name VARCHAR(255),
+    notes TEXT,
+    created_by VARCHAR(255),
+    fulfilled_at TIMESTAMP,
+    fulfilled_by VARCHAR(255),
+    cancelled_at TIMESTAMP,
+    cancelled_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS sales_order_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    sales_order_id UUID NOT NULL REFERENCES sales_orders(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    position INTEGER NOT NULL DEFAULT 0,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(14,4) NOT NULL CHECK (unit_price >= 0),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(sales_order_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sales_orders_tenant ON sales_orders(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_sales_orders_fulfilled ON sales_orders(tenant_id, store_id, fulfilled_at)
+    WHERE status = 'fulfilled';
+CREATE INDEX IF NOT EXISTS idx_sales_order_lines_order ON sales_order_lines(sales_order_id);
@@ -0,0 +1,46 @@
+-- Unidades en cuarentena: siguen en stock y valorizadas pero no están disponibles
+-- hasta que se inspeccionan
+ALTER TABLE stock ADD COLUMN IF NOT EXISTS quarantined_quantity INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_quarantined_quantity_check;
+ALTER TABLE stock ADD CONSTRAINT stock_quarantined_quantity_check CHECK (quarantined_quantity >= 0);
+
+-- Devoluciones: de clientes (entran al stock), a proveedores y bajas (salen del
+-- stock). Con cuarentena las unidades quedan retenidas hasta que se decide qué
+-- hacer con cada una: reingresarlas, descartarlas o enviarlas al proveedor
+CREATE TABLE IF NOT EXISTS stock_returns (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE RESTRICT,
+    type VARCHAR(30) NOT NULL CHECK (type IN ('customer_return', 'supplier_return', 'write_off')),
+    reason VARCHAR(30) NOT NULL,
+    status VARCHAR(30) NOT NULL CHECK (status IN ('quarantined', 'completed')),
+    sales_order_id UUID REFERENCES sales_orders(id) ON DELETE SET NULL,
+    supplier_id UUID REFERENCES suppliers(id) ON DELETE RESTRICT,
+    notes TEXT,
+    created_by VARCHAR(255),
+    completed_at TIMESTAMP,
+    completed_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS stock_return_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    return_id UUID NOT NULL REFERENCES stock_returns(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    position INTEGER NOT NULL DEFAULT 0,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_cost NUMERIC(14,4) CHECK (unit_cost >= 0),
+    restocked_quantity INTEGER NOT NULL DEFAULT 0 CHECK (restocked_quantity >= 0),
+    scrapped_quantity INTEGER NOT NULL DEFAULT 0 CHECK (scrapped_quantity >= 0),
+    shipped_quantity INTEGER NOT NULL DEFAULT 0 CHECK (shipped_quantity >= 0),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(return_id, product_id),
+    CHECK (restocked_quantity + scrapped_quantity + shipped_quantity <= quantity)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_returns_tenant ON stock_returns(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_stock_returns_store ON stock_returns(tenant_id, store_id);
+CREATE INDEX IF NOT EXISTS idx_stock_return_lines_return ON stock_return_lines(return_id);
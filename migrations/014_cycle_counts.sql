@@ -0,0 +1,51 @@
+-- Conteos cíclicos: una sesión por sucursal (opcionalmente acotada a una
+-- categoría) que congela la cantidad esperada de cada producto al abrirse. Las
+-- diferencias con lo contado se aplican al stock recién cuando un encargado
+-- aprueba la sesión
+CREATE TABLE IF NOT EXISTS count_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE RESTRICT,
+    category_id UUID REFERENCES categories(id) ON DELETE RESTRICT,
+    status VARCHAR(30) NOT NULL CHECK (status IN ('open', 'submitted', 'approved', 'cancelled')),
+    notes TEXT,
+    created_by VARCHAR(255),
+    submitted_at TIMESTAMP,
+    submitted_by VARCHAR(255),
+    approved_at TIMESTAMP,
+    approved_by VARCHAR(255),
+    cancelled_at TIMESTAMP,
+    cancelled_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Una línea por producto con la foto del stock y su costo promedio al abrir la sesión
+CREATE TABLE IF NOT EXISTS count_session_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES count_sessions(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    expected_quantity INTEGER NOT NULL,
+    unit_cost NUMERIC(14,4) NOT NULL DEFAULT 0,
+    UNIQUE(session_id, product_id)
+);
+
+-- Lo contado por cada dispositivo. Un dispositivo que vuelve a contar un producto
+-- reemplaza su conteo anterior; el total contado es la suma de los dispositivos
+CREATE TABLE IF NOT EXISTS count_session_counts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES count_sessions(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    device_id VARCHAR(100) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity >= 0),
+    counted_by VARCHAR(255),
+    counted_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(session_id, product_id, device_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_count_sessions_tenant ON count_sessions(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_count_sessions_store ON count_sessions(tenant_id, store_id);
+CREATE INDEX IF NOT EXISTS idx_count_session_lines_session ON count_session_lines(session_id);
+CREATE INDEX IF NOT EXISTS idx_count_session_counts_session ON count_session_counts(session_id, product_id);
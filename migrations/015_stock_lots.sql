@@ -0,0 +1,52 @@
+-- Seguimiento por lote: los artículos con tracking 'lot' guardan su stock por lote
+-- con fecha de vencimiento. La fila de stock sigue siendo el total del producto y
+-- los lotes lo desglosan. El tracking es del artículo de catálogo para que todas
+-- sus publicaciones lo compartan
+ALTER TABLE catalog_items ADD COLUMN IF NOT EXISTS tracking VARCHAR(20) NOT NULL DEFAULT 'none';
+ALTER TABLE catalog_items DROP CONSTRAINT IF EXISTS catalog_items_tracking_check;
+ALTER TABLE catalog_items ADD CONSTRAINT catalog_items_tracking_check
+    CHECK (tracking IN ('none', 'lot'));
+
+CREATE TABLE IF NOT EXISTS stock_lots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    lot_number VARCHAR(100) NOT NULL,
+    expires_at DATE,
+    quantity INTEGER NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    reserved_quantity INTEGER NOT NULL DEFAULT 0 CHECK (reserved_quantity >= 0),
+    received_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(product_id, lot_number),
+    CHECK (reserved_quantity <= quantity)
+);
+
+-- Qué lotes retiene cada reserva, asignados por FEFO al reservar
+CREATE TABLE IF NOT EXISTS stock_lot_reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    lot_id UUID NOT NULL REFERENCES stock_lots(id) ON DELETE CASCADE,
+    owner_type VARCHAR(30) NOT NULL,
+    owner_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    UNIQUE(lot_id, owner_type, owner_id)
+);
+
+-- Desglose por lote de cada movimiento del libro
+CREATE TABLE IF NOT EXISTS stock_lot_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    movement_id UUID NOT NULL REFERENCES stock_movements(id) ON DELETE CASCADE,
+    lot_id UUID NOT NULL REFERENCES stock_lots(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL
+);
+
+-- Las devoluciones de artículos con lote indican el lote devuelto
+ALTER TABLE stock_return_lines ADD COLUMN IF NOT EXISTS lot_number VARCHAR(100);
+ALTER TABLE stock_return_lines ADD COLUMN IF NOT EXISTS lot_expires_at DATE;
+
+CREATE INDEX IF NOT EXISTS idx_stock_lots_product ON stock_lots(tenant_id, product_id, expires_at) WHERE quantity > 0;
+CREATE INDEX IF NOT EXISTS idx_stock_lots_expiry ON stock_lots(tenant_id, expires_at) WHERE quantity > 0;
+CREATE INDEX IF NOT EXISTS idx_stock_lot_reservations_owner ON stock_lot_reservations(tenant_id, owner_type, owner_id);
+CREATE INDEX IF NOT EXISTS idx_stock_lot_movements_movement ON stock_lot_movements(movement_id);
+CREATE INDEX IF NOT EXISTS idx_stock_lot_movements_lot ON stock_lot_movements(lot_id);
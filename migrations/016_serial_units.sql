@@ -0,0 +1,49 @@
+-- Seguimiento por número de serie: cada unidad de un artículo con tracking 'serial'
+-- es una fila con su estado y la publicación (y por ende la sucursal) donde está.
+-- La fila de stock sigue siendo el total del producto y coincide con las unidades
+-- en stock, reservadas o en tránsito de esa publicación
+ALTER TABLE catalog_items DROP CONSTRAINT IF EXISTS catalog_items_tracking_check;
+ALTER TABLE catalog_items ADD CONSTRAINT catalog_items_tracking_check
+    CHECK (tracking IN ('none', 'lot', 'serial'));
+
+CREATE TABLE IF NOT EXISTS serial_units (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    serial_number VARCHAR(100) NOT NULL,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('in_stock', 'reserved', 'in_transit', 'sold', 'removed')),
+    owner_type VARCHAR(30),
+    owner_id UUID,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(tenant_id, serial_number)
+);
+
+-- Historia de cada unidad: un evento por cambio de estado o de sucursal, con el
+-- movimiento de stock que lo generó si lo hubo
+CREATE TABLE IF NOT EXISTS serial_unit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES serial_units(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE CASCADE,
+    movement_id UUID REFERENCES stock_movements(id) ON DELETE SET NULL,
+    reason VARCHAR(30),
+    reference_type VARCHAR(30),
+    reference_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    seq BIGINT GENERATED ALWAYS AS IDENTITY
+);
+
+-- Las líneas de traspasos, ventas y devoluciones indican qué unidades mueven
+ALTER TABLE transfer_lines ADD COLUMN IF NOT EXISTS serials TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE transfer_lines ADD COLUMN IF NOT EXISTS received_serials TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE sales_order_lines ADD COLUMN IF NOT EXISTS serials TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE stock_return_lines ADD COLUMN IF NOT EXISTS serials TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_serial_units_product ON serial_units(tenant_id, product_id, status);
+CREATE INDEX IF NOT EXISTS idx_serial_units_owner ON serial_units(tenant_id, owner_type, owner_id) WHERE owner_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_serial_unit_events_unit ON serial_unit_events(unit_id, created_at, seq);
+CREATE INDEX IF NOT EXISTS idx_serial_unit_events_movement ON serial_unit_events(movement_id) WHERE movement_id IS NOT NULL;
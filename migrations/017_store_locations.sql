@@ -0,0 +1,52 @@
+-- Ubicaciones dentro de una sucursal: zona → pasillo → bin. path es el código
+-- completo (por ejemplo A-03-12) y define el orden de recorrido al preparar pedidos
+CREATE TABLE IF NOT EXISTS store_locations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    store_id UUID NOT NULL REFERENCES stores(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES store_locations(id) ON DELETE RESTRICT,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('zone', 'aisle', 'bin')),
+    code VARCHAR(20) NOT NULL,
+    name VARCHAR(255),
+    path VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(store_id, path),
+    CHECK ((type = 'zone') = (parent_id IS NULL))
+);
+
+-- Stock de cada producto por bin. La fila de stock sigue siendo el total del
+-- producto; lo que no está en ningún bin queda sin ubicar hasta guardarse. Solo
+-- se borran bins vacíos, así que sus filas en cero se van con ellos
+CREATE TABLE IF NOT EXISTS bin_stock (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    location_id UUID NOT NULL REFERENCES store_locations(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE(product_id, location_id)
+);
+
+-- Historia de guardados, movimientos entre bins y salidas tomadas de un bin. Las
+-- salidas llevan el movimiento de stock o, si se preparan antes (como al
+-- despachar un traspaso), el documento que las causó
+CREATE TABLE IF NOT EXISTS bin_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    from_location_id UUID REFERENCES store_locations(id) ON DELETE SET NULL,
+    to_location_id UUID REFERENCES store_locations(id) ON DELETE SET NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    movement_id UUID REFERENCES stock_movements(id) ON DELETE CASCADE,
+    reference_type VARCHAR(30),
+    reference_id UUID,
+    created_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_store_locations_store ON store_locations(tenant_id, store_id, path);
+CREATE INDEX IF NOT EXISTS idx_store_locations_parent ON store_locations(parent_id);
+CREATE INDEX IF NOT EXISTS idx_bin_stock_product ON bin_stock(tenant_id, product_id) WHERE quantity > 0;
+CREATE INDEX IF NOT EXISTS idx_bin_stock_location ON bin_stock(location_id) WHERE quantity > 0;
+CREATE INDEX IF NOT EXISTS idx_bin_movements_product ON bin_movements(tenant_id, product_id, created_at);
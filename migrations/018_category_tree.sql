@@ -0,0 +1,12 @@
+-- Árbol de categorías: cada categoría puede colgar de otra (p. ej. Motor > Pistones >
+-- Aros). El nombre deja de ser único en todo el tenant y pasa a serlo entre
+-- hermanas, así "Aros" puede existir en ramas distintas.
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES categories(id) ON DELETE RESTRICT;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_parent_not_self;
+ALTER TABLE categories ADD CONSTRAINT categories_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_tenant_id_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_sibling_name
+    ON categories(tenant_id, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), name);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories(parent_id);
@@ -0,0 +1,5 @@
+-- Atributos tipados por categoría: cada categoría define su esquema (clave, tipo,
+-- opciones, unidad) y las subcategorías heredan el de sus ancestras. Los productos
+-- guardan sus valores en attributes, validados contra el esquema efectivo
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS attribute_schema JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE products ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,20 @@
+-- Compatibilidad de repuestos: qué vehículos (marca, modelo y rango de años) acepta
+-- cada artículo del catálogo. Todas sus publicaciones la comparten y las variantes
+-- usan la del artículo de su producto padre
+CREATE TABLE IF NOT EXISTS catalog_fitments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    catalog_item_id UUID NOT NULL REFERENCES catalog_items(id) ON DELETE CASCADE,
+    make VARCHAR(100) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    year_from INTEGER NOT NULL,
+    year_to INTEGER NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (year_from <= year_to)
+);
+
+-- Marca y modelo se comparan sin distinguir mayúsculas
+CREATE UNIQUE INDEX IF NOT EXISTS idx_catalog_fitments_unique
+    ON catalog_fitments(catalog_item_id, lower(make), lower(model), year_from, year_to);
+CREATE INDEX IF NOT EXISTS idx_catalog_fitments_vehicle ON catalog_fitments(tenant_id, lower(make), lower(model));
@@ -0,0 +1,21 @@
+-- Adjuntos de los productos: imágenes (con su miniatura) y documentos. Los
+-- archivos viven en el almacenamiento configurado; aquí se guardan sus claves y
+-- las URLs públicas que dio el almacenamiento al subirlos
+CREATE TABLE IF NOT EXISTS product_attachments (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('image', 'document')),
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    storage_key TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL,
+    thumbnail_key TEXT,
+    thumbnail_url TEXT,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_by VARCHAR(255),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_attachments_product ON product_attachments(tenant_id, product_id, position);
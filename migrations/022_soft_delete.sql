@@ -0,0 +1,32 @@
+-- Borrado lógico de sucursales, categorías, productos y traspasos: DELETE marca
+-- deleted_at y un job purga definitivamente lo que supera la retención. Las
+-- restricciones únicas pasan a ser índices parciales sobre las filas vivas, así un
+-- nombre o SKU borrado puede volver a usarse
+ALTER TABLE stores ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+ALTER TABLE stores DROP CONSTRAINT IF EXISTS stores_tenant_id_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stores_name ON stores(tenant_id, name) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_categories_sibling_name;
+CREATE UNIQUE INDEX idx_categories_sibling_name
+    ON categories(tenant_id, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), name)
+    WHERE deleted_at IS NULL;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_tenant_id_store_id_sku_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_store_sku ON products(tenant_id, store_id, sku) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_products_store_catalog_item;
+CREATE UNIQUE INDEX idx_products_store_catalog_item ON products(store_id, catalog_item_id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_products_variant_options;
+CREATE UNIQUE INDEX idx_products_variant_options ON products(parent_id, option_values)
+    WHERE parent_id IS NOT NULL AND deleted_at IS NULL;
+
+-- Para que el job de purga encuentre rápido lo borrado
+CREATE INDEX IF NOT EXISTS idx_stores_deleted_at ON stores(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at ON categories(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transfers_deleted_at ON transfers(deleted_at) WHERE deleted_at IS NOT NULL;
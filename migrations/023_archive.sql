@@ -0,0 +1,14 @@
+-- Archivado de sucursales y productos: una sucursal cerrada o un producto
+-- discontinuado deja de operar y de aparecer en los listados sin borrar su
+-- historial. archived_at guarda cuándo se archivó; los productos archivados junto
+-- con su sucursal o su producto padre comparten ese instante, así se reactivan juntos
+ALTER TABLE stores ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'archived'));
+ALTER TABLE stores ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'archived'));
+ALTER TABLE products ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_stores_status ON stores(tenant_id, status) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_products_status ON products(store_id, status) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+-- Cierre de sucursales: mientras el traspaso de evacuación lleva su stock a otra
+-- sucursal, la sucursal queda en 'closing' y no vende; al recibirse el traspaso
+-- se archiva. closing_transfer_id es ese traspaso
+ALTER TABLE stores DROP CONSTRAINT IF EXISTS stores_status_check;
+ALTER TABLE stores ADD CONSTRAINT stores_status_check
+    CHECK (status IN ('active', 'closing', 'archived'));
+
+ALTER TABLE stores ADD COLUMN IF NOT EXISTS closing_transfer_id UUID REFERENCES transfers(id) ON DELETE SET NULL;
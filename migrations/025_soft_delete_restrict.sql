@@ -0,0 +1,14 @@
+-- Las publicaciones y los traspasos borrados lógicamente se purgan según su propio
+-- deleted_at. Con ON DELETE CASCADE, purgar una sucursal se los llevaba antes de
+-- tiempo; con RESTRICT la purga salta la sucursal hasta que no queden
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_store_id_fkey;
+ALTER TABLE products ADD CONSTRAINT products_store_id_fkey
+    FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE RESTRICT;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_from_store_id_fkey;
+ALTER TABLE transfers ADD CONSTRAINT transfers_from_store_id_fkey
+    FOREIGN KEY (from_store_id) REFERENCES stores(id) ON DELETE RESTRICT;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_to_store_id_fkey;
+ALTER TABLE transfers ADD CONSTRAINT transfers_to_store_id_fkey
+    FOREIGN KEY (to_store_id) REFERENCES stores(id) ON DELETE RESTRICT;
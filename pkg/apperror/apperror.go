@@ -0,0 +1,92 @@
+package apperror
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Kind clasifica un error de dominio y sugiere el estado HTTP con que se responde.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindInvalid
+	KindUnauthorized
+	KindForbidden
+	KindNotFound
+	KindConflict
+	KindTooLarge
+	KindUnsupportedMediaType
+	KindUnprocessable
+)
+
+// Status es el estado HTTP que corresponde a la clase de error.
+func (k Kind) Status() int {
+	switch k {
+	case KindInvalid:
+		return http.StatusBadRequest
+	case KindUnauthorized:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case KindUnsupportedMediaType:
+		return http.StatusUnsupportedMediaType
+	case KindUnprocessable:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Error es un error de dominio con un código estable que los clientes pueden
+// comparar sin depender del mensaje.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	// Details amplía el error, p. ej. con los campos que no pasaron la validación.
+	Details any
+
+	parent *Error
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap devuelve el error del que se derivó la copia, para que errors.Is la
+// siga reconociendo como el error original.
+func (e *Error) Unwrap() error {
+	if e.parent == nil {
+		return nil
+	}
+	return e.parent
+}
+
+// WithDetails devuelve una copia del error con detalles; el original no cambia.
+func (e *Error) WithDetails(details any) *Error {
+	return &Error{Kind: e.Kind, Code: e.Code, Message: e.Message, Details: details, parent: e}
+}
+
+// WithMessage devuelve una copia del error con otro mensaje y el mismo código.
+func (e *Error) WithMessage(message string) *Error {
+	return &Error{Kind: e.Kind, Code: e.Code, Message: message, Details: e.Details, parent: e}
+}
+
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,35 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestErrorDetails(t *testing.T) {
+	errNotFound := New(KindNotFound, "store_not_found", "store not found")
+
+	detailed := errNotFound.WithDetails(map[string]string{"id": "unknown"})
+	if !errors.Is(detailed, errNotFound) {
+		t.Error("a copy with details should still match the original error")
+	}
+	if errNotFound.Details != nil {
+		t.Error("WithDetails must not change the original error")
+	}
+
+	appErr, ok := As(fmt.Errorf("get store: %w", detailed))
+	if !ok || appErr.Code != "store_not_found" || appErr.Details == nil {
+		t.Fatalf("As() = %+v, %v; want the wrapped copy with details", appErr, ok)
+	}
+	if got := appErr.Kind.Status(); got != http.StatusNotFound {
+		t.Errorf("Status() = %d, want %d", got, http.StatusNotFound)
+	}
+
+	if errors.Is(New(KindNotFound, "store_not_found", "store not found"), errNotFound) {
+		t.Error("distinct errors with the same code should not match")
+	}
+	if _, ok := As(errors.New("boom")); ok {
+		t.Error("As() should not match plain errors")
+	}
+}
@@ -8,6 +8,8 @@ type contextKey string
 
 const TenantIDKey contextKey = "tenant_id"
 const UserIDKey contextKey = "user_id"
+const StoreIDKey contextKey = "store_id"
+const RoleKey contextKey = "role"
 
 func GetTenantID(ctx context.Context) string {
 	if tenantID, ok := ctx.Value(TenantIDKey).(string); ok {
@@ -22,3 +24,17 @@ func GetUserID(ctx context.Context) string {
 	}
 	return ""
 }
+
+func GetStoreID(ctx context.Context) string {
+	if storeID, ok := ctx.Value(StoreIDKey).(string); ok {
+		return storeID
+	}
+	return ""
+}
+
+func GetRole(ctx context.Context) string {
+	if role, ok := ctx.Value(RoleKey).(string); ok {
+		return role
+	}
+	return ""
+}
@@ -46,6 +46,11 @@ func New(level string, format string) (Logger, error) {
 	return &zapLogger{logger: logger}, nil
 }
 
+// NewNop devuelve un logger que descarta todo, para pruebas.
+func NewNop() Logger {
+	return &zapLogger{logger: zap.NewNop()}
+}
+
 func (l *zapLogger) Debug(msg string, fields ...Field) {
 	l.logger.Debug(msg, fields...)
 }
@@ -0,0 +1,201 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"motico-api/pkg/apperror"
+	"strconv"
+	"strings"
+)
+
+// Scale es la cantidad de decimales con que se guardan los importes. Alcanza para
+// las monedas de tres decimales y para costos unitarios con más precisión.
+const Scale = 4
+
+const unitsPerWhole = 10000
+
+var (
+	ErrInvalidAmount  = apperror.New(apperror.KindInvalid, "invalid_amount", "amount must be a decimal number with at most 4 decimal places")
+	ErrNegativeAmount = apperror.New(apperror.KindInvalid, "negative_amount", "amount must not be negative")
+)
+
+// Amount es un importe decimal exacto expresado en diezmilésimos. Nunca pasa por
+// float64, así que las sumas y los totales no acumulan errores de redondeo.
+type Amount int64
+
+func FromInt(n int64) Amount {
+	return Amount(n * unitsPerWhole)
+}
+
+// Parse interpreta un decimal como "12", "12.5" o "-0.0125".
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, frac, hasDot := strings.Cut(s, ".")
+	if whole == "" && frac == "" || len(frac) > Scale || hasDot && frac == "" {
+		return 0, ErrInvalidAmount
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	if !isDigits(whole) || frac != "" && !isDigits(frac) {
+		return 0, ErrInvalidAmount
+	}
+
+	wholeUnits, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || wholeUnits > (1<<63-1)/unitsPerWhole-1 {
+		return 0, ErrInvalidAmount
+	}
+	fracUnits := int64(0)
+	if frac != "" {
+		fracUnits, _ = strconv.ParseInt(frac+strings.Repeat("0", Scale-len(frac)), 10, 64)
+	}
+
+	amount := Amount(wholeUnits*unitsPerWhole + fracUnits)
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+func (a Amount) MulInt(n int) Amount {
+	return a * Amount(n)
+}
+
+// DivInt divide redondeando la mitad hacia arriba; se usa para costos promedio.
+func (a Amount) DivInt(n int) Amount {
+	if n == 0 {
+		return 0
+	}
+	d := Amount(n)
+	q, r := a/d, a%d
+	if r < 0 {
+		r = -r
+	}
+	if d < 0 {
+		d = -d
+	}
+	if 2*r >= d {
+		if (a < 0) != (n < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
+
+// Decimals devuelve cuántos decimales significativos tiene el importe.
+func (a Amount) Decimals() int {
+	frac := int64(a) % unitsPerWhole
+	if frac < 0 {
+		frac = -frac
+	}
+	decimals := Scale
+	for decimals > 0 && frac%10 == 0 {
+		frac /= 10
+		decimals--
+	}
+	return decimals
+}
+
+// String muestra el importe con al menos dos decimales, p. ej. "12.50" o "0.1234".
+func (a Amount) String() string {
+	places := a.Decimals()
+	if places < 2 {
+		places = 2
+	}
+	return a.StringFixed(places)
+}
+
+// StringFixed muestra el importe con exactamente places decimales, truncando el resto.
+func (a Amount) StringFixed(places int) string {
+	units := int64(a)
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+	whole := units / unitsPerWhole
+	frac := fmt.Sprintf("%04d", units%unitsPerWhole)
+	if places <= 0 {
+		return fmt.Sprintf("%s%d", sign, whole)
+	}
+	if places > Scale {
+		places = Scale
+	}
+	return fmt.Sprintf("%s%d.%s", sign, whole, frac[:places])
+}
+
+// MarshalJSON serializa el importe como string para que los clientes no lo
+// conviertan a punto flotante.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(a.String())), nil
+}
+
+// UnmarshalJSON acepta tanto "12.50" como 12.50; en ambos casos se interpreta
+// el texto tal cual, sin pasar por float64.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if unquoted, err := strconv.Unquote(text); err == nil {
+		text = unquoted
+	}
+	amount, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// Scan lee columnas NUMERIC, que el driver entrega como texto.
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case string:
+		return a.scanText(v)
+	case []byte:
+		return a.scanText(string(v))
+	case int64:
+		*a = FromInt(v)
+		return nil
+	case float64:
+		return a.scanText(strconv.FormatFloat(v, 'f', Scale, 64))
+	default:
+		return fmt.Errorf("cannot scan %T into money.Amount", src)
+	}
+}
+
+func (a *Amount) scanText(text string) error {
+	// NUMERIC puede traer más decimales que Scale; se conservan los primeros
+	if whole, frac, ok := strings.Cut(text, "."); ok && len(frac) > Scale {
+		text = whole + "." + frac[:Scale]
+	}
+	amount, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+func (a Amount) Value() (driver.Value, error) {
+	return a.StringFixed(Scale), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"motico-api/pkg/apperror"
+	"strings"
+)
+
+var (
+	ErrInvalidCurrency = apperror.New(apperror.KindInvalid, "invalid_currency", "currency must be a three-letter ISO 4217 code")
+	ErrInvalidScale    = apperror.New(apperror.KindInvalid, "invalid_scale", "amount has more decimal places than its currency allows")
+)
+
+// Currency es un código ISO 4217.
+type Currency string
+
+// minorUnits lista las monedas que no usan dos decimales.
+var minorUnits = map[Currency]int{
+	"CLP": 0,
+	"JPY": 0,
+	"KRW": 0,
+	"PYG": 0,
+	"VND": 0,
+	"BHD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+func ParseCurrency(code string) (Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'A' || code[i] > 'Z' {
+			return "", ErrInvalidCurrency
+		}
+	}
+	return Currency(code), nil
+}
+
+// Scale devuelve los decimales de la moneda.
+func (c Currency) Scale() int {
+	if units, ok := minorUnits[c]; ok {
+		return units
+	}
+	return 2
+}
+
+// ValidatePrice rechaza importes negativos o con más decimales de los que admite la moneda.
+func ValidatePrice(amount Amount, currency Currency) error {
+	if amount.IsNegative() {
+		return ErrNegativeAmount
+	}
+	if amount.Decimals() > currency.Scale() {
+		return ErrInvalidScale
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Amount
+		err  error
+	}{
+		{"12", 120000, nil},
+		{"12.5", 125000, nil},
+		{"0.1234", 1234, nil},
+		{"-3.05", -30500, nil},
+		{".5", 5000, nil},
+		{"1.23456", 0, ErrInvalidAmount},
+		{"1.", 0, ErrInvalidAmount},
+		{"abc", 0, ErrInvalidAmount},
+		{"", 0, ErrInvalidAmount},
+		{"1e3", 0, ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != tt.err || got != tt.want {
+			t.Errorf("Parse(%q) = %d, %v; want %d, %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	tests := map[Amount]string{
+		125000:     "12.50",
+		1234:       "0.1234",
+		-30500:     "-3.05",
+		FromInt(7): "7.00",
+		10010:      "1.001",
+	}
+
+	for amount, want := range tests {
+		if got := amount.String(); got != want {
+			t.Errorf("Amount(%d).String() = %s, want %s", amount, got, want)
+		}
+	}
+}
+
+func TestAmountSumIsExact(t *testing.T) {
+	// 0.1 + 0.2 en float64 da 0.30000000000000004
+	a, _ := Parse("0.1")
+	b, _ := Parse("0.2")
+	if got := (a + b).String(); got != "0.30" {
+		t.Errorf("expected 0.30, got %s", got)
+	}
+}
+
+func TestDivInt(t *testing.T) {
+	a, _ := Parse("10")
+	if got := a.DivInt(3).String(); got != "3.3333" {
+		t.Errorf("expected 3.3333, got %s", got)
+	}
+	b, _ := Parse("0.0005")
+	if got := b.DivInt(2); got != 3 {
+		t.Errorf("expected half-up rounding to 3 units, got %d", got)
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	var payload struct {
+		Price  Amount `json:"price"`
+		Legacy Amount `json:"legacy"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":"19.99","legacy":5.10}`), &payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Price != 199900 || payload.Legacy != 51000 {
+		t.Errorf("unexpected amounts %d, %d", payload.Price, payload.Legacy)
+	}
+
+	data, _ := json.Marshal(payload)
+	if string(data) != `{"price":"19.99","legacy":"5.10"}` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+}
+
+func TestValidatePrice(t *testing.T) {
+	cents, _ := Parse("10.99")
+	mills, _ := Parse("10.995")
+
+	if err := ValidatePrice(cents, "USD"); err != nil {
+		t.Errorf("expected USD 10.99 to be valid, got %v", err)
+	}
+	if err := ValidatePrice(mills, "USD"); err != ErrInvalidScale {
+		t.Errorf("expected scale error for USD 10.995, got %v", err)
+	}
+	if err := ValidatePrice(mills, "KWD"); err != nil {
+		t.Errorf("expected KWD 10.995 to be valid, got %v", err)
+	}
+	if err := ValidatePrice(cents, "CLP"); err != ErrInvalidScale {
+		t.Errorf("expected scale error for CLP 10.99, got %v", err)
+	}
+	if err := ValidatePrice(-cents, "USD"); err != ErrNegativeAmount {
+		t.Errorf("expected negative amount error, got %v", err)
+	}
+}
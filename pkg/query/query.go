@@ -0,0 +1,93 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"motico-api/pkg/apperror"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Sort es un criterio de orden; en la URL un "-" delante del campo indica orden descendente.
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// Params son el orden y los filtros pedidos en la URL de un listado. Cada
+// repositorio valida los campos contra su propia lista blanca.
+type Params struct {
+	Sort    []Sort
+	Filters map[string]string
+	// IncludeDeleted incluye en el listado las filas borradas lógicamente
+	// (include_deleted=true). Solo los administradores pueden pedirlo.
+	IncludeDeleted bool
+}
+
+// IncludeDeletedParam es el parámetro de la URL que incluye las filas borradas.
+const IncludeDeletedParam = "include_deleted"
+
+// ValidationError indica un campo de orden o filtro desconocido, o un valor inválido.
+type ValidationError struct {
+	Param   string
+	Message string
+}
+
+// ErrInvalidParam lleva en Details el mensaje del parámetro inválido.
+var ErrInvalidParam = apperror.New(apperror.KindInvalid, "invalid_query_param", "invalid query parameter")
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Param, e.Message)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidParam.WithDetails(map[string]string{e.Param: e.Message})
+}
+
+func AsValidationError(err error) (*ValidationError, bool) {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr, true
+	}
+	return nil, false
+}
+
+// Parse lee sort=name,-price y trata como filtro cualquier otro parámetro que no
+// esté en reserved (paginación y filtros propios del endpoint).
+func Parse(values url.Values, reserved ...string) Params {
+	params := Params{Filters: make(map[string]string)}
+
+	skip := make(map[string]bool, len(reserved)+1)
+	skip["sort"] = true
+	skip[IncludeDeletedParam] = true
+	for _, name := range reserved {
+		skip[name] = true
+	}
+
+	for _, field := range strings.Split(values.Get("sort"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		sort := Sort{Field: field}
+		if strings.HasPrefix(field, "-") {
+			sort = Sort{Field: strings.TrimPrefix(field, "-"), Desc: true}
+		}
+		params.Sort = append(params.Sort, sort)
+	}
+
+	// El middleware ya rechazó los valores inválidos
+	params.IncludeDeleted, _ = strconv.ParseBool(values.Get(IncludeDeletedParam))
+
+	for name := range values {
+		if skip[name] {
+			continue
+		}
+		if value := values.Get(name); value != "" {
+			params.Filters[name] = value
+		}
+	}
+
+	return params
+}
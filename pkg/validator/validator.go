@@ -1,32 +1,60 @@
 package validator
 
 import (
-	"fmt"
-	"motico-api/internal/rest/response"
+	"motico-api/pkg/apperror"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
 
+// ErrValidation lleva en Details el mensaje de cada campo que no pasó la validación.
+var ErrValidation = apperror.New(apperror.KindInvalid, "validation_failed", "request validation failed")
+
 var validate *validator.Validate
 
 func init() {
 	validate = validator.New()
+	// Los campos se informan con su nombre JSON, que es el que conoce el cliente.
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
 }
 
 func ValidateRequest(r *http.Request, v interface{}) error {
 	if err := validate.Struct(v); err != nil {
-		validationErrors := make(map[string]string)
-		for _, err := range err.(validator.ValidationErrors) {
-			field := err.Field()
-			tag := err.Tag()
-			validationErrors[field] = fmt.Sprintf("Field '%s' failed validation: %s", field, tag)
+		validationErrors, ok := err.(validator.ValidationErrors)
+		if !ok {
+			return err
+		}
+		fields := make(map[string]string, len(validationErrors))
+		for _, err := range validationErrors {
+			fields[fieldPath(err.Namespace())] = fieldMessage(err)
 		}
-		return fmt.Errorf("validation failed: %v", validationErrors)
+		return ErrValidation.WithDetails(fields)
 	}
 	return nil
 }
 
-func HandleValidationError(w http.ResponseWriter, err error) {
-	response.Error(w, http.StatusBadRequest, "Validation error", err.Error())
+// fieldPath quita el nombre de la estructura raíz: "CreateRequest.lines[0].quantity" -> "lines[0].quantity".
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func fieldMessage(err validator.FieldError) string {
+	if err.Param() != "" {
+		return "failed validation: " + err.Tag() + "=" + err.Param()
+	}
+	return "failed validation: " + err.Tag()
 }